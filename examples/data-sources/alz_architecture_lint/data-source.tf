@@ -0,0 +1,28 @@
+data "azapi_client_config" "example" {}
+
+data "alz_architecture_lint" "example" {
+  name                     = "alz"
+  root_management_group_id = data.azapi_client_config.example.tenant_id
+  location                 = "swedencentral"
+
+  rules = {
+    policy_assignment_duplicate_name = {
+      enabled = false
+    }
+    policy_assignment_not_scope_outside_hierarchy = {
+      severity = "error"
+    }
+  }
+
+  lifecycle {
+    postcondition {
+      condition     = alltrue([for f in self.findings : f.severity != "error"])
+      error_message = join("\n", [for f in self.findings : "${f.rule_id}: ${f.message}" if f.severity == "error"])
+    }
+  }
+}
+
+output "lint_findings" {
+  description = "The findings of the architecture lint rules."
+  value       = data.alz_architecture_lint.example.findings
+}
@@ -4,3 +4,8 @@ output "alz_library_refs" {
   description = "A list of the loaded ALZ Library references."
   value       = data.alz_metadata.example.alz_library_refs
 }
+
+output "alz_library_overwrites" {
+  description = "The library assets that were replaced by later library references."
+  value       = data.alz_metadata.example.library_overwrites
+}
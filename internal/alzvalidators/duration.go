@@ -0,0 +1,54 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package alzvalidators
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that a string Attribute's value is a valid positive duration.
+type durationValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator durationValidator) Description(_ context.Context) string {
+	return "Value must be a valid positive duration, e.g. 168h or 30m"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator durationValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v durationValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// Duration returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a valid duration, as accepted by time.ParseDuration
+//   - Is greater than zero
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Duration() validator.String {
+	return durationValidator{}
+}
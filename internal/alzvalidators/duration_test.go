@@ -0,0 +1,83 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package alzvalidators_test
+
+import (
+	"testing"
+
+	"github.com/Azure/terraform-provider-alz/internal/alzvalidators"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDuration(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		id        types.String
+		validator validator.String
+		expErrors int
+	}
+
+	testCases := map[string]testCase{
+		"hours": {
+			id:        types.StringValue("168h"),
+			validator: alzvalidators.Duration(),
+			expErrors: 0,
+		},
+		"compound": {
+			id:        types.StringValue("1h30m"),
+			validator: alzvalidators.Duration(),
+			expErrors: 0,
+		},
+		"null": {
+			id:        types.StringNull(),
+			validator: alzvalidators.Duration(),
+			expErrors: 0,
+		},
+		"days": {
+			id:        types.StringValue("7d"),
+			validator: alzvalidators.Duration(),
+			expErrors: 1,
+		},
+		"zero": {
+			id:        types.StringValue("0s"),
+			validator: alzvalidators.Duration(),
+			expErrors: 1,
+		},
+		"negative": {
+			id:        types.StringValue("-1h"),
+			validator: alzvalidators.Duration(),
+			expErrors: 1,
+		},
+		"empty": {
+			id:        types.StringValue(""),
+			validator: alzvalidators.Duration(),
+			expErrors: 1,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := validator.StringRequest{
+				ConfigValue: test.id,
+			}
+			res := validator.StringResponse{}
+			test.validator.ValidateString(t.Context(), req, &res)
+
+			if test.expErrors > 0 && !res.Diagnostics.HasError() {
+				t.Fatalf("expected %d error(s), got none", test.expErrors)
+			}
+
+			if test.expErrors > 0 && test.expErrors != res.Diagnostics.ErrorsCount() {
+				t.Fatalf("expected %d error(s), got %d: %v", test.expErrors, res.Diagnostics.ErrorsCount(), res.Diagnostics)
+			}
+
+			if test.expErrors == 0 && res.Diagnostics.HasError() {
+				t.Fatalf("expected no error(s), got %d: %v", res.Diagnostics.ErrorsCount(), res.Diagnostics)
+			}
+		})
+	}
+}
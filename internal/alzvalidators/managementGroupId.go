@@ -0,0 +1,62 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package alzvalidators
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = managementGroupIdValidator{}
+
+// managementGroupIdRegex matches the characters allowed in a management group id.
+var managementGroupIdRegex = regexp.MustCompile(`^[a-zA-Z0-9\-_().]{1,90}$`)
+
+// managementGroupIdValidator validates that a string Attribute's value is a valid management group id.
+type managementGroupIdValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator managementGroupIdValidator) Description(_ context.Context) string {
+	return "Value must be a valid management group id: up to 90 alphanumeric, hyphen, underscore, period or parenthesis characters, not ending with a period"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator managementGroupIdValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v managementGroupIdValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	if !IsManagementGroupId(value) {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// IsManagementGroupId returns true if the supplied value is a valid management group id.
+func IsManagementGroupId(value string) bool {
+	return managementGroupIdRegex.MatchString(value) && !strings.HasSuffix(value, ".")
+}
+
+// ManagementGroupId returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a valid management group id
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func ManagementGroupId() validator.String {
+	return managementGroupIdValidator{}
+}
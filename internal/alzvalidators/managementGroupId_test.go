@@ -0,0 +1,84 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package alzvalidators_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Azure/terraform-provider-alz/internal/alzvalidators"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestManagementGroupId(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		id        types.String
+		validator validator.String
+		expErrors int
+	}
+
+	testCases := map[string]testCase{
+		"simple-match": {
+			id:        types.StringValue("alz-landingzones"),
+			validator: alzvalidators.ManagementGroupId(),
+			expErrors: 0,
+		},
+		"special-characters-match": {
+			id:        types.StringValue("alz_(test).corp"),
+			validator: alzvalidators.ManagementGroupId(),
+			expErrors: 0,
+		},
+		"null": {
+			id:        types.StringNull(),
+			validator: alzvalidators.ManagementGroupId(),
+			expErrors: 0,
+		},
+		"invalid-character": {
+			id:        types.StringValue("alz/landingzones"),
+			validator: alzvalidators.ManagementGroupId(),
+			expErrors: 1,
+		},
+		"trailing-period": {
+			id:        types.StringValue("alz."),
+			validator: alzvalidators.ManagementGroupId(),
+			expErrors: 1,
+		},
+		"too-long": {
+			id:        types.StringValue(strings.Repeat("a", 91)),
+			validator: alzvalidators.ManagementGroupId(),
+			expErrors: 1,
+		},
+		"empty": {
+			id:        types.StringValue(""),
+			validator: alzvalidators.ManagementGroupId(),
+			expErrors: 1,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := validator.StringRequest{
+				ConfigValue: test.id,
+			}
+			res := validator.StringResponse{}
+			test.validator.ValidateString(t.Context(), req, &res)
+
+			if test.expErrors > 0 && !res.Diagnostics.HasError() {
+				t.Fatalf("expected %d error(s), got none", test.expErrors)
+			}
+
+			if test.expErrors > 0 && test.expErrors != res.Diagnostics.ErrorsCount() {
+				t.Fatalf("expected %d error(s), got %d: %v", test.expErrors, res.Diagnostics.ErrorsCount(), res.Diagnostics)
+			}
+
+			if test.expErrors == 0 && res.Diagnostics.HasError() {
+				t.Fatalf("expected no error(s), got %d: %v", res.Diagnostics.ErrorsCount(), res.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package alzvalidators
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = rfc3339TimestampValidator{}
+
+// rfc3339TimestampValidator validates that a string Attribute's value is a valid RFC 3339 timestamp.
+type rfc3339TimestampValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator rfc3339TimestampValidator) Description(_ context.Context) string {
+	return "Value must be a valid RFC 3339 timestamp, e.g. 2025-01-31T00:00:00Z"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator rfc3339TimestampValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v rfc3339TimestampValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// RFC3339Timestamp returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a valid RFC 3339 timestamp
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func RFC3339Timestamp() validator.String {
+	return rfc3339TimestampValidator{}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package alzvalidators_test
+
+import (
+	"testing"
+
+	"github.com/Azure/terraform-provider-alz/internal/alzvalidators"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRFC3339Timestamp(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		id        types.String
+		validator validator.String
+		expErrors int
+	}
+
+	testCases := map[string]testCase{
+		"utc": {
+			id:        types.StringValue("2025-01-31T00:00:00Z"),
+			validator: alzvalidators.RFC3339Timestamp(),
+			expErrors: 0,
+		},
+		"offset": {
+			id:        types.StringValue("2025-01-31T12:30:00+01:00"),
+			validator: alzvalidators.RFC3339Timestamp(),
+			expErrors: 0,
+		},
+		"null": {
+			id:        types.StringNull(),
+			validator: alzvalidators.RFC3339Timestamp(),
+			expErrors: 0,
+		},
+		"date-only": {
+			id:        types.StringValue("2025-01-31"),
+			validator: alzvalidators.RFC3339Timestamp(),
+			expErrors: 1,
+		},
+		"no-timezone": {
+			id:        types.StringValue("2025-01-31T00:00:00"),
+			validator: alzvalidators.RFC3339Timestamp(),
+			expErrors: 1,
+		},
+		"empty": {
+			id:        types.StringValue(""),
+			validator: alzvalidators.RFC3339Timestamp(),
+			expErrors: 1,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := validator.StringRequest{
+				ConfigValue: test.id,
+			}
+			res := validator.StringResponse{}
+			test.validator.ValidateString(t.Context(), req, &res)
+
+			if test.expErrors > 0 && !res.Diagnostics.HasError() {
+				t.Fatalf("expected %d error(s), got none", test.expErrors)
+			}
+
+			if test.expErrors > 0 && test.expErrors != res.Diagnostics.ErrorsCount() {
+				t.Fatalf("expected %d error(s), got %d: %v", test.expErrors, res.Diagnostics.ErrorsCount(), res.Diagnostics)
+			}
+
+			if test.expErrors == 0 && res.Diagnostics.HasError() {
+				t.Fatalf("expected no error(s), got %d: %v", res.Diagnostics.ErrorsCount(), res.Diagnostics)
+			}
+		})
+	}
+}
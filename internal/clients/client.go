@@ -1,6 +1,8 @@
 package clients
 
 import (
+	"context"
+	"io/fs"
 	"sync"
 
 	"github.com/Azure/alzlib"
@@ -14,6 +16,32 @@ type Client struct {
 	ncmPlaceholder                       string
 	ncmEnforcedReplacement               string
 	ncmNotEnforcedReplacement            string
+	libraryOverwrites                    []LibraryOverwrite
+	libraryDependencyGraph               []LibraryDependency
+	offline                              bool
+	builtInCache                         alzlib.BuiltInCache
+	saveBuiltInCache                     SaveBuiltInCacheFunc
+}
+
+// SaveBuiltInCacheFunc saves the built-in definitions to the cache file, after a hierarchy has been built.
+// The referenced definitions are those used by the hierarchy, which are kept if the cache file is pruned.
+type SaveBuiltInCacheFunc func(ctx context.Context, referenced []alzlib.BuiltInRequest) error
+
+// LibraryOverwrite is a library asset that was replaced by a later library reference.
+type LibraryOverwrite struct {
+	AssetType                   string
+	Name                        string
+	Version                     string
+	OriginalLibraryReference    string
+	OverwritingLibraryReference string
+}
+
+// LibraryDependency is a library reference in the resolved library dependency graph.
+type LibraryDependency struct {
+	LibraryReference          string
+	Explicit                  bool
+	Dependencies              []string
+	ReplacedLibraryReferences []string
 }
 
 func (s *Client) SuppressWarningPolicyRoleAssignments() bool {
@@ -35,6 +63,48 @@ func (s *Client) NonComplianceMessageNotEnforcedReplacement() string {
 	return s.ncmNotEnforcedReplacement
 }
 
+// LibraryOverwrites returns the library assets that were replaced by later library references.
+func (s *Client) LibraryOverwrites() []LibraryOverwrite {
+	return s.libraryOverwrites
+}
+
+// LibraryDependencyGraph returns the resolved library dependency graph, in load order.
+func (s *Client) LibraryDependencyGraph() []LibraryDependency {
+	return s.libraryDependencyGraph
+}
+
+// Offline returns true if Azure API calls are forbidden, so built-in definitions can only come from the cache.
+func (s *Client) Offline() bool {
+	return s.offline
+}
+
+// BuiltInCache returns the cache of built-in definitions loaded from the cache file, or nil if there is none.
+func (s *Client) BuiltInCache() alzlib.BuiltInCache {
+	return s.builtInCache
+}
+
+// SaveBuiltInCache saves the built-in definitions to the cache file, if saving is enabled.
+func (s *Client) SaveBuiltInCache(ctx context.Context, referenced []alzlib.BuiltInRequest) error {
+	if s.saveBuiltInCache == nil {
+		return nil
+	}
+	return s.saveBuiltInCache(ctx, referenced)
+}
+
+// InitArchitectureFromFS processes the supplied library filesystem, which must contain the named architecture.
+// The library is only processed if the architecture does not already exist,
+// so architectures generated at read time are added once and then reused.
+func (s *Client) InitArchitectureFromFS(ctx context.Context, name string, lib fs.FS) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Architecture(name) != nil {
+		return nil
+	}
+
+	return s.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS(name, lib))
+}
+
 // Option is a functional option for configuring the Client.
 type Option func(*Client)
 
@@ -47,6 +117,11 @@ func NewClient(opts ...Option) *Client {
 		ncmPlaceholder:                       "",
 		ncmEnforcedReplacement:               "",
 		ncmNotEnforcedReplacement:            "",
+		libraryOverwrites:                    nil,
+		libraryDependencyGraph:               nil,
+		offline:                              false,
+		builtInCache:                         nil,
+		saveBuiltInCache:                     nil,
 	}
 
 	for _, opt := range opts {
@@ -78,3 +153,32 @@ func WithNonComplianceMessageSubstitutionSettings(placeholder, enforcedReplaceme
 		c.ncmNotEnforcedReplacement = notEnforcedReplacement
 	}
 }
+
+// WithLibraryOverwrites sets the library assets that were replaced by later library references.
+func WithLibraryOverwrites(overwrites []LibraryOverwrite) Option {
+	return func(c *Client) {
+		c.libraryOverwrites = overwrites
+	}
+}
+
+// WithLibraryDependencyGraph sets the resolved library dependency graph.
+func WithLibraryDependencyGraph(graph []LibraryDependency) Option {
+	return func(c *Client) {
+		c.libraryDependencyGraph = graph
+	}
+}
+
+// WithOffline sets whether Azure API calls are forbidden, and the cache of built-in definitions.
+func WithOffline(offline bool, builtInCache alzlib.BuiltInCache) Option {
+	return func(c *Client) {
+		c.offline = offline
+		c.builtInCache = builtInCache
+	}
+}
+
+// WithSaveBuiltInCache sets the function that saves the built-in definitions to the cache file.
+func WithSaveBuiltInCache(save SaveBuiltInCacheFunc) Option {
+	return func(c *Client) {
+		c.saveBuiltInCache = save
+	}
+}
@@ -5,7 +5,11 @@ package gen
 import (
 	"context"
 	"fmt"
+	"github.com/Azure/terraform-provider-alz/internal/alzvalidators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -23,32 +27,216 @@ import (
 func AlzProviderSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"archetype_overrides": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"base_archetype": schema.StringAttribute{
+							Required:            true,
+							Description:         "The name of the archetype to use as the base.",
+							MarkdownDescription: "The name of the archetype to use as the base.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"name": schema.StringAttribute{
+							Required:            true,
+							Description:         "The name of the new archetype.",
+							MarkdownDescription: "The name of the new archetype.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"policy_assignments_to_add": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Description:         "The names of the policy assignments to add to the base archetype.",
+							MarkdownDescription: "The names of the policy assignments to add to the base archetype.",
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+						"policy_assignments_to_remove": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Description:         "The names of the policy assignments to remove from the base archetype.",
+							MarkdownDescription: "The names of the policy assignments to remove from the base archetype.",
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+						"policy_definitions_to_add": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Description:         "The names of the policy definitions to add to the base archetype.",
+							MarkdownDescription: "The names of the policy definitions to add to the base archetype.",
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+						"policy_definitions_to_remove": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Description:         "The names of the policy definitions to remove from the base archetype.",
+							MarkdownDescription: "The names of the policy definitions to remove from the base archetype.",
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+						"policy_set_definitions_to_add": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Description:         "The names of the policy set definitions to add to the base archetype.",
+							MarkdownDescription: "The names of the policy set definitions to add to the base archetype.",
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+						"policy_set_definitions_to_remove": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Description:         "The names of the policy set definitions to remove from the base archetype.",
+							MarkdownDescription: "The names of the policy set definitions to remove from the base archetype.",
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+						"role_definitions_to_add": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Description:         "The names of the role definitions to add to the base archetype.",
+							MarkdownDescription: "The names of the role definitions to add to the base archetype.",
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+						"role_definitions_to_remove": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Description:         "The names of the role definitions to remove from the base archetype.",
+							MarkdownDescription: "The names of the role definitions to remove from the base archetype.",
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+					},
+					CustomType: ArchetypeOverridesType{
+						ObjectType: types.ObjectType{
+							AttrTypes: ArchetypeOverridesValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Optional:            true,
+				Description:         "A list of archetype overrides. Each override defines a new archetype from a base archetype, with assets added or removed. This is equivalent to an `*.alz_archetype_override.json` file in a library. The overrides are processed together with the last library in `library_references`, so they can be referenced by management groups in the architectures of that library, or in an inline architecture on `alz_architecture`. All referenced archetypes and assets must exist in the library.",
+				MarkdownDescription: "A list of archetype overrides. Each override defines a new archetype from a base archetype, with assets added or removed. This is equivalent to an `*.alz_archetype_override.json` file in a library. The overrides are processed together with the last library in `library_references`, so they can be referenced by management groups in the architectures of that library, or in an inline architecture on `alz_architecture`. All referenced archetypes and assets must exist in the library.",
+			},
+			"cache_file_max_age": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The maximum age of the cache file specified by `cache_file_name`, as a duration, e.g. `168h`. An older cache file is not used, so that built-in definitions are fetched from Azure and the cache file can be refreshed with `cache_file_save_enabled`. When `offline_enabled` is `true`, provider configuration fails instead. The age of cache files without metadata, e.g. those created by `alzlibtool`, is taken from their modification time. If not specified, cache files do not expire.",
+				MarkdownDescription: "The maximum age of the cache file specified by `cache_file_name`, as a duration, e.g. `168h`. An older cache file is not used, so that built-in definitions are fetched from Azure and the cache file can be refreshed with `cache_file_save_enabled`. When `offline_enabled` is `true`, provider configuration fails instead. The age of cache files without metadata, e.g. those created by `alzlibtool`, is taken from their modification time. If not specified, cache files do not expire.",
+				Validators: []validator.String{
+					alzvalidators.Duration(),
+				},
+			},
 			"cache_file_name": schema.StringAttribute{
 				Optional:            true,
-				Description:         "Path to a gzipped cache file (must end in `.gz`) containing built-in policy and policy set definitions. When set, the provider will load the cache from this file (if it exists) so that built-in definitions do not need to be fetched from Azure. Use `cache_file_save_enabled` to (re)write the cache file after the provider has been configured. Caches should be regularly updated to ensure no miscalculation for the policy role assignments, as new minor or patch versions of built-in policy definitions may be released.",
-				MarkdownDescription: "Path to a gzipped cache file (must end in `.gz`) containing built-in policy and policy set definitions. When set, the provider will load the cache from this file (if it exists) so that built-in definitions do not need to be fetched from Azure. Use `cache_file_save_enabled` to (re)write the cache file after the provider has been configured. Caches should be regularly updated to ensure no miscalculation for the policy role assignments, as new minor or patch versions of built-in policy definitions may be released.",
+				Description:         "Path to a gzipped cache file (must end in `.gz`) containing built-in policy and policy set definitions. When set, the provider will load the cache from this file (if it exists) so that built-in definitions do not need to be fetched from Azure. Use `cache_file_save_enabled` to (re)write the cache file with the definitions used by the `alz_architecture` data sources. The cache file records the cloud, creation time, provider and alzlib versions and a content hash of the definitions. Provider configuration fails if the cache file was created for a different cloud or its content has been modified, and warns if it was created by different provider or alzlib versions. Caches should be regularly updated to ensure no miscalculation for the policy role assignments, as new minor or patch versions of built-in policy definitions may be released, see `cache_file_max_age`.",
+				MarkdownDescription: "Path to a gzipped cache file (must end in `.gz`) containing built-in policy and policy set definitions. When set, the provider will load the cache from this file (if it exists) so that built-in definitions do not need to be fetched from Azure. Use `cache_file_save_enabled` to (re)write the cache file with the definitions used by the `alz_architecture` data sources. The cache file records the cloud, creation time, provider and alzlib versions and a content hash of the definitions. Provider configuration fails if the cache file was created for a different cloud or its content has been modified, and warns if it was created by different provider or alzlib versions. Caches should be regularly updated to ensure no miscalculation for the policy role assignments, as new minor or patch versions of built-in policy definitions may be released, see `cache_file_max_age`.",
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(regexp.MustCompile(`\.gz$`), "cache_file_name must end with .gz"),
 				},
 			},
+			"cache_file_prune_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether to only save the built-in policy and policy set definitions used by the `alz_architecture` data sources read in this run to the cache file, removing all others. Only the versions that are used are kept. Defaults to `false`. Requires `cache_file_save_enabled`.",
+				MarkdownDescription: "Whether to only save the built-in policy and policy set definitions used by the `alz_architecture` data sources read in this run to the cache file, removing all others. Only the versions that are used are kept. Defaults to `false`. Requires `cache_file_save_enabled`.",
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("cache_file_save_enabled")),
+				},
+			},
 			"cache_file_save_enabled": schema.BoolAttribute{
 				Optional:            true,
-				Description:         "Whether to (re)write the cache file specified by `cache_file_name` after the provider has been configured. When `true`, the built-in policy and policy set definitions loaded into the AlzLib will be exported and saved to the file. Defaults to `false`. Has no effect when `cache_file_name` is not set.",
-				MarkdownDescription: "Whether to (re)write the cache file specified by `cache_file_name` after the provider has been configured. When `true`, the built-in policy and policy set definitions loaded into the AlzLib will be exported and saved to the file. Defaults to `false`. Has no effect when `cache_file_name` is not set.",
+				Description:         "Whether to (re)write the cache file specified by `cache_file_name`. When `true`, the built-in policy and policy set definitions used by each `alz_architecture` data source are added to the cache file after the data source is read, together with the definitions already in the file. Defaults to `false`. Has no effect when `cache_file_name` is not set.",
+				MarkdownDescription: "Whether to (re)write the cache file specified by `cache_file_name`. When `true`, the built-in policy and policy set definitions used by each `alz_architecture` data source are added to the cache file after the data source is read, together with the definitions already in the file. Defaults to `false`. Has no effect when `cache_file_name` is not set.",
+			},
+			"library_dependency_conflict_resolution": schema.StringAttribute{
+				Optional:            true,
+				Description:         "How to resolve library dependencies that reference different refs of the same library, used when `library_fetch_dependencies` is enabled. Possible values are `all`, which loads every requested ref, `error`, which fails provider configuration, `highest`, which uses the highest ref, and `explicit`, which uses the ref from `library_references` and fails if the library is not in `library_references`. Default is `all`.",
+				MarkdownDescription: "How to resolve library dependencies that reference different refs of the same library, used when `library_fetch_dependencies` is enabled. Possible values are `all`, which loads every requested ref, `error`, which fails provider configuration, `highest`, which uses the highest ref, and `explicit`, which uses the ref from `library_references` and fails if the library is not in `library_references`. Default is `all`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "error", "highest", "explicit"),
+				},
 			},
 			"library_fetch_dependencies": schema.BoolAttribute{
 				Optional:            true,
 				Description:         "Whether to automatically fetch dependencies for the library. This option reads the `alz_library_metadata.json` file in any supplied library and will recursively download dependent libraries. Default is `true`.",
 				MarkdownDescription: "Whether to automatically fetch dependencies for the library. This option reads the `alz_library_metadata.json` file in any supplied library and will recursively download dependent libraries. Default is `true`.",
 			},
+			"library_lock_file_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Path to a JSON lock file that records the resolved library dependency tree and the content hash of every fetched library. When set and the file exists, provider configuration fails if the libraries or their content no longer match the lock file. If the file does not exist it is created. Use `library_lock_file_update_enabled` to regenerate the lock file.",
+				MarkdownDescription: "Path to a JSON lock file that records the resolved library dependency tree and the content hash of every fetched library. When set and the file exists, provider configuration fails if the libraries or their content no longer match the lock file. If the file does not exist it is created. Use `library_lock_file_update_enabled` to regenerate the lock file.",
+			},
+			"library_lock_file_update_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether to regenerate the lock file specified by `library_lock_file_name` from the fetched libraries instead of checking them against it. Defaults to `false`. Has no effect when `library_lock_file_name` is not set.",
+				MarkdownDescription: "Whether to regenerate the lock file specified by `library_lock_file_name` from the fetched libraries instead of checking them against it. Defaults to `false`. Has no effect when `library_lock_file_name` is not set.",
+			},
+			"library_manifest_file": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The path to a JSON or YAML file containing `library_references`, `library_fetch_dependencies` and `library_dependency_conflict_resolution` values, e.g. a manifest of approved libraries published by a platform team. Relative `local_path` and `signature_file` values in the file are resolved from the directory of the file. The library references from the file are merged with the inline `library_references`: an inline reference to the same library (the same `path`, `custom_url` or `local_path`) replaces the reference from the file, and other inline references are added after those from the file. Inline `library_fetch_dependencies` and `library_dependency_conflict_resolution` values take precedence over those in the file.",
+				MarkdownDescription: "The path to a JSON or YAML file containing `library_references`, `library_fetch_dependencies` and `library_dependency_conflict_resolution` values, e.g. a manifest of approved libraries published by a platform team. Relative `local_path` and `signature_file` values in the file are resolved from the directory of the file. The library references from the file are merged with the inline `library_references`: an inline reference to the same library (the same `path`, `custom_url` or `local_path`) replaces the reference from the file, and other inline references are added after those from the file. Inline `library_fetch_dependencies` and `library_dependency_conflict_resolution` values take precedence over those in the file.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"library_overwrite_enabled": schema.BoolAttribute{
 				Optional:            true,
-				Description:         "Whether to allow overwriting of the library by other lib directories. Default is `false`.",
-				MarkdownDescription: "Whether to allow overwriting of the library by other lib directories. Default is `false`.",
+				Description:         "Whether to allow overwriting of the library by other lib directories. The overwritten assets are reported by the `alz_metadata` data source. Default is `false`.",
+				MarkdownDescription: "Whether to allow overwriting of the library by other lib directories. The overwritten assets are reported by the `alz_metadata` data source. Default is `false`.",
 			},
 			"library_references": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
+						"credentials": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"http_headers": schema.MapAttribute{
+									ElementType:         types.StringType,
+									Optional:            true,
+									Sensitive:           true,
+									Description:         "A map of additional headers to send with HTTP(S) downloads, e.g. `{ \"PRIVATE-TOKEN\" = \"...\" }`. Not used for git clones. If not specified, value will be attempted to be read from the `ALZ_PROVIDER_LIBRARY_HTTP_HEADERS` environment variable, which contains one `Name: value` header per line.",
+									MarkdownDescription: "A map of additional headers to send with HTTP(S) downloads, e.g. `{ \"PRIVATE-TOKEN\" = \"...\" }`. Not used for git clones. If not specified, value will be attempted to be read from the `ALZ_PROVIDER_LIBRARY_HTTP_HEADERS` environment variable, which contains one `Name: value` header per line.",
+								},
+								"netrc_file": schema.StringAttribute{
+									Optional:            true,
+									Sensitive:           true,
+									Description:         "The path to a netrc file with the login and password for the library host, used for HTTP(S) downloads and HTTPS git clones. If not specified, value will be attempted to be read from the `ALZ_PROVIDER_LIBRARY_NETRC_FILE` environment variable.",
+									MarkdownDescription: "The path to a netrc file with the login and password for the library host, used for HTTP(S) downloads and HTTPS git clones. If not specified, value will be attempted to be read from the `ALZ_PROVIDER_LIBRARY_NETRC_FILE` environment variable.",
+								},
+								"ssh_key_path": schema.StringAttribute{
+									Optional:            true,
+									Sensitive:           true,
+									Description:         "The path to a private SSH key used for git clones over SSH. If not specified, value will be attempted to be read from the `ALZ_PROVIDER_LIBRARY_SSH_KEY_PATH` environment variable.",
+									MarkdownDescription: "The path to a private SSH key used for git clones over SSH. If not specified, value will be attempted to be read from the `ALZ_PROVIDER_LIBRARY_SSH_KEY_PATH` environment variable.",
+								},
+								"token": schema.StringAttribute{
+									Optional:            true,
+									Sensitive:           true,
+									Description:         "A token used to authenticate to the library host. It is sent as a bearer token in the `Authorization` header of HTTP(S) downloads, and as the password of HTTPS git clones. If not specified, value will be attempted to be read from the `ALZ_PROVIDER_LIBRARY_TOKEN` environment variable.",
+									MarkdownDescription: "A token used to authenticate to the library host. It is sent as a bearer token in the `Authorization` header of HTTP(S) downloads, and as the password of HTTPS git clones. If not specified, value will be attempted to be read from the `ALZ_PROVIDER_LIBRARY_TOKEN` environment variable.",
+								},
+							},
+							CustomType: CredentialsType{
+								ObjectType: types.ObjectType{
+									AttrTypes: CredentialsValue{}.AttributeTypes(ctx),
+								},
+							},
+							Optional:            true,
+							Description:         "Credentials used to fetch a private `custom_url` library, so that secrets do not need to be embedded in the URL. Values that are not specified are read from environment variables, which is also done for `custom_url` library references without this attribute. Also requires `custom_url`.",
+							MarkdownDescription: "Credentials used to fetch a private `custom_url` library, so that secrets do not need to be embedded in the URL. Values that are not specified are read from environment variables, which is also done for `custom_url` library references without this attribute. Also requires `custom_url`.",
+							Validators: []validator.Object{
+								objectvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("custom_url")),
+							},
+						},
 						"custom_url": schema.StringAttribute{
 							Optional:            true,
 							Sensitive:           true,
@@ -59,6 +247,15 @@ func AlzProviderSchema(ctx context.Context) schema.Schema {
 								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ref")),
 							},
 						},
+						"local_path": schema.StringAttribute{
+							Optional:            true,
+							Description:         "The path to a library directory on the local filesystem. Relative paths are resolved from the Terraform configuration directory, i.e. the directory that Terraform is run in, so the configuration is portable across machines. The library content is fingerprinted, so that changes to it are picked up when the provider is configured again in the same process. Conflicts with `path`, `ref` and `custom_url`.",
+							MarkdownDescription: "The path to a library directory on the local filesystem. Relative paths are resolved from the Terraform configuration directory, i.e. the directory that Terraform is run in, so the configuration is portable across machines. The library content is fingerprinted, so that changes to it are picked up when the provider is configured again in the same process. Conflicts with `path`, `ref` and `custom_url`.",
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("custom_url"), path.MatchRelative().AtParent().AtName("path"), path.MatchRelative().AtParent().AtName("ref")),
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
 						"path": schema.StringAttribute{
 							Optional:            true,
 							Description:         "The path in the ALZ Library, e.g. `platform/alz`. Also requires `ref`. Conflicts with `custom_url`.",
@@ -68,6 +265,11 @@ func AlzProviderSchema(ctx context.Context) schema.Schema {
 								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("ref")),
 							},
 						},
+						"public_key": schema.StringAttribute{
+							Optional:            true,
+							Description:         "A PEM encoded public key (Ed25519, ECDSA or RSA) that is trusted to sign the library. When set, the library must contain an `alz_library_manifest.json` file with the SHA-256 hash of every file in the library, and provider configuration fails unless the manifest signature is valid for this key and the library content matches the manifest. Verification is performed offline.",
+							MarkdownDescription: "A PEM encoded public key (Ed25519, ECDSA or RSA) that is trusted to sign the library. When set, the library must contain an `alz_library_manifest.json` file with the SHA-256 hash of every file in the library, and provider configuration fails unless the manifest signature is valid for this key and the library content matches the manifest. Verification is performed offline.",
+						},
 						"ref": schema.StringAttribute{
 							Optional:            true,
 							Description:         "This is the version of the library to use, e.g. `2024.07.5`. Also requires `path`. Conflicts with `custom_url`.",
@@ -77,6 +279,14 @@ func AlzProviderSchema(ctx context.Context) schema.Schema {
 								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("path")),
 							},
 						},
+						"signature_file": schema.StringAttribute{
+							Optional:            true,
+							Description:         "The path to a local file containing the base64 encoded detached signature of the library manifest. Defaults to the `alz_library_manifest.json.sig` file in the library. Also requires `public_key`.",
+							MarkdownDescription: "The path to a local file containing the base64 encoded detached signature of the library manifest. Defaults to the `alz_library_manifest.json.sig` file in the library. Also requires `public_key`.",
+							Validators: []validator.String{
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("public_key")),
+							},
+						},
 					},
 					CustomType: LibraryReferencesType{
 						ObjectType: types.ObjectType{
@@ -84,12 +294,13 @@ func AlzProviderSchema(ctx context.Context) schema.Schema {
 						},
 					},
 				},
-				Required:            true,
-				Description:         "A list of references to the [ALZ library](https://aka.ms/alz/library) to use. Each reference should either contain the `path` (e.g. `platform/alz`) and the `ref` (e.g. `2024.03.5`), or a `custom_url` to be supplied to go-getter.",
-				MarkdownDescription: "A list of references to the [ALZ library](https://aka.ms/alz/library) to use. Each reference should either contain the `path` (e.g. `platform/alz`) and the `ref` (e.g. `2024.03.5`), or a `custom_url` to be supplied to go-getter.",
+				Optional:            true,
+				Description:         "A list of references to the [ALZ library](https://aka.ms/alz/library) to use. Each reference should either contain the `path` (e.g. `platform/alz`) and the `ref` (e.g. `2024.03.5`), a `custom_url` to be supplied to go-getter, or a `local_path` to a directory. References can also be loaded from `library_manifest_file`. At least one of `library_references` or `library_manifest_file` must be set.",
+				MarkdownDescription: "A list of references to the [ALZ library](https://aka.ms/alz/library) to use. Each reference should either contain the `path` (e.g. `platform/alz`) and the `ref` (e.g. `2024.03.5`), a `custom_url` to be supplied to go-getter, or a `local_path` to a directory. References can also be loaded from `library_manifest_file`. At least one of `library_references` or `library_manifest_file` must be set.",
 				Validators: []validator.List{
 					listvalidator.UniqueValues(),
 					listvalidator.SizeAtLeast(1),
+					listvalidator.AtLeastOneOf(path.MatchRoot("library_manifest_file")),
 				},
 			},
 			"non_compliance_message_substitution_settings": schema.SingleNestedAttribute{
@@ -119,6 +330,14 @@ func AlzProviderSchema(ctx context.Context) schema.Schema {
 				Description:         "Global settings for non-compliance message placeholder substitutions. These control how placeholders in non-compliance messages are resolved based on the enforcement mode of policy assignments.",
 				MarkdownDescription: "Global settings for non-compliance message placeholder substitutions. These control how placeholders in non-compliance messages are resolved based on the enforcement mode of policy assignments.",
 			},
+			"offline_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether to forbid all Azure API calls. When `true`, the provider does not acquire a token or register resource providers, and every built-in policy and policy set definition referenced by an architecture must be in the cache file specified by `cache_file_name`, which must exist. Any that are missing are reported in an error. Defaults to `false`.",
+				MarkdownDescription: "Whether to forbid all Azure API calls. When `true`, the provider does not acquire a token or register resource providers, and every built-in policy and policy set definition referenced by an architecture must be in the cache file specified by `cache_file_name`, which must exist. Any that are missing are reported in an error. Defaults to `false`.",
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("cache_file_name")),
+				},
+			},
 			"role_definitions_use_supplied_names_enabled": schema.BoolAttribute{
 				Optional:            true,
 				Description:         "Whether to allow using the Name and RoleName supplied in the library directly for a predictable ID. Default behaviour is to update them to unique values per management group. Default is `false`.",
@@ -135,31 +354,1931 @@ func AlzProviderSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Should the provider omit the warning if it cannot create the full list of policy role assignments? Default is `false`. If not specified, value will be attempted to be read from the `ALZ_PROVIDER_SUPPRESS_WARNING_POLICY_ROLE_ASSIGNMENTS` environment variable.",
 			},
 		},
-		Description:         "ALZ provider to generate archetype data for use with the ALZ Terraform module.",
-		MarkdownDescription: "ALZ provider to generate archetype data for use with the ALZ Terraform module.",
+		Description:         "ALZ provider to generate archetype data for use with the ALZ Terraform module.",
+		MarkdownDescription: "ALZ provider to generate archetype data for use with the ALZ Terraform module.",
+	}
+}
+
+type AlzModel struct {
+	ArchetypeOverrides                       types.List                                    `tfsdk:"archetype_overrides"`
+	CacheFileMaxAge                          types.String                                  `tfsdk:"cache_file_max_age"`
+	CacheFileName                            types.String                                  `tfsdk:"cache_file_name"`
+	CacheFilePruneEnabled                    types.Bool                                    `tfsdk:"cache_file_prune_enabled"`
+	CacheFileSaveEnabled                     types.Bool                                    `tfsdk:"cache_file_save_enabled"`
+	LibraryDependencyConflictResolution      types.String                                  `tfsdk:"library_dependency_conflict_resolution"`
+	LibraryFetchDependencies                 types.Bool                                    `tfsdk:"library_fetch_dependencies"`
+	LibraryLockFileName                      types.String                                  `tfsdk:"library_lock_file_name"`
+	LibraryLockFileUpdateEnabled             types.Bool                                    `tfsdk:"library_lock_file_update_enabled"`
+	LibraryManifestFile                      types.String                                  `tfsdk:"library_manifest_file"`
+	LibraryOverwriteEnabled                  types.Bool                                    `tfsdk:"library_overwrite_enabled"`
+	LibraryReferences                        types.List                                    `tfsdk:"library_references"`
+	NonComplianceMessageSubstitutionSettings NonComplianceMessageSubstitutionSettingsValue `tfsdk:"non_compliance_message_substitution_settings"`
+	OfflineEnabled                           types.Bool                                    `tfsdk:"offline_enabled"`
+	RoleDefinitionsUseSuppliedNamesEnabled   types.Bool                                    `tfsdk:"role_definitions_use_supplied_names_enabled"`
+	SkipProviderRegistration                 types.Bool                                    `tfsdk:"skip_provider_registration"`
+	SuppressWarningPolicyRoleAssignments     types.Bool                                    `tfsdk:"suppress_warning_policy_role_assignments"`
+}
+
+var _ basetypes.ObjectTypable = ArchetypeOverridesType{}
+
+type ArchetypeOverridesType struct {
+	basetypes.ObjectType
+}
+
+func (t ArchetypeOverridesType) Equal(o attr.Type) bool {
+	other, ok := o.(ArchetypeOverridesType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t ArchetypeOverridesType) String() string {
+	return "ArchetypeOverridesType"
+}
+
+func (t ArchetypeOverridesType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	baseArchetypeAttribute, ok := attributes["base_archetype"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`base_archetype is missing from object`)
+
+		return nil, diags
+	}
+
+	baseArchetypeVal, ok := baseArchetypeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`base_archetype expected to be basetypes.StringValue, was: %T`, baseArchetypeAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return nil, diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	policyAssignmentsToAddAttribute, ok := attributes["policy_assignments_to_add"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignments_to_add is missing from object`)
+
+		return nil, diags
+	}
+
+	policyAssignmentsToAddVal, ok := policyAssignmentsToAddAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignments_to_add expected to be basetypes.SetValue, was: %T`, policyAssignmentsToAddAttribute))
+	}
+
+	policyAssignmentsToRemoveAttribute, ok := attributes["policy_assignments_to_remove"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignments_to_remove is missing from object`)
+
+		return nil, diags
+	}
+
+	policyAssignmentsToRemoveVal, ok := policyAssignmentsToRemoveAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignments_to_remove expected to be basetypes.SetValue, was: %T`, policyAssignmentsToRemoveAttribute))
+	}
+
+	policyDefinitionsToAddAttribute, ok := attributes["policy_definitions_to_add"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definitions_to_add is missing from object`)
+
+		return nil, diags
+	}
+
+	policyDefinitionsToAddVal, ok := policyDefinitionsToAddAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definitions_to_add expected to be basetypes.SetValue, was: %T`, policyDefinitionsToAddAttribute))
+	}
+
+	policyDefinitionsToRemoveAttribute, ok := attributes["policy_definitions_to_remove"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definitions_to_remove is missing from object`)
+
+		return nil, diags
+	}
+
+	policyDefinitionsToRemoveVal, ok := policyDefinitionsToRemoveAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definitions_to_remove expected to be basetypes.SetValue, was: %T`, policyDefinitionsToRemoveAttribute))
+	}
+
+	policySetDefinitionsToAddAttribute, ok := attributes["policy_set_definitions_to_add"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_set_definitions_to_add is missing from object`)
+
+		return nil, diags
+	}
+
+	policySetDefinitionsToAddVal, ok := policySetDefinitionsToAddAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_set_definitions_to_add expected to be basetypes.SetValue, was: %T`, policySetDefinitionsToAddAttribute))
+	}
+
+	policySetDefinitionsToRemoveAttribute, ok := attributes["policy_set_definitions_to_remove"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_set_definitions_to_remove is missing from object`)
+
+		return nil, diags
+	}
+
+	policySetDefinitionsToRemoveVal, ok := policySetDefinitionsToRemoveAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_set_definitions_to_remove expected to be basetypes.SetValue, was: %T`, policySetDefinitionsToRemoveAttribute))
+	}
+
+	roleDefinitionsToAddAttribute, ok := attributes["role_definitions_to_add"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`role_definitions_to_add is missing from object`)
+
+		return nil, diags
+	}
+
+	roleDefinitionsToAddVal, ok := roleDefinitionsToAddAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`role_definitions_to_add expected to be basetypes.SetValue, was: %T`, roleDefinitionsToAddAttribute))
+	}
+
+	roleDefinitionsToRemoveAttribute, ok := attributes["role_definitions_to_remove"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`role_definitions_to_remove is missing from object`)
+
+		return nil, diags
+	}
+
+	roleDefinitionsToRemoveVal, ok := roleDefinitionsToRemoveAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`role_definitions_to_remove expected to be basetypes.SetValue, was: %T`, roleDefinitionsToRemoveAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return ArchetypeOverridesValue{
+		BaseArchetype:                baseArchetypeVal,
+		Name:                         nameVal,
+		PolicyAssignmentsToAdd:       policyAssignmentsToAddVal,
+		PolicyAssignmentsToRemove:    policyAssignmentsToRemoveVal,
+		PolicyDefinitionsToAdd:       policyDefinitionsToAddVal,
+		PolicyDefinitionsToRemove:    policyDefinitionsToRemoveVal,
+		PolicySetDefinitionsToAdd:    policySetDefinitionsToAddVal,
+		PolicySetDefinitionsToRemove: policySetDefinitionsToRemoveVal,
+		RoleDefinitionsToAdd:         roleDefinitionsToAddVal,
+		RoleDefinitionsToRemove:      roleDefinitionsToRemoveVal,
+		state:                        attr.ValueStateKnown,
+	}, diags
+}
+
+func NewArchetypeOverridesValueNull() ArchetypeOverridesValue {
+	return ArchetypeOverridesValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewArchetypeOverridesValueUnknown() ArchetypeOverridesValue {
+	return ArchetypeOverridesValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewArchetypeOverridesValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ArchetypeOverridesValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing ArchetypeOverridesValue Attribute Value",
+				"While creating a ArchetypeOverridesValue value, a missing attribute value was detected. "+
+					"A ArchetypeOverridesValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ArchetypeOverridesValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid ArchetypeOverridesValue Attribute Type",
+				"While creating a ArchetypeOverridesValue value, an invalid attribute value was detected. "+
+					"A ArchetypeOverridesValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ArchetypeOverridesValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("ArchetypeOverridesValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra ArchetypeOverridesValue Attribute Value",
+				"While creating a ArchetypeOverridesValue value, an extra attribute value was detected. "+
+					"A ArchetypeOverridesValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra ArchetypeOverridesValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewArchetypeOverridesValueUnknown(), diags
+	}
+
+	baseArchetypeAttribute, ok := attributes["base_archetype"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`base_archetype is missing from object`)
+
+		return NewArchetypeOverridesValueUnknown(), diags
+	}
+
+	baseArchetypeVal, ok := baseArchetypeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`base_archetype expected to be basetypes.StringValue, was: %T`, baseArchetypeAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return NewArchetypeOverridesValueUnknown(), diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	policyAssignmentsToAddAttribute, ok := attributes["policy_assignments_to_add"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignments_to_add is missing from object`)
+
+		return NewArchetypeOverridesValueUnknown(), diags
+	}
+
+	policyAssignmentsToAddVal, ok := policyAssignmentsToAddAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignments_to_add expected to be basetypes.SetValue, was: %T`, policyAssignmentsToAddAttribute))
+	}
+
+	policyAssignmentsToRemoveAttribute, ok := attributes["policy_assignments_to_remove"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignments_to_remove is missing from object`)
+
+		return NewArchetypeOverridesValueUnknown(), diags
+	}
+
+	policyAssignmentsToRemoveVal, ok := policyAssignmentsToRemoveAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignments_to_remove expected to be basetypes.SetValue, was: %T`, policyAssignmentsToRemoveAttribute))
+	}
+
+	policyDefinitionsToAddAttribute, ok := attributes["policy_definitions_to_add"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definitions_to_add is missing from object`)
+
+		return NewArchetypeOverridesValueUnknown(), diags
+	}
+
+	policyDefinitionsToAddVal, ok := policyDefinitionsToAddAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definitions_to_add expected to be basetypes.SetValue, was: %T`, policyDefinitionsToAddAttribute))
+	}
+
+	policyDefinitionsToRemoveAttribute, ok := attributes["policy_definitions_to_remove"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definitions_to_remove is missing from object`)
+
+		return NewArchetypeOverridesValueUnknown(), diags
+	}
+
+	policyDefinitionsToRemoveVal, ok := policyDefinitionsToRemoveAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definitions_to_remove expected to be basetypes.SetValue, was: %T`, policyDefinitionsToRemoveAttribute))
+	}
+
+	policySetDefinitionsToAddAttribute, ok := attributes["policy_set_definitions_to_add"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_set_definitions_to_add is missing from object`)
+
+		return NewArchetypeOverridesValueUnknown(), diags
+	}
+
+	policySetDefinitionsToAddVal, ok := policySetDefinitionsToAddAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_set_definitions_to_add expected to be basetypes.SetValue, was: %T`, policySetDefinitionsToAddAttribute))
+	}
+
+	policySetDefinitionsToRemoveAttribute, ok := attributes["policy_set_definitions_to_remove"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_set_definitions_to_remove is missing from object`)
+
+		return NewArchetypeOverridesValueUnknown(), diags
+	}
+
+	policySetDefinitionsToRemoveVal, ok := policySetDefinitionsToRemoveAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_set_definitions_to_remove expected to be basetypes.SetValue, was: %T`, policySetDefinitionsToRemoveAttribute))
+	}
+
+	roleDefinitionsToAddAttribute, ok := attributes["role_definitions_to_add"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`role_definitions_to_add is missing from object`)
+
+		return NewArchetypeOverridesValueUnknown(), diags
+	}
+
+	roleDefinitionsToAddVal, ok := roleDefinitionsToAddAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`role_definitions_to_add expected to be basetypes.SetValue, was: %T`, roleDefinitionsToAddAttribute))
+	}
+
+	roleDefinitionsToRemoveAttribute, ok := attributes["role_definitions_to_remove"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`role_definitions_to_remove is missing from object`)
+
+		return NewArchetypeOverridesValueUnknown(), diags
+	}
+
+	roleDefinitionsToRemoveVal, ok := roleDefinitionsToRemoveAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`role_definitions_to_remove expected to be basetypes.SetValue, was: %T`, roleDefinitionsToRemoveAttribute))
+	}
+
+	if diags.HasError() {
+		return NewArchetypeOverridesValueUnknown(), diags
+	}
+
+	return ArchetypeOverridesValue{
+		BaseArchetype:                baseArchetypeVal,
+		Name:                         nameVal,
+		PolicyAssignmentsToAdd:       policyAssignmentsToAddVal,
+		PolicyAssignmentsToRemove:    policyAssignmentsToRemoveVal,
+		PolicyDefinitionsToAdd:       policyDefinitionsToAddVal,
+		PolicyDefinitionsToRemove:    policyDefinitionsToRemoveVal,
+		PolicySetDefinitionsToAdd:    policySetDefinitionsToAddVal,
+		PolicySetDefinitionsToRemove: policySetDefinitionsToRemoveVal,
+		RoleDefinitionsToAdd:         roleDefinitionsToAddVal,
+		RoleDefinitionsToRemove:      roleDefinitionsToRemoveVal,
+		state:                        attr.ValueStateKnown,
+	}, diags
+}
+
+func NewArchetypeOverridesValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ArchetypeOverridesValue {
+	object, diags := NewArchetypeOverridesValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewArchetypeOverridesValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t ArchetypeOverridesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewArchetypeOverridesValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewArchetypeOverridesValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewArchetypeOverridesValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewArchetypeOverridesValueMust(ArchetypeOverridesValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t ArchetypeOverridesType) ValueType(ctx context.Context) attr.Value {
+	return ArchetypeOverridesValue{}
+}
+
+var _ basetypes.ObjectValuable = ArchetypeOverridesValue{}
+
+type ArchetypeOverridesValue struct {
+	BaseArchetype                basetypes.StringValue `tfsdk:"base_archetype"`
+	Name                         basetypes.StringValue `tfsdk:"name"`
+	PolicyAssignmentsToAdd       basetypes.SetValue    `tfsdk:"policy_assignments_to_add"`
+	PolicyAssignmentsToRemove    basetypes.SetValue    `tfsdk:"policy_assignments_to_remove"`
+	PolicyDefinitionsToAdd       basetypes.SetValue    `tfsdk:"policy_definitions_to_add"`
+	PolicyDefinitionsToRemove    basetypes.SetValue    `tfsdk:"policy_definitions_to_remove"`
+	PolicySetDefinitionsToAdd    basetypes.SetValue    `tfsdk:"policy_set_definitions_to_add"`
+	PolicySetDefinitionsToRemove basetypes.SetValue    `tfsdk:"policy_set_definitions_to_remove"`
+	RoleDefinitionsToAdd         basetypes.SetValue    `tfsdk:"role_definitions_to_add"`
+	RoleDefinitionsToRemove      basetypes.SetValue    `tfsdk:"role_definitions_to_remove"`
+	state                        attr.ValueState
+}
+
+func (v ArchetypeOverridesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 10)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["base_archetype"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["policy_assignments_to_add"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["policy_assignments_to_remove"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["policy_definitions_to_add"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["policy_definitions_to_remove"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["policy_set_definitions_to_add"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["policy_set_definitions_to_remove"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["role_definitions_to_add"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["role_definitions_to_remove"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 10)
+
+		val, err = v.BaseArchetype.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["base_archetype"] = val
+
+		val, err = v.Name.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["name"] = val
+
+		val, err = v.PolicyAssignmentsToAdd.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_assignments_to_add"] = val
+
+		val, err = v.PolicyAssignmentsToRemove.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_assignments_to_remove"] = val
+
+		val, err = v.PolicyDefinitionsToAdd.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_definitions_to_add"] = val
+
+		val, err = v.PolicyDefinitionsToRemove.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_definitions_to_remove"] = val
+
+		val, err = v.PolicySetDefinitionsToAdd.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_set_definitions_to_add"] = val
+
+		val, err = v.PolicySetDefinitionsToRemove.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_set_definitions_to_remove"] = val
+
+		val, err = v.RoleDefinitionsToAdd.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["role_definitions_to_add"] = val
+
+		val, err = v.RoleDefinitionsToRemove.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["role_definitions_to_remove"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v ArchetypeOverridesValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v ArchetypeOverridesValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v ArchetypeOverridesValue) String() string {
+	return "ArchetypeOverridesValue"
+}
+
+func (v ArchetypeOverridesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var policyAssignmentsToAddVal basetypes.SetValue
+	switch {
+	case v.PolicyAssignmentsToAdd.IsUnknown():
+		policyAssignmentsToAddVal = types.SetUnknown(types.StringType)
+	case v.PolicyAssignmentsToAdd.IsNull():
+		policyAssignmentsToAddVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policyAssignmentsToAddVal, d = types.SetValue(types.StringType, v.PolicyAssignmentsToAdd.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"base_archetype": basetypes.StringType{},
+			"name":           basetypes.StringType{},
+			"policy_assignments_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var policyAssignmentsToRemoveVal basetypes.SetValue
+	switch {
+	case v.PolicyAssignmentsToRemove.IsUnknown():
+		policyAssignmentsToRemoveVal = types.SetUnknown(types.StringType)
+	case v.PolicyAssignmentsToRemove.IsNull():
+		policyAssignmentsToRemoveVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policyAssignmentsToRemoveVal, d = types.SetValue(types.StringType, v.PolicyAssignmentsToRemove.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"base_archetype": basetypes.StringType{},
+			"name":           basetypes.StringType{},
+			"policy_assignments_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var policyDefinitionsToAddVal basetypes.SetValue
+	switch {
+	case v.PolicyDefinitionsToAdd.IsUnknown():
+		policyDefinitionsToAddVal = types.SetUnknown(types.StringType)
+	case v.PolicyDefinitionsToAdd.IsNull():
+		policyDefinitionsToAddVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policyDefinitionsToAddVal, d = types.SetValue(types.StringType, v.PolicyDefinitionsToAdd.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"base_archetype": basetypes.StringType{},
+			"name":           basetypes.StringType{},
+			"policy_assignments_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var policyDefinitionsToRemoveVal basetypes.SetValue
+	switch {
+	case v.PolicyDefinitionsToRemove.IsUnknown():
+		policyDefinitionsToRemoveVal = types.SetUnknown(types.StringType)
+	case v.PolicyDefinitionsToRemove.IsNull():
+		policyDefinitionsToRemoveVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policyDefinitionsToRemoveVal, d = types.SetValue(types.StringType, v.PolicyDefinitionsToRemove.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"base_archetype": basetypes.StringType{},
+			"name":           basetypes.StringType{},
+			"policy_assignments_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var policySetDefinitionsToAddVal basetypes.SetValue
+	switch {
+	case v.PolicySetDefinitionsToAdd.IsUnknown():
+		policySetDefinitionsToAddVal = types.SetUnknown(types.StringType)
+	case v.PolicySetDefinitionsToAdd.IsNull():
+		policySetDefinitionsToAddVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policySetDefinitionsToAddVal, d = types.SetValue(types.StringType, v.PolicySetDefinitionsToAdd.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"base_archetype": basetypes.StringType{},
+			"name":           basetypes.StringType{},
+			"policy_assignments_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var policySetDefinitionsToRemoveVal basetypes.SetValue
+	switch {
+	case v.PolicySetDefinitionsToRemove.IsUnknown():
+		policySetDefinitionsToRemoveVal = types.SetUnknown(types.StringType)
+	case v.PolicySetDefinitionsToRemove.IsNull():
+		policySetDefinitionsToRemoveVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policySetDefinitionsToRemoveVal, d = types.SetValue(types.StringType, v.PolicySetDefinitionsToRemove.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"base_archetype": basetypes.StringType{},
+			"name":           basetypes.StringType{},
+			"policy_assignments_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var roleDefinitionsToAddVal basetypes.SetValue
+	switch {
+	case v.RoleDefinitionsToAdd.IsUnknown():
+		roleDefinitionsToAddVal = types.SetUnknown(types.StringType)
+	case v.RoleDefinitionsToAdd.IsNull():
+		roleDefinitionsToAddVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		roleDefinitionsToAddVal, d = types.SetValue(types.StringType, v.RoleDefinitionsToAdd.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"base_archetype": basetypes.StringType{},
+			"name":           basetypes.StringType{},
+			"policy_assignments_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var roleDefinitionsToRemoveVal basetypes.SetValue
+	switch {
+	case v.RoleDefinitionsToRemove.IsUnknown():
+		roleDefinitionsToRemoveVal = types.SetUnknown(types.StringType)
+	case v.RoleDefinitionsToRemove.IsNull():
+		roleDefinitionsToRemoveVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		roleDefinitionsToRemoveVal, d = types.SetValue(types.StringType, v.RoleDefinitionsToRemove.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"base_archetype": basetypes.StringType{},
+			"name":           basetypes.StringType{},
+			"policy_assignments_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_add": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"role_definitions_to_remove": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"base_archetype": basetypes.StringType{},
+		"name":           basetypes.StringType{},
+		"policy_assignments_to_add": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"policy_assignments_to_remove": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"policy_definitions_to_add": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"policy_definitions_to_remove": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"policy_set_definitions_to_add": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"policy_set_definitions_to_remove": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"role_definitions_to_add": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"role_definitions_to_remove": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"base_archetype":                   v.BaseArchetype,
+			"name":                             v.Name,
+			"policy_assignments_to_add":        policyAssignmentsToAddVal,
+			"policy_assignments_to_remove":     policyAssignmentsToRemoveVal,
+			"policy_definitions_to_add":        policyDefinitionsToAddVal,
+			"policy_definitions_to_remove":     policyDefinitionsToRemoveVal,
+			"policy_set_definitions_to_add":    policySetDefinitionsToAddVal,
+			"policy_set_definitions_to_remove": policySetDefinitionsToRemoveVal,
+			"role_definitions_to_add":          roleDefinitionsToAddVal,
+			"role_definitions_to_remove":       roleDefinitionsToRemoveVal,
+		})
+
+	return objVal, diags
+}
+
+func (v ArchetypeOverridesValue) Equal(o attr.Value) bool {
+	other, ok := o.(ArchetypeOverridesValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.BaseArchetype.Equal(other.BaseArchetype) {
+		return false
+	}
+
+	if !v.Name.Equal(other.Name) {
+		return false
+	}
+
+	if !v.PolicyAssignmentsToAdd.Equal(other.PolicyAssignmentsToAdd) {
+		return false
+	}
+
+	if !v.PolicyAssignmentsToRemove.Equal(other.PolicyAssignmentsToRemove) {
+		return false
+	}
+
+	if !v.PolicyDefinitionsToAdd.Equal(other.PolicyDefinitionsToAdd) {
+		return false
+	}
+
+	if !v.PolicyDefinitionsToRemove.Equal(other.PolicyDefinitionsToRemove) {
+		return false
+	}
+
+	if !v.PolicySetDefinitionsToAdd.Equal(other.PolicySetDefinitionsToAdd) {
+		return false
+	}
+
+	if !v.PolicySetDefinitionsToRemove.Equal(other.PolicySetDefinitionsToRemove) {
+		return false
+	}
+
+	if !v.RoleDefinitionsToAdd.Equal(other.RoleDefinitionsToAdd) {
+		return false
+	}
+
+	if !v.RoleDefinitionsToRemove.Equal(other.RoleDefinitionsToRemove) {
+		return false
+	}
+
+	return true
+}
+
+func (v ArchetypeOverridesValue) Type(ctx context.Context) attr.Type {
+	return ArchetypeOverridesType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v ArchetypeOverridesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"base_archetype": basetypes.StringType{},
+		"name":           basetypes.StringType{},
+		"policy_assignments_to_add": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"policy_assignments_to_remove": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"policy_definitions_to_add": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"policy_definitions_to_remove": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"policy_set_definitions_to_add": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"policy_set_definitions_to_remove": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"role_definitions_to_add": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"role_definitions_to_remove": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+	}
+}
+
+var _ basetypes.ObjectTypable = LibraryReferencesType{}
+
+type LibraryReferencesType struct {
+	basetypes.ObjectType
+}
+
+func (t LibraryReferencesType) Equal(o attr.Type) bool {
+	other, ok := o.(LibraryReferencesType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t LibraryReferencesType) String() string {
+	return "LibraryReferencesType"
+}
+
+func (t LibraryReferencesType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	credentialsAttribute, ok := attributes["credentials"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`credentials is missing from object`)
+
+		return nil, diags
+	}
+
+	credentialsVal, ok := credentialsAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`credentials expected to be basetypes.ObjectValue, was: %T`, credentialsAttribute))
+	}
+
+	customUrlAttribute, ok := attributes["custom_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`custom_url is missing from object`)
+
+		return nil, diags
+	}
+
+	customUrlVal, ok := customUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`custom_url expected to be basetypes.StringValue, was: %T`, customUrlAttribute))
+	}
+
+	localPathAttribute, ok := attributes["local_path"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`local_path is missing from object`)
+
+		return nil, diags
+	}
+
+	localPathVal, ok := localPathAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`local_path expected to be basetypes.StringValue, was: %T`, localPathAttribute))
+	}
+
+	pathAttribute, ok := attributes["path"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`path is missing from object`)
+
+		return nil, diags
+	}
+
+	pathVal, ok := pathAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`path expected to be basetypes.StringValue, was: %T`, pathAttribute))
+	}
+
+	publicKeyAttribute, ok := attributes["public_key"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`public_key is missing from object`)
+
+		return nil, diags
+	}
+
+	publicKeyVal, ok := publicKeyAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`public_key expected to be basetypes.StringValue, was: %T`, publicKeyAttribute))
+	}
+
+	refAttribute, ok := attributes["ref"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ref is missing from object`)
+
+		return nil, diags
+	}
+
+	refVal, ok := refAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ref expected to be basetypes.StringValue, was: %T`, refAttribute))
+	}
+
+	signatureFileAttribute, ok := attributes["signature_file"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`signature_file is missing from object`)
+
+		return nil, diags
+	}
+
+	signatureFileVal, ok := signatureFileAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`signature_file expected to be basetypes.StringValue, was: %T`, signatureFileAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return LibraryReferencesValue{
+		Credentials:   credentialsVal,
+		CustomUrl:     customUrlVal,
+		LocalPath:     localPathVal,
+		Path:          pathVal,
+		PublicKey:     publicKeyVal,
+		Ref:           refVal,
+		SignatureFile: signatureFileVal,
+		state:         attr.ValueStateKnown,
+	}, diags
+}
+
+func NewLibraryReferencesValueNull() LibraryReferencesValue {
+	return LibraryReferencesValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewLibraryReferencesValueUnknown() LibraryReferencesValue {
+	return LibraryReferencesValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewLibraryReferencesValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (LibraryReferencesValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing LibraryReferencesValue Attribute Value",
+				"While creating a LibraryReferencesValue value, a missing attribute value was detected. "+
+					"A LibraryReferencesValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("LibraryReferencesValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid LibraryReferencesValue Attribute Type",
+				"While creating a LibraryReferencesValue value, an invalid attribute value was detected. "+
+					"A LibraryReferencesValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("LibraryReferencesValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("LibraryReferencesValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra LibraryReferencesValue Attribute Value",
+				"While creating a LibraryReferencesValue value, an extra attribute value was detected. "+
+					"A LibraryReferencesValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra LibraryReferencesValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewLibraryReferencesValueUnknown(), diags
+	}
+
+	credentialsAttribute, ok := attributes["credentials"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`credentials is missing from object`)
+
+		return NewLibraryReferencesValueUnknown(), diags
+	}
+
+	credentialsVal, ok := credentialsAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`credentials expected to be basetypes.ObjectValue, was: %T`, credentialsAttribute))
+	}
+
+	customUrlAttribute, ok := attributes["custom_url"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`custom_url is missing from object`)
+
+		return NewLibraryReferencesValueUnknown(), diags
+	}
+
+	customUrlVal, ok := customUrlAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`custom_url expected to be basetypes.StringValue, was: %T`, customUrlAttribute))
+	}
+
+	localPathAttribute, ok := attributes["local_path"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`local_path is missing from object`)
+
+		return NewLibraryReferencesValueUnknown(), diags
+	}
+
+	localPathVal, ok := localPathAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`local_path expected to be basetypes.StringValue, was: %T`, localPathAttribute))
+	}
+
+	pathAttribute, ok := attributes["path"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`path is missing from object`)
+
+		return NewLibraryReferencesValueUnknown(), diags
+	}
+
+	pathVal, ok := pathAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`path expected to be basetypes.StringValue, was: %T`, pathAttribute))
+	}
+
+	publicKeyAttribute, ok := attributes["public_key"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`public_key is missing from object`)
+
+		return NewLibraryReferencesValueUnknown(), diags
+	}
+
+	publicKeyVal, ok := publicKeyAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`public_key expected to be basetypes.StringValue, was: %T`, publicKeyAttribute))
+	}
+
+	refAttribute, ok := attributes["ref"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ref is missing from object`)
+
+		return NewLibraryReferencesValueUnknown(), diags
+	}
+
+	refVal, ok := refAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ref expected to be basetypes.StringValue, was: %T`, refAttribute))
+	}
+
+	signatureFileAttribute, ok := attributes["signature_file"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`signature_file is missing from object`)
+
+		return NewLibraryReferencesValueUnknown(), diags
+	}
+
+	signatureFileVal, ok := signatureFileAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`signature_file expected to be basetypes.StringValue, was: %T`, signatureFileAttribute))
+	}
+
+	if diags.HasError() {
+		return NewLibraryReferencesValueUnknown(), diags
+	}
+
+	return LibraryReferencesValue{
+		Credentials:   credentialsVal,
+		CustomUrl:     customUrlVal,
+		LocalPath:     localPathVal,
+		Path:          pathVal,
+		PublicKey:     publicKeyVal,
+		Ref:           refVal,
+		SignatureFile: signatureFileVal,
+		state:         attr.ValueStateKnown,
+	}, diags
+}
+
+func NewLibraryReferencesValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) LibraryReferencesValue {
+	object, diags := NewLibraryReferencesValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewLibraryReferencesValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t LibraryReferencesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewLibraryReferencesValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewLibraryReferencesValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewLibraryReferencesValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewLibraryReferencesValueMust(LibraryReferencesValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t LibraryReferencesType) ValueType(ctx context.Context) attr.Value {
+	return LibraryReferencesValue{}
+}
+
+var _ basetypes.ObjectValuable = LibraryReferencesValue{}
+
+type LibraryReferencesValue struct {
+	Credentials   basetypes.ObjectValue `tfsdk:"credentials"`
+	CustomUrl     basetypes.StringValue `tfsdk:"custom_url"`
+	LocalPath     basetypes.StringValue `tfsdk:"local_path"`
+	Path          basetypes.StringValue `tfsdk:"path"`
+	PublicKey     basetypes.StringValue `tfsdk:"public_key"`
+	Ref           basetypes.StringValue `tfsdk:"ref"`
+	SignatureFile basetypes.StringValue `tfsdk:"signature_file"`
+	state         attr.ValueState
+}
+
+func (v LibraryReferencesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 7)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["credentials"] = basetypes.ObjectType{
+		AttrTypes: CredentialsValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
+	attrTypes["custom_url"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["local_path"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["path"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["public_key"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["ref"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["signature_file"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 7)
+
+		val, err = v.Credentials.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["credentials"] = val
+
+		val, err = v.CustomUrl.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["custom_url"] = val
+
+		val, err = v.LocalPath.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["local_path"] = val
+
+		val, err = v.Path.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["path"] = val
+
+		val, err = v.PublicKey.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["public_key"] = val
+
+		val, err = v.Ref.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["ref"] = val
+
+		val, err = v.SignatureFile.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["signature_file"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v LibraryReferencesValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v LibraryReferencesValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v LibraryReferencesValue) String() string {
+	return "LibraryReferencesValue"
+}
+
+func (v LibraryReferencesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var credentials basetypes.ObjectValue
+
+	if v.Credentials.IsNull() {
+		credentials = types.ObjectNull(
+			CredentialsValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if v.Credentials.IsUnknown() {
+		credentials = types.ObjectUnknown(
+			CredentialsValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if !v.Credentials.IsNull() && !v.Credentials.IsUnknown() {
+		credentials = types.ObjectValueMust(
+			CredentialsValue{}.AttributeTypes(ctx),
+			v.Credentials.Attributes(),
+		)
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"credentials": basetypes.ObjectType{
+			AttrTypes: CredentialsValue{}.AttributeTypes(ctx),
+		},
+		"custom_url":     basetypes.StringType{},
+		"local_path":     basetypes.StringType{},
+		"path":           basetypes.StringType{},
+		"public_key":     basetypes.StringType{},
+		"ref":            basetypes.StringType{},
+		"signature_file": basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
 	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"credentials":    credentials,
+			"custom_url":     v.CustomUrl,
+			"local_path":     v.LocalPath,
+			"path":           v.Path,
+			"public_key":     v.PublicKey,
+			"ref":            v.Ref,
+			"signature_file": v.SignatureFile,
+		})
+
+	return objVal, diags
 }
 
-type AlzModel struct {
-	CacheFileName                            types.String                                  `tfsdk:"cache_file_name"`
-	CacheFileSaveEnabled                     types.Bool                                    `tfsdk:"cache_file_save_enabled"`
-	LibraryFetchDependencies                 types.Bool                                    `tfsdk:"library_fetch_dependencies"`
-	LibraryOverwriteEnabled                  types.Bool                                    `tfsdk:"library_overwrite_enabled"`
-	LibraryReferences                        types.List                                    `tfsdk:"library_references"`
-	NonComplianceMessageSubstitutionSettings NonComplianceMessageSubstitutionSettingsValue `tfsdk:"non_compliance_message_substitution_settings"`
-	RoleDefinitionsUseSuppliedNamesEnabled   types.Bool                                    `tfsdk:"role_definitions_use_supplied_names_enabled"`
-	SkipProviderRegistration                 types.Bool                                    `tfsdk:"skip_provider_registration"`
-	SuppressWarningPolicyRoleAssignments     types.Bool                                    `tfsdk:"suppress_warning_policy_role_assignments"`
+func (v LibraryReferencesValue) Equal(o attr.Value) bool {
+	other, ok := o.(LibraryReferencesValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Credentials.Equal(other.Credentials) {
+		return false
+	}
+
+	if !v.CustomUrl.Equal(other.CustomUrl) {
+		return false
+	}
+
+	if !v.LocalPath.Equal(other.LocalPath) {
+		return false
+	}
+
+	if !v.Path.Equal(other.Path) {
+		return false
+	}
+
+	if !v.PublicKey.Equal(other.PublicKey) {
+		return false
+	}
+
+	if !v.Ref.Equal(other.Ref) {
+		return false
+	}
+
+	if !v.SignatureFile.Equal(other.SignatureFile) {
+		return false
+	}
+
+	return true
 }
 
-var _ basetypes.ObjectTypable = LibraryReferencesType{}
+func (v LibraryReferencesValue) Type(ctx context.Context) attr.Type {
+	return LibraryReferencesType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
 
-type LibraryReferencesType struct {
+func (v LibraryReferencesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"credentials": basetypes.ObjectType{
+			AttrTypes: CredentialsValue{}.AttributeTypes(ctx),
+		},
+		"custom_url":     basetypes.StringType{},
+		"local_path":     basetypes.StringType{},
+		"path":           basetypes.StringType{},
+		"public_key":     basetypes.StringType{},
+		"ref":            basetypes.StringType{},
+		"signature_file": basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = CredentialsType{}
+
+type CredentialsType struct {
 	basetypes.ObjectType
 }
 
-func (t LibraryReferencesType) Equal(o attr.Type) bool {
-	other, ok := o.(LibraryReferencesType)
+func (t CredentialsType) Equal(o attr.Type) bool {
+	other, ok := o.(CredentialsType)
 
 	if !ok {
 		return false
@@ -168,94 +2287,113 @@ func (t LibraryReferencesType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t LibraryReferencesType) String() string {
-	return "LibraryReferencesType"
+func (t CredentialsType) String() string {
+	return "CredentialsType"
 }
 
-func (t LibraryReferencesType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t CredentialsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	customUrlAttribute, ok := attributes["custom_url"]
+	httpHeadersAttribute, ok := attributes["http_headers"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`custom_url is missing from object`)
+			`http_headers is missing from object`)
 
 		return nil, diags
 	}
 
-	customUrlVal, ok := customUrlAttribute.(basetypes.StringValue)
+	httpHeadersVal, ok := httpHeadersAttribute.(basetypes.MapValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`custom_url expected to be basetypes.StringValue, was: %T`, customUrlAttribute))
+			fmt.Sprintf(`http_headers expected to be basetypes.MapValue, was: %T`, httpHeadersAttribute))
 	}
 
-	pathAttribute, ok := attributes["path"]
+	netrcFileAttribute, ok := attributes["netrc_file"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`path is missing from object`)
+			`netrc_file is missing from object`)
 
 		return nil, diags
 	}
 
-	pathVal, ok := pathAttribute.(basetypes.StringValue)
+	netrcFileVal, ok := netrcFileAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`path expected to be basetypes.StringValue, was: %T`, pathAttribute))
+			fmt.Sprintf(`netrc_file expected to be basetypes.StringValue, was: %T`, netrcFileAttribute))
 	}
 
-	refAttribute, ok := attributes["ref"]
+	sshKeyPathAttribute, ok := attributes["ssh_key_path"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`ref is missing from object`)
+			`ssh_key_path is missing from object`)
 
 		return nil, diags
 	}
 
-	refVal, ok := refAttribute.(basetypes.StringValue)
+	sshKeyPathVal, ok := sshKeyPathAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`ref expected to be basetypes.StringValue, was: %T`, refAttribute))
+			fmt.Sprintf(`ssh_key_path expected to be basetypes.StringValue, was: %T`, sshKeyPathAttribute))
+	}
+
+	tokenAttribute, ok := attributes["token"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`token is missing from object`)
+
+		return nil, diags
+	}
+
+	tokenVal, ok := tokenAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`token expected to be basetypes.StringValue, was: %T`, tokenAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return LibraryReferencesValue{
-		CustomUrl: customUrlVal,
-		Path:      pathVal,
-		Ref:       refVal,
-		state:     attr.ValueStateKnown,
+	return CredentialsValue{
+		HttpHeaders: httpHeadersVal,
+		NetrcFile:   netrcFileVal,
+		SshKeyPath:  sshKeyPathVal,
+		Token:       tokenVal,
+		state:       attr.ValueStateKnown,
 	}, diags
 }
 
-func NewLibraryReferencesValueNull() LibraryReferencesValue {
-	return LibraryReferencesValue{
+func NewCredentialsValueNull() CredentialsValue {
+	return CredentialsValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewLibraryReferencesValueUnknown() LibraryReferencesValue {
-	return LibraryReferencesValue{
+func NewCredentialsValueUnknown() CredentialsValue {
+	return CredentialsValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewLibraryReferencesValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (LibraryReferencesValue, diag.Diagnostics) {
+func NewCredentialsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (CredentialsValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -266,11 +2404,11 @@ func NewLibraryReferencesValue(attributeTypes map[string]attr.Type, attributes m
 
 		if !ok {
 			diags.AddError(
-				"Missing LibraryReferencesValue Attribute Value",
-				"While creating a LibraryReferencesValue value, a missing attribute value was detected. "+
-					"A LibraryReferencesValue must contain values for all attributes, even if null or unknown. "+
+				"Missing CredentialsValue Attribute Value",
+				"While creating a CredentialsValue value, a missing attribute value was detected. "+
+					"A CredentialsValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("LibraryReferencesValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("CredentialsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -278,12 +2416,12 @@ func NewLibraryReferencesValue(attributeTypes map[string]attr.Type, attributes m
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid LibraryReferencesValue Attribute Type",
-				"While creating a LibraryReferencesValue value, an invalid attribute value was detected. "+
-					"A LibraryReferencesValue must use a matching attribute type for the value. "+
+				"Invalid CredentialsValue Attribute Type",
+				"While creating a CredentialsValue value, an invalid attribute value was detected. "+
+					"A CredentialsValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("LibraryReferencesValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("LibraryReferencesValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("CredentialsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("CredentialsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -293,87 +2431,106 @@ func NewLibraryReferencesValue(attributeTypes map[string]attr.Type, attributes m
 
 		if !ok {
 			diags.AddError(
-				"Extra LibraryReferencesValue Attribute Value",
-				"While creating a LibraryReferencesValue value, an extra attribute value was detected. "+
-					"A LibraryReferencesValue must not contain values beyond the expected attribute types. "+
+				"Extra CredentialsValue Attribute Value",
+				"While creating a CredentialsValue value, an extra attribute value was detected. "+
+					"A CredentialsValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra LibraryReferencesValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra CredentialsValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewLibraryReferencesValueUnknown(), diags
+		return NewCredentialsValueUnknown(), diags
 	}
 
-	customUrlAttribute, ok := attributes["custom_url"]
+	httpHeadersAttribute, ok := attributes["http_headers"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`custom_url is missing from object`)
+			`http_headers is missing from object`)
 
-		return NewLibraryReferencesValueUnknown(), diags
+		return NewCredentialsValueUnknown(), diags
 	}
 
-	customUrlVal, ok := customUrlAttribute.(basetypes.StringValue)
+	httpHeadersVal, ok := httpHeadersAttribute.(basetypes.MapValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`custom_url expected to be basetypes.StringValue, was: %T`, customUrlAttribute))
+			fmt.Sprintf(`http_headers expected to be basetypes.MapValue, was: %T`, httpHeadersAttribute))
 	}
 
-	pathAttribute, ok := attributes["path"]
+	netrcFileAttribute, ok := attributes["netrc_file"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`path is missing from object`)
+			`netrc_file is missing from object`)
 
-		return NewLibraryReferencesValueUnknown(), diags
+		return NewCredentialsValueUnknown(), diags
 	}
 
-	pathVal, ok := pathAttribute.(basetypes.StringValue)
+	netrcFileVal, ok := netrcFileAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`path expected to be basetypes.StringValue, was: %T`, pathAttribute))
+			fmt.Sprintf(`netrc_file expected to be basetypes.StringValue, was: %T`, netrcFileAttribute))
 	}
 
-	refAttribute, ok := attributes["ref"]
+	sshKeyPathAttribute, ok := attributes["ssh_key_path"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`ref is missing from object`)
+			`ssh_key_path is missing from object`)
 
-		return NewLibraryReferencesValueUnknown(), diags
+		return NewCredentialsValueUnknown(), diags
 	}
 
-	refVal, ok := refAttribute.(basetypes.StringValue)
+	sshKeyPathVal, ok := sshKeyPathAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`ref expected to be basetypes.StringValue, was: %T`, refAttribute))
+			fmt.Sprintf(`ssh_key_path expected to be basetypes.StringValue, was: %T`, sshKeyPathAttribute))
+	}
+
+	tokenAttribute, ok := attributes["token"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`token is missing from object`)
+
+		return NewCredentialsValueUnknown(), diags
+	}
+
+	tokenVal, ok := tokenAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`token expected to be basetypes.StringValue, was: %T`, tokenAttribute))
 	}
 
 	if diags.HasError() {
-		return NewLibraryReferencesValueUnknown(), diags
+		return NewCredentialsValueUnknown(), diags
 	}
 
-	return LibraryReferencesValue{
-		CustomUrl: customUrlVal,
-		Path:      pathVal,
-		Ref:       refVal,
-		state:     attr.ValueStateKnown,
+	return CredentialsValue{
+		HttpHeaders: httpHeadersVal,
+		NetrcFile:   netrcFileVal,
+		SshKeyPath:  sshKeyPathVal,
+		Token:       tokenVal,
+		state:       attr.ValueStateKnown,
 	}, diags
 }
 
-func NewLibraryReferencesValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) LibraryReferencesValue {
-	object, diags := NewLibraryReferencesValue(attributeTypes, attributes)
+func NewCredentialsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) CredentialsValue {
+	object, diags := NewCredentialsValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -387,15 +2544,15 @@ func NewLibraryReferencesValueMust(attributeTypes map[string]attr.Type, attribut
 				diagnostic.Detail()))
 		}
 
-		panic("NewLibraryReferencesValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewCredentialsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t LibraryReferencesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t CredentialsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewLibraryReferencesValueNull(), nil
+		return NewCredentialsValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -403,11 +2560,11 @@ func (t LibraryReferencesType) ValueFromTerraform(ctx context.Context, in tftype
 	}
 
 	if !in.IsKnown() {
-		return NewLibraryReferencesValueUnknown(), nil
+		return NewCredentialsValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewLibraryReferencesValueNull(), nil
+		return NewCredentialsValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -430,61 +2587,73 @@ func (t LibraryReferencesType) ValueFromTerraform(ctx context.Context, in tftype
 		attributes[k] = a
 	}
 
-	return NewLibraryReferencesValueMust(LibraryReferencesValue{}.AttributeTypes(ctx), attributes), nil
+	return NewCredentialsValueMust(CredentialsValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t LibraryReferencesType) ValueType(ctx context.Context) attr.Value {
-	return LibraryReferencesValue{}
+func (t CredentialsType) ValueType(ctx context.Context) attr.Value {
+	return CredentialsValue{}
 }
 
-var _ basetypes.ObjectValuable = LibraryReferencesValue{}
+var _ basetypes.ObjectValuable = CredentialsValue{}
 
-type LibraryReferencesValue struct {
-	CustomUrl basetypes.StringValue `tfsdk:"custom_url"`
-	Path      basetypes.StringValue `tfsdk:"path"`
-	Ref       basetypes.StringValue `tfsdk:"ref"`
-	state     attr.ValueState
+type CredentialsValue struct {
+	HttpHeaders basetypes.MapValue    `tfsdk:"http_headers"`
+	NetrcFile   basetypes.StringValue `tfsdk:"netrc_file"`
+	SshKeyPath  basetypes.StringValue `tfsdk:"ssh_key_path"`
+	Token       basetypes.StringValue `tfsdk:"token"`
+	state       attr.ValueState
 }
 
-func (v LibraryReferencesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 3)
+func (v CredentialsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 4)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["custom_url"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["path"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["ref"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["http_headers"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["netrc_file"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["ssh_key_path"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["token"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 3)
+		vals := make(map[string]tftypes.Value, 4)
 
-		val, err = v.CustomUrl.ToTerraformValue(ctx)
+		val, err = v.HttpHeaders.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["custom_url"] = val
+		vals["http_headers"] = val
 
-		val, err = v.Path.ToTerraformValue(ctx)
+		val, err = v.NetrcFile.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["path"] = val
+		vals["netrc_file"] = val
 
-		val, err = v.Ref.ToTerraformValue(ctx)
+		val, err = v.SshKeyPath.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["ref"] = val
+		vals["ssh_key_path"] = val
+
+		val, err = v.Token.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["token"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -500,25 +2669,51 @@ func (v LibraryReferencesValue) ToTerraformValue(ctx context.Context) (tftypes.V
 	}
 }
 
-func (v LibraryReferencesValue) IsNull() bool {
+func (v CredentialsValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v LibraryReferencesValue) IsUnknown() bool {
+func (v CredentialsValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v LibraryReferencesValue) String() string {
-	return "LibraryReferencesValue"
+func (v CredentialsValue) String() string {
+	return "CredentialsValue"
 }
 
-func (v LibraryReferencesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v CredentialsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	var httpHeadersVal basetypes.MapValue
+	switch {
+	case v.HttpHeaders.IsUnknown():
+		httpHeadersVal = types.MapUnknown(types.StringType)
+	case v.HttpHeaders.IsNull():
+		httpHeadersVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		httpHeadersVal, d = types.MapValue(types.StringType, v.HttpHeaders.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"http_headers": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"netrc_file":   basetypes.StringType{},
+			"ssh_key_path": basetypes.StringType{},
+			"token":        basetypes.StringType{},
+		}), diags
+	}
+
 	attributeTypes := map[string]attr.Type{
-		"custom_url": basetypes.StringType{},
-		"path":       basetypes.StringType{},
-		"ref":        basetypes.StringType{},
+		"http_headers": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"netrc_file":   basetypes.StringType{},
+		"ssh_key_path": basetypes.StringType{},
+		"token":        basetypes.StringType{},
 	}
 
 	if v.IsNull() {
@@ -532,16 +2727,17 @@ func (v LibraryReferencesValue) ToObjectValue(ctx context.Context) (basetypes.Ob
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"custom_url": v.CustomUrl,
-			"path":       v.Path,
-			"ref":        v.Ref,
+			"http_headers": httpHeadersVal,
+			"netrc_file":   v.NetrcFile,
+			"ssh_key_path": v.SshKeyPath,
+			"token":        v.Token,
 		})
 
 	return objVal, diags
 }
 
-func (v LibraryReferencesValue) Equal(o attr.Value) bool {
-	other, ok := o.(LibraryReferencesValue)
+func (v CredentialsValue) Equal(o attr.Value) bool {
+	other, ok := o.(CredentialsValue)
 
 	if !ok {
 		return false
@@ -555,34 +2751,41 @@ func (v LibraryReferencesValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.CustomUrl.Equal(other.CustomUrl) {
+	if !v.HttpHeaders.Equal(other.HttpHeaders) {
 		return false
 	}
 
-	if !v.Path.Equal(other.Path) {
+	if !v.NetrcFile.Equal(other.NetrcFile) {
 		return false
 	}
 
-	if !v.Ref.Equal(other.Ref) {
+	if !v.SshKeyPath.Equal(other.SshKeyPath) {
+		return false
+	}
+
+	if !v.Token.Equal(other.Token) {
 		return false
 	}
 
 	return true
 }
 
-func (v LibraryReferencesValue) Type(ctx context.Context) attr.Type {
-	return LibraryReferencesType{
+func (v CredentialsValue) Type(ctx context.Context) attr.Type {
+	return CredentialsType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v LibraryReferencesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v CredentialsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"custom_url": basetypes.StringType{},
-		"path":       basetypes.StringType{},
-		"ref":        basetypes.StringType{},
+		"http_headers": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"netrc_file":   basetypes.StringType{},
+		"ssh_key_path": basetypes.StringType{},
+		"token":        basetypes.StringType{},
 	}
 }
 
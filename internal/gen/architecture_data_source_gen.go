@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -26,6 +28,111 @@ import (
 func ArchitectureDataSourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"architecture_management_groups": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"archetypes": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Required:            true,
+							Description:         "The names of the archetypes to apply to the management group.",
+							MarkdownDescription: "The names of the archetypes to apply to the management group.",
+						},
+						"display_name": schema.StringAttribute{
+							Required:            true,
+							Description:         "The display name of the management group.",
+							MarkdownDescription: "The display name of the management group.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"exists": schema.BoolAttribute{
+							Optional:            true,
+							Description:         "Whether the management group already exists. Default is `false`.",
+							MarkdownDescription: "Whether the management group already exists. Default is `false`.",
+						},
+						"id": schema.StringAttribute{
+							Required:            true,
+							Description:         "The id of the management group.",
+							MarkdownDescription: "The id of the management group.",
+							Validators: []validator.String{
+								alzvalidators.ManagementGroupId(),
+							},
+						},
+						"parent_id": schema.StringAttribute{
+							Optional:            true,
+							Description:         "The id of the parent management group in the architecture. Omit for management groups that are deployed under `root_management_group_id`.",
+							MarkdownDescription: "The id of the parent management group in the architecture. Omit for management groups that are deployed under `root_management_group_id`.",
+							Validators: []validator.String{
+								alzvalidators.ManagementGroupId(),
+							},
+						},
+					},
+					CustomType: ArchitectureManagementGroupsType{
+						ObjectType: types.ObjectType{
+							AttrTypes: ArchitectureManagementGroupsValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Optional:            true,
+				Description:         "An inline architecture definition, used instead of the architecture named `name` in the library. Each element is a management group in the architecture. The archetypes must exist in the library.",
+				MarkdownDescription: "An inline architecture definition, used instead of the architecture named `name` in the library. Each element is a management group in the architecture. The archetypes must exist in the library.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"asset_dependencies": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"depends_on_resource_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The resource id of the resource that must be deployed first.",
+							MarkdownDescription: "The resource id of the resource that must be deployed first.",
+						},
+						"resource_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The resource id of the dependent resource.",
+							MarkdownDescription: "The resource id of the dependent resource.",
+						},
+					},
+					CustomType: AssetDependenciesType{
+						ObjectType: types.ObjectType{
+							AttrTypes: AssetDependenciesValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "A list of the dependencies between the management groups and assets in the architecture, derived from the references in each asset. Management groups depend on their parent, assets depend on their management group, policy definitions depend on the custom role definitions they reference, policy set definitions depend on their policy definitions, policy assignments depend on their policy (set) definition, policy exemptions depend on their policy assignment, and policy role assignments depend on their policy assignment and on their scope, if it is a management group in the architecture. References to resources outside the architecture, e.g. built-in definitions, are omitted. Each element of `policy_role_assignments` is identified by the resource id `<scope>/providers/Microsoft.Authorization/roleAssignments/<name>`, where the name is `uuidv5(\"url\", \"${policy_assignment_id}${role_definition_id}${scope}\")` and the policy assignment id is at the `management_group_id` of the element.",
+				MarkdownDescription: "A list of the dependencies between the management groups and assets in the architecture, derived from the references in each asset. Management groups depend on their parent, assets depend on their management group, policy definitions depend on the custom role definitions they reference, policy set definitions depend on their policy definitions, policy assignments depend on their policy (set) definition, policy exemptions depend on their policy assignment, and policy role assignments depend on their policy assignment and on their scope, if it is a management group in the architecture. References to resources outside the architecture, e.g. built-in definitions, are omitted. Each element of `policy_role_assignments` is identified by the resource id `<scope>/providers/Microsoft.Authorization/roleAssignments/<name>`, where the name is `uuidv5(\"url\", \"${policy_assignment_id}${role_definition_id}${scope}\")` and the policy assignment id is at the `management_group_id` of the element.",
+			},
+			"default_identity": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"identity_id": schema.StringAttribute{
+						Required:            true,
+						Description:         "The resource id of the user assigned identity to apply to policy assignments that require an identity. **Do not** pass in computed values, instead construct the resource id yourself.",
+						MarkdownDescription: "The resource id of the user assigned identity to apply to policy assignments that require an identity. **Do not** pass in computed values, instead construct the resource id yourself.",
+						Validators: []validator.String{
+							alzvalidators.ArmResourceIdTypeNamespace("Microsoft.ManagedIdentity", "userAssignedIdentities"),
+						},
+					},
+					"management_group_identity_ids": schema.MapAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						Description:         "A map of per management group overrides. The key is the management group id, and the value is the resource id of the user assigned identity to use for policy assignments in that management group instead of `identity_id`.",
+						MarkdownDescription: "A map of per management group overrides. The key is the management group id, and the value is the resource id of the user assigned identity to use for policy assignments in that management group instead of `identity_id`.",
+						Validators: []validator.Map{
+							mapvalidator.ValueStringsAre(alzvalidators.ArmResourceIdTypeNamespace("Microsoft.ManagedIdentity", "userAssignedIdentities")),
+						},
+					},
+				},
+				CustomType: DefaultIdentityType{
+					ObjectType: types.ObjectType{
+						AttrTypes: DefaultIdentityValue{}.AttributeTypes(ctx),
+					},
+				},
+				Optional:            true,
+				Description:         "Applies a user assigned managed identity to every policy assignment in the hierarchy that requires one, i.e. those assigning DeployIfNotExists or Modify policies (directly or via a policy set). Identities supplied for individual assignments in `policy_assignments_to_modify` take precedence. When set, the `identity_id` attribute of `policy_role_assignments` is populated so that role assignments can target the user assigned identity.",
+				MarkdownDescription: "Applies a user assigned managed identity to every policy assignment in the hierarchy that requires one, i.e. those assigning DeployIfNotExists or Modify policies (directly or via a policy set). Identities supplied for individual assignments in `policy_assignments_to_modify` take precedence. When set, the `identity_id` attribute of `policy_role_assignments` is populated so that role assignments can target the user assigned identity.",
+			},
 			"default_non_compliance_message_settings": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"default_message": schema.StringAttribute{
@@ -51,6 +158,12 @@ func ArchitectureDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Settings for controlling default non-compliance messages on policy assignments. When configured, a default non-compliance message will be applied to policy assignments.",
 				MarkdownDescription: "Settings for controlling default non-compliance messages on policy assignments. When configured, a default non-compliance message will be applied to policy assignments.",
 			},
+			"deployment_order": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "The resource ids of the management groups, assets and policy role assignments in the architecture, in an order that satisfies `asset_dependencies`. Each resource appears after all of the resources it depends on.",
+				MarkdownDescription: "The resource ids of the management groups, assets and policy role assignments in the architecture, in an order that satisfies `asset_dependencies`. Each resource appears after all of the resources it depends on.",
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				Description:         "A computed value representing the unique identifier for the architecture. Mandatory for acceptance testing.",
@@ -64,6 +177,67 @@ func ArchitectureDataSourceSchema(ctx context.Context) schema.Schema {
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"management_group_locations": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "A map of management group locations that override `location`. The key is the management group id, and the value is the Azure region. The override also applies to the descendants of the management group, unless they have an override of their own. The location is applied to the policy assignments in the management group, and to location-typed policy assignment parameter values that are equal to `location`, e.g. those set using `policy_default_values`.",
+				MarkdownDescription: "A map of management group locations that override `location`. The key is the management group id, and the value is the Azure region. The override also applies to the descendants of the management group, unless they have an override of their own. The location is applied to the policy assignments in the management group, and to location-typed policy assignment parameter values that are equal to `location`, e.g. those set using `policy_default_values`.",
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"management_group_naming": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"display_name_template": schema.StringAttribute{
+						Optional:            true,
+						Description:         "A template for the management group display names. The `{display_name}` placeholder is replaced with the display name from the architecture definition, e.g. `Sandbox {display_name}`.",
+						MarkdownDescription: "A template for the management group display names. The `{display_name}` placeholder is replaced with the display name from the architecture definition, e.g. `Sandbox {display_name}`.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`\{display_name\}`), "must contain the `{display_name}` placeholder"),
+						},
+					},
+					"display_names": schema.MapAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						Description:         "A map of explicit management group display names. The key is the id from the architecture definition, and the value is the new display name. Takes precedence over `display_name_template`.",
+						MarkdownDescription: "A map of explicit management group display names. The key is the id from the architecture definition, and the value is the new display name. Takes precedence over `display_name_template`.",
+						Validators: []validator.Map{
+							mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+						},
+					},
+					"id_template": schema.StringAttribute{
+						Optional:            true,
+						Description:         "A template for the management group ids. The `{id}` placeholder is replaced with the id from the architecture definition, e.g. `sbx-{id}`.",
+						MarkdownDescription: "A template for the management group ids. The `{id}` placeholder is replaced with the id from the architecture definition, e.g. `sbx-{id}`.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`\{id\}`), "must contain the `{id}` placeholder"),
+						},
+					},
+					"ids": schema.MapAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						Description:         "A map of explicit management group ids. The key is the id from the architecture definition, and the value is the new id. Takes precedence over `id_template`.",
+						MarkdownDescription: "A map of explicit management group ids. The key is the id from the architecture definition, and the value is the new id. Takes precedence over `id_template`.",
+						Validators: []validator.Map{
+							mapvalidator.ValueStringsAre(alzvalidators.ManagementGroupId()),
+						},
+					},
+				},
+				CustomType: ManagementGroupNamingType{
+					ObjectType: types.ObjectType{
+						AttrTypes: ManagementGroupNamingValue{}.AttributeTypes(ctx),
+					},
+				},
+				Optional:            true,
+				Description:         "Controls the ids and display names of the management groups in the architecture, e.g. to deploy several copies of the architecture into the same tenant. Renamed ids are used throughout the outputs, including policy assignment scopes and `not_scopes`, definition ids, role assignment scopes and `parent_id`. Other attributes keyed by management group id, e.g. `policy_assignments_to_modify`, must use the renamed ids. The `root_management_group_id` is not renamed.",
+				MarkdownDescription: "Controls the ids and display names of the management groups in the architecture, e.g. to deploy several copies of the architecture into the same tenant. Renamed ids are used throughout the outputs, including policy assignment scopes and `not_scopes`, definition ids, role assignment scopes and `parent_id`. Other attributes keyed by management group id, e.g. `policy_assignments_to_modify`, must use the renamed ids. The `root_management_group_id` is not renamed.",
+			},
+			"management_group_resource_ids": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "A map of the full resource ids of the management groups in the architecture. The key is the management group id, and the value is the management group resource id, e.g. `/providers/Microsoft.Management/managementGroups/<id>`.",
+				MarkdownDescription: "A map of the full resource ids of the management groups in the architecture. The key is the management group id, and the value is the management group resource id, e.g. `/providers/Microsoft.Management/managementGroups/<id>`.",
+			},
 			"management_groups": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -92,24 +266,60 @@ func ArchitectureDataSourceSchema(ctx context.Context) schema.Schema {
 							Description:         "The parent management group id.",
 							MarkdownDescription: "The parent management group id.",
 						},
+						"policy_assignment_ids": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The full resource ids of the policy assignments to apply to the management group. The keys are the same as `policy_assignments`, and the value is the policy assignment resource id.",
+							MarkdownDescription: "The full resource ids of the policy assignments to apply to the management group. The keys are the same as `policy_assignments`, and the value is the policy assignment resource id.",
+						},
 						"policy_assignments": schema.MapAttribute{
 							ElementType:         types.StringType,
 							Computed:            true,
 							Description:         "The policy assignments to apply to the management group. The key is the policy assignment name, and the value is the policy assignment JSON as a string.",
 							MarkdownDescription: "The policy assignments to apply to the management group. The key is the policy assignment name, and the value is the policy assignment JSON as a string.",
 						},
+						"policy_definition_ids": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The full resource ids of the policy definitions to apply to the management group. The keys are the same as `policy_definitions`, and the value is the policy definition resource id.",
+							MarkdownDescription: "The full resource ids of the policy definitions to apply to the management group. The keys are the same as `policy_definitions`, and the value is the policy definition resource id.",
+						},
 						"policy_definitions": schema.MapAttribute{
 							ElementType:         types.StringType,
 							Computed:            true,
 							Description:         "The policy definitions to apply to the management group. The key is the policy definition name, and the value is the policy definition JSON as a string.",
 							MarkdownDescription: "The policy definitions to apply to the management group. The key is the policy definition name, and the value is the policy definition JSON as a string.",
 						},
+						"policy_exemption_ids": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The full resource ids of the policy exemptions to apply to the management group. The keys are the same as `policy_exemptions`, and the value is the policy exemption resource id.",
+							MarkdownDescription: "The full resource ids of the policy exemptions to apply to the management group. The keys are the same as `policy_exemptions`, and the value is the policy exemption resource id.",
+						},
+						"policy_exemptions": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The policy exemptions to apply to the management group. The key is the policy exemption name, and the value is the policy exemption JSON as a string.",
+							MarkdownDescription: "The policy exemptions to apply to the management group. The key is the policy exemption name, and the value is the policy exemption JSON as a string.",
+						},
+						"policy_set_definition_ids": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The full resource ids of the policy set definitions to apply to the management group. The keys are the same as `policy_set_definitions`, and the value is the policy set definition resource id.",
+							MarkdownDescription: "The full resource ids of the policy set definitions to apply to the management group. The keys are the same as `policy_set_definitions`, and the value is the policy set definition resource id.",
+						},
 						"policy_set_definitions": schema.MapAttribute{
 							ElementType:         types.StringType,
 							Computed:            true,
 							Description:         "The policy set definitions to apply to the management group. The key is the policy set definition name, and the value is the policy set definition JSON as a string.",
 							MarkdownDescription: "The policy set definitions to apply to the management group. The key is the policy set definition name, and the value is the policy set definition JSON as a string.",
 						},
+						"role_definition_ids": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The full resource ids of the role definitions to apply to the management group. The keys are the same as `role_definitions`, and the value is the role definition resource id.",
+							MarkdownDescription: "The full resource ids of the role definitions to apply to the management group. The keys are the same as `role_definitions`, and the value is the role definition resource id.",
+						},
 						"role_definitions": schema.MapAttribute{
 							ElementType:         types.StringType,
 							Computed:            true,
@@ -129,8 +339,8 @@ func ArchitectureDataSourceSchema(ctx context.Context) schema.Schema {
 			},
 			"name": schema.StringAttribute{
 				Required:            true,
-				Description:         "The name of the architecture to deploy.",
-				MarkdownDescription: "The name of the architecture to deploy.",
+				Description:         "The name of the architecture to deploy. When `architecture_management_groups` is supplied, this is the name given to the inline architecture.",
+				MarkdownDescription: "The name of the architecture to deploy. When `architecture_management_groups` is supplied, this is the name given to the inline architecture.",
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
@@ -199,6 +409,60 @@ func ArchitectureDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "This list of objects allows you to unset set the [`assignPermissions` metadata property](https://learn.microsoft.com/azure/governance/policy/concepts/definition-structure-parameters#parameter-properties) of the supplied definition and parameter names. This allows you to correct policies that haven't been authored correctly, or prevent permissions being assigned for policies that are disabled in a policy set. The provider can then generate the correct policy role assignments.",
 				MarkdownDescription: "This list of objects allows you to unset set the [`assignPermissions` metadata property](https://learn.microsoft.com/azure/governance/policy/concepts/definition-structure-parameters#parameter-properties) of the supplied definition and parameter names. This allows you to correct policies that haven't been authored correctly, or prevent permissions being assigned for policies that are disabled in a policy set. The provider can then generate the correct policy role assignments.",
 			},
+			"policy_assignment_effects": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"effect": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The resolved effect of the policy definition. Null if the effect cannot be resolved, e.g. if it is set by an expression other than a parameter reference.",
+							MarkdownDescription: "The resolved effect of the policy definition. Null if the effect cannot be resolved, e.g. if it is set by an expression other than a parameter reference.",
+						},
+						"effect_source": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Where the effect was resolved from. One of `policy_assignment` (a policy assignment parameter value), `policy_set_definition` (a value in the policy set definition member), `policy_set_definition_default` (a policy set definition parameter default), `policy_definition` (a literal effect in the policy rule), or `policy_definition_default` (a policy definition parameter default). Null if the effect cannot be resolved.",
+							MarkdownDescription: "Where the effect was resolved from. One of `policy_assignment` (a policy assignment parameter value), `policy_set_definition` (a value in the policy set definition member), `policy_set_definition_default` (a policy set definition parameter default), `policy_definition` (a literal effect in the policy rule), or `policy_definition_default` (a policy definition parameter default). Null if the effect cannot be resolved.",
+						},
+						"enforcement_mode": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The enforcement mode of the policy assignment. A `Deny` effect is only enforced when this is `Default`.",
+							MarkdownDescription: "The enforcement mode of the policy assignment. A `Deny` effect is only enforced when this is `Default`.",
+						},
+						"management_group_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The id of the management group where the policy assignment is created.",
+							MarkdownDescription: "The id of the management group where the policy assignment is created.",
+						},
+						"policy_assignment_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The resource id of the policy assignment.",
+							MarkdownDescription: "The resource id of the policy assignment.",
+						},
+						"policy_assignment_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the policy assignment.",
+							MarkdownDescription: "The name of the policy assignment.",
+						},
+						"policy_definition_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The resource id of the policy definition.",
+							MarkdownDescription: "The resource id of the policy definition.",
+						},
+						"policy_definition_reference_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The policy definition reference id of the member of the policy set definition. Null for policy assignments of a policy definition.",
+							MarkdownDescription: "The policy definition reference id of the member of the policy set definition. Null for policy assignments of a policy definition.",
+						},
+					},
+					CustomType: PolicyAssignmentEffectsType{
+						ObjectType: types.ObjectType{
+							AttrTypes: PolicyAssignmentEffectsValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "A list of the effective policy effects in the architecture. There is an element for every policy assignment of a policy definition, and for every member of a policy set definition assignment. The effect is resolved by following the parameter chain from the policy assignment parameter values, to the policy set definition parameters, to the policy definition defaults.",
+				MarkdownDescription: "A list of the effective policy effects in the architecture. There is an element for every policy assignment of a policy definition, and for every member of a policy set definition assignment. The effect is resolved by following the parameter chain from the policy assignment parameter values, to the policy set definition parameters, to the policy definition defaults.",
+			},
 			"policy_assignments_to_modify": schema.MapNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -444,9 +708,170 @@ func ArchitectureDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "A map of default values to apply to policy assignments. The key is the default name as defined in the library, and the value is an JSON object containing a single `value` attribute with the values to apply. This to mitigate issues with the Terraform type system. E.g. `{ defaultName = jsonencode({ value = \"value\"}) }`",
 				MarkdownDescription: "A map of default values to apply to policy assignments. The key is the default name as defined in the library, and the value is an JSON object containing a single `value` attribute with the values to apply. This to mitigate issues with the Terraform type system. E.g. `{ defaultName = jsonencode({ value = \"value\"}) }`",
 			},
+			"policy_definition_checks": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"deprecated": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The severity of the diagnostic for policy assignments that reference deprecated definitions. Defaults to `warning`.",
+						MarkdownDescription: "The severity of the diagnostic for policy assignments that reference deprecated definitions. Defaults to `warning`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("none", "warning", "error"),
+						},
+					},
+					"preview": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The severity of the diagnostic for policy assignments that reference preview definitions. Defaults to `none`.",
+						MarkdownDescription: "The severity of the diagnostic for policy assignments that reference preview definitions. Defaults to `none`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("none", "warning", "error"),
+						},
+					},
+				},
+				CustomType: PolicyDefinitionChecksType{
+					ObjectType: types.ObjectType{
+						AttrTypes: PolicyDefinitionChecksValue{}.AttributeTypes(ctx),
+					},
+				},
+				Optional:            true,
+				Description:         "Controls the checks of the policy definitions referenced by the policy assignments in the hierarchy, either directly or as members of a policy set definition. Definitions, usually built-in, are flagged using the `deprecated` and `preview` properties of their metadata. When the `supersededBy` metadata property names a successor definition, it is included in the diagnostic. The severity of each check is one of `none`, `warning` or `error`.",
+				MarkdownDescription: "Controls the checks of the policy definitions referenced by the policy assignments in the hierarchy, either directly or as members of a policy set definition. Definitions, usually built-in, are flagged using the `deprecated` and `preview` properties of their metadata. When the `supersededBy` metadata property names a successor definition, it is included in the diagnostic. The severity of each check is one of `none`, `warning` or `error`.",
+			},
+			"policy_exemptions": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							Optional:            true,
+							Description:         "The description of the policy exemption.",
+							MarkdownDescription: "The description of the policy exemption.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 512),
+							},
+						},
+						"display_name": schema.StringAttribute{
+							Optional:            true,
+							Description:         "The display name of the policy exemption.",
+							MarkdownDescription: "The display name of the policy exemption.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 128),
+							},
+						},
+						"exemption_category": schema.StringAttribute{
+							Required:            true,
+							Description:         "The policy exemption category. Valid values are `Waiver` and `Mitigated`.",
+							MarkdownDescription: "The policy exemption category. Valid values are `Waiver` and `Mitigated`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("Waiver", "Mitigated"),
+							},
+						},
+						"expires_on": schema.StringAttribute{
+							Optional:            true,
+							Description:         "The expiry date and time of the policy exemption, as an RFC 3339 timestamp, e.g. `2025-01-31T00:00:00Z`. A warning is raised if the exemption has already expired.",
+							MarkdownDescription: "The expiry date and time of the policy exemption, as an RFC 3339 timestamp, e.g. `2025-01-31T00:00:00Z`. A warning is raised if the exemption has already expired.",
+							Validators: []validator.String{
+								alzvalidators.RFC3339Timestamp(),
+							},
+						},
+						"management_group_id": schema.StringAttribute{
+							Required:            true,
+							Description:         "The id of the management group at which to create the exemption. If `management_group_naming` is used, this is the new id.",
+							MarkdownDescription: "The id of the management group at which to create the exemption. If `management_group_naming` is used, this is the new id.",
+							Validators: []validator.String{
+								alzvalidators.ManagementGroupId(),
+							},
+						},
+						"name": schema.StringAttribute{
+							Required:            true,
+							Description:         "The name of the policy exemption. Must be unique within the management group.",
+							MarkdownDescription: "The name of the policy exemption. Must be unique within the management group.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 64),
+							},
+						},
+						"policy_assignment_name": schema.StringAttribute{
+							Required:            true,
+							Description:         "The name of the policy assignment to exempt. The nearest policy assignment with this name, searching from `management_group_id` up through its ancestors, is used.",
+							MarkdownDescription: "The name of the policy assignment to exempt. The nearest policy assignment with this name, searching from `management_group_id` up through its ancestors, is used.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"policy_definition_reference_ids": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							Description:         "The policy definition reference ids (not the resource ids) of the definitions within the assigned policy set definition to exempt. Omit to exempt all definitions. Only valid when the policy assignment assigns a policy set definition.",
+							MarkdownDescription: "The policy definition reference ids (not the resource ids) of the definitions within the assigned policy set definition to exempt. Omit to exempt all definitions. Only valid when the policy assignment assigns a policy set definition.",
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+					},
+					CustomType: PolicyExemptionsType{
+						ObjectType: types.ObjectType{
+							AttrTypes: PolicyExemptionsValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Optional:            true,
+				Description:         "A list of policy exemptions to create in the hierarchy. Each exemption targets a policy assignment by its name, which must be assigned at the exemption's management group or one of its ancestors. The exemptions are returned, with full resource ids, in the `policy_exemptions` attribute of the relevant element of `management_groups`.",
+				MarkdownDescription: "A list of policy exemptions to create in the hierarchy. Each exemption targets a policy assignment by its name, which must be assigned at the exemption's management group or one of its ancestors. The exemptions are returned, with full resource ids, in the `policy_exemptions` attribute of the relevant element of `management_groups`.",
+			},
+			"policy_remediation_targets": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"effect": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The resolved effect of the policy definition.",
+							MarkdownDescription: "The resolved effect of the policy definition.",
+						},
+						"management_group_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The id of the management group where the policy assignment is created.",
+							MarkdownDescription: "The id of the management group where the policy assignment is created.",
+						},
+						"policy_assignment_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The resource id of the policy assignment.",
+							MarkdownDescription: "The resource id of the policy assignment.",
+						},
+						"policy_assignment_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the policy assignment.",
+							MarkdownDescription: "The name of the policy assignment.",
+						},
+						"policy_definition_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The resource id of the policy definition to remediate.",
+							MarkdownDescription: "The resource id of the policy definition to remediate.",
+						},
+						"policy_definition_reference_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The policy definition reference id of the member of the policy set definition to remediate. Null for policy assignments of a policy definition.",
+							MarkdownDescription: "The policy definition reference id of the member of the policy set definition to remediate. Null for policy assignments of a policy definition.",
+						},
+						"scope": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The resource id of the scope to remediate.",
+							MarkdownDescription: "The resource id of the scope to remediate.",
+						},
+					},
+					CustomType: PolicyRemediationTargetsType{
+						ObjectType: types.ObjectType{
+							AttrTypes: PolicyRemediationTargetsValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "A list of the policy definitions in the architecture that need a remediation task after deployment, i.e. those whose resolved effect is `DeployIfNotExists` or `Modify`. Policy set definition assignments have an element for each applicable member. The effect is resolved from the policy assignment parameter values, the policy set definition parameters and the policy definition defaults. Members whose effect cannot be resolved are omitted.",
+				MarkdownDescription: "A list of the policy definitions in the architecture that need a remediation task after deployment, i.e. those whose resolved effect is `DeployIfNotExists` or `Modify`. Policy set definition assignments have an element for each applicable member. The effect is resolved from the policy assignment parameter values, the policy set definition parameters and the policy definition defaults. Members whose effect cannot be resolved are omitted.",
+			},
 			"policy_role_assignments": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
+						"identity_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The resource id of the user assigned identity used by the policy assignment. Null when the policy assignment uses a system assigned identity.",
+							MarkdownDescription: "The resource id of the user assigned identity used by the policy assignment. Null when the policy assignment uses a system assigned identity.",
+						},
 						"management_group_id": schema.StringAttribute{
 							Computed:            true,
 							Description:         "The id of the management group where the policy assignment will be created.",
@@ -505,28 +930,39 @@ func ArchitectureDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type ArchitectureModel struct {
+	ArchitectureManagementGroups                            types.List                               `tfsdk:"architecture_management_groups"`
+	AssetDependencies                                       types.List                               `tfsdk:"asset_dependencies"`
+	DefaultIdentity                                         DefaultIdentityValue                     `tfsdk:"default_identity"`
 	DefaultNonComplianceMessageSettings                     DefaultNonComplianceMessageSettingsValue `tfsdk:"default_non_compliance_message_settings"`
+	DeploymentOrder                                         types.List                               `tfsdk:"deployment_order"`
 	Id                                                      types.String                             `tfsdk:"id"`
 	Location                                                types.String                             `tfsdk:"location"`
+	ManagementGroupLocations                                types.Map                                `tfsdk:"management_group_locations"`
+	ManagementGroupNaming                                   ManagementGroupNamingValue               `tfsdk:"management_group_naming"`
+	ManagementGroupResourceIds                              types.Map                                `tfsdk:"management_group_resource_ids"`
 	ManagementGroups                                        types.List                               `tfsdk:"management_groups"`
 	Name                                                    types.String                             `tfsdk:"name"`
 	OverridePolicyDefinitionParameterAssignPermissionsSet   types.Set                                `tfsdk:"override_policy_definition_parameter_assign_permissions_set"`
 	OverridePolicyDefinitionParameterAssignPermissionsUnset types.Set                                `tfsdk:"override_policy_definition_parameter_assign_permissions_unset"`
+	PolicyAssignmentEffects                                 types.List                               `tfsdk:"policy_assignment_effects"`
 	PolicyAssignmentsToModify                               types.Map                                `tfsdk:"policy_assignments_to_modify"`
 	PolicyDefaultValues                                     types.Map                                `tfsdk:"policy_default_values"`
+	PolicyDefinitionChecks                                  PolicyDefinitionChecksValue              `tfsdk:"policy_definition_checks"`
+	PolicyExemptions                                        types.List                               `tfsdk:"policy_exemptions"`
+	PolicyRemediationTargets                                types.List                               `tfsdk:"policy_remediation_targets"`
 	PolicyRoleAssignments                                   types.Set                                `tfsdk:"policy_role_assignments"`
 	RootManagementGroupId                                   types.String                             `tfsdk:"root_management_group_id"`
 	Timeouts                                                timeouts.Value                           `tfsdk:"timeouts"`
 }
 
-var _ basetypes.ObjectTypable = DefaultNonComplianceMessageSettingsType{}
+var _ basetypes.ObjectTypable = ArchitectureManagementGroupsType{}
 
-type DefaultNonComplianceMessageSettingsType struct {
+type ArchitectureManagementGroupsType struct {
 	basetypes.ObjectType
 }
 
-func (t DefaultNonComplianceMessageSettingsType) Equal(o attr.Type) bool {
-	other, ok := o.(DefaultNonComplianceMessageSettingsType)
+func (t ArchitectureManagementGroupsType) Equal(o attr.Type) bool {
+	other, ok := o.(ArchitectureManagementGroupsType)
 
 	if !ok {
 		return false
@@ -535,75 +971,132 @@ func (t DefaultNonComplianceMessageSettingsType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t DefaultNonComplianceMessageSettingsType) String() string {
-	return "DefaultNonComplianceMessageSettingsType"
+func (t ArchitectureManagementGroupsType) String() string {
+	return "ArchitectureManagementGroupsType"
 }
 
-func (t DefaultNonComplianceMessageSettingsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t ArchitectureManagementGroupsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	defaultMessageAttribute, ok := attributes["default_message"]
+	archetypesAttribute, ok := attributes["archetypes"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`default_message is missing from object`)
+			`archetypes is missing from object`)
 
 		return nil, diags
 	}
 
-	defaultMessageVal, ok := defaultMessageAttribute.(basetypes.StringValue)
+	archetypesVal, ok := archetypesAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`default_message expected to be basetypes.StringValue, was: %T`, defaultMessageAttribute))
+			fmt.Sprintf(`archetypes expected to be basetypes.SetValue, was: %T`, archetypesAttribute))
 	}
 
-	mergeModeAttribute, ok := attributes["merge_mode"]
+	displayNameAttribute, ok := attributes["display_name"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`merge_mode is missing from object`)
+			`display_name is missing from object`)
 
 		return nil, diags
 	}
 
-	mergeModeVal, ok := mergeModeAttribute.(basetypes.StringValue)
+	displayNameVal, ok := displayNameAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`merge_mode expected to be basetypes.StringValue, was: %T`, mergeModeAttribute))
+			fmt.Sprintf(`display_name expected to be basetypes.StringValue, was: %T`, displayNameAttribute))
 	}
 
-	if diags.HasError() {
+	existsAttribute, ok := attributes["exists"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`exists is missing from object`)
+
 		return nil, diags
 	}
 
-	return DefaultNonComplianceMessageSettingsValue{
-		DefaultMessage: defaultMessageVal,
-		MergeMode:      mergeModeVal,
-		state:          attr.ValueStateKnown,
-	}, diags
-}
+	existsVal, ok := existsAttribute.(basetypes.BoolValue)
 
-func NewDefaultNonComplianceMessageSettingsValueNull() DefaultNonComplianceMessageSettingsValue {
-	return DefaultNonComplianceMessageSettingsValue{
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`exists expected to be basetypes.BoolValue, was: %T`, existsAttribute))
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return nil, diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
+	}
+
+	parentIdAttribute, ok := attributes["parent_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`parent_id is missing from object`)
+
+		return nil, diags
+	}
+
+	parentIdVal, ok := parentIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`parent_id expected to be basetypes.StringValue, was: %T`, parentIdAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return ArchitectureManagementGroupsValue{
+		Archetypes:  archetypesVal,
+		DisplayName: displayNameVal,
+		Exists:      existsVal,
+		Id:          idVal,
+		ParentId:    parentIdVal,
+		state:       attr.ValueStateKnown,
+	}, diags
+}
+
+func NewArchitectureManagementGroupsValueNull() ArchitectureManagementGroupsValue {
+	return ArchitectureManagementGroupsValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewDefaultNonComplianceMessageSettingsValueUnknown() DefaultNonComplianceMessageSettingsValue {
-	return DefaultNonComplianceMessageSettingsValue{
+func NewArchitectureManagementGroupsValueUnknown() ArchitectureManagementGroupsValue {
+	return ArchitectureManagementGroupsValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewDefaultNonComplianceMessageSettingsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (DefaultNonComplianceMessageSettingsValue, diag.Diagnostics) {
+func NewArchitectureManagementGroupsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ArchitectureManagementGroupsValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -614,11 +1107,11 @@ func NewDefaultNonComplianceMessageSettingsValue(attributeTypes map[string]attr.
 
 		if !ok {
 			diags.AddError(
-				"Missing DefaultNonComplianceMessageSettingsValue Attribute Value",
-				"While creating a DefaultNonComplianceMessageSettingsValue value, a missing attribute value was detected. "+
-					"A DefaultNonComplianceMessageSettingsValue must contain values for all attributes, even if null or unknown. "+
+				"Missing ArchitectureManagementGroupsValue Attribute Value",
+				"While creating a ArchitectureManagementGroupsValue value, a missing attribute value was detected. "+
+					"A ArchitectureManagementGroupsValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("DefaultNonComplianceMessageSettingsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("ArchitectureManagementGroupsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -626,12 +1119,12 @@ func NewDefaultNonComplianceMessageSettingsValue(attributeTypes map[string]attr.
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid DefaultNonComplianceMessageSettingsValue Attribute Type",
-				"While creating a DefaultNonComplianceMessageSettingsValue value, an invalid attribute value was detected. "+
-					"A DefaultNonComplianceMessageSettingsValue must use a matching attribute type for the value. "+
+				"Invalid ArchitectureManagementGroupsValue Attribute Type",
+				"While creating a ArchitectureManagementGroupsValue value, an invalid attribute value was detected. "+
+					"A ArchitectureManagementGroupsValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("DefaultNonComplianceMessageSettingsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("DefaultNonComplianceMessageSettingsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("ArchitectureManagementGroupsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("ArchitectureManagementGroupsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -641,68 +1134,125 @@ func NewDefaultNonComplianceMessageSettingsValue(attributeTypes map[string]attr.
 
 		if !ok {
 			diags.AddError(
-				"Extra DefaultNonComplianceMessageSettingsValue Attribute Value",
-				"While creating a DefaultNonComplianceMessageSettingsValue value, an extra attribute value was detected. "+
-					"A DefaultNonComplianceMessageSettingsValue must not contain values beyond the expected attribute types. "+
+				"Extra ArchitectureManagementGroupsValue Attribute Value",
+				"While creating a ArchitectureManagementGroupsValue value, an extra attribute value was detected. "+
+					"A ArchitectureManagementGroupsValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra DefaultNonComplianceMessageSettingsValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra ArchitectureManagementGroupsValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewDefaultNonComplianceMessageSettingsValueUnknown(), diags
+		return NewArchitectureManagementGroupsValueUnknown(), diags
 	}
 
-	defaultMessageAttribute, ok := attributes["default_message"]
+	archetypesAttribute, ok := attributes["archetypes"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`default_message is missing from object`)
+			`archetypes is missing from object`)
 
-		return NewDefaultNonComplianceMessageSettingsValueUnknown(), diags
+		return NewArchitectureManagementGroupsValueUnknown(), diags
 	}
 
-	defaultMessageVal, ok := defaultMessageAttribute.(basetypes.StringValue)
+	archetypesVal, ok := archetypesAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`default_message expected to be basetypes.StringValue, was: %T`, defaultMessageAttribute))
+			fmt.Sprintf(`archetypes expected to be basetypes.SetValue, was: %T`, archetypesAttribute))
 	}
 
-	mergeModeAttribute, ok := attributes["merge_mode"]
+	displayNameAttribute, ok := attributes["display_name"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`merge_mode is missing from object`)
+			`display_name is missing from object`)
 
-		return NewDefaultNonComplianceMessageSettingsValueUnknown(), diags
+		return NewArchitectureManagementGroupsValueUnknown(), diags
 	}
 
-	mergeModeVal, ok := mergeModeAttribute.(basetypes.StringValue)
+	displayNameVal, ok := displayNameAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`merge_mode expected to be basetypes.StringValue, was: %T`, mergeModeAttribute))
+			fmt.Sprintf(`display_name expected to be basetypes.StringValue, was: %T`, displayNameAttribute))
+	}
+
+	existsAttribute, ok := attributes["exists"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`exists is missing from object`)
+
+		return NewArchitectureManagementGroupsValueUnknown(), diags
+	}
+
+	existsVal, ok := existsAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`exists expected to be basetypes.BoolValue, was: %T`, existsAttribute))
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return NewArchitectureManagementGroupsValueUnknown(), diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
+	}
+
+	parentIdAttribute, ok := attributes["parent_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`parent_id is missing from object`)
+
+		return NewArchitectureManagementGroupsValueUnknown(), diags
+	}
+
+	parentIdVal, ok := parentIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`parent_id expected to be basetypes.StringValue, was: %T`, parentIdAttribute))
 	}
 
 	if diags.HasError() {
-		return NewDefaultNonComplianceMessageSettingsValueUnknown(), diags
+		return NewArchitectureManagementGroupsValueUnknown(), diags
 	}
 
-	return DefaultNonComplianceMessageSettingsValue{
-		DefaultMessage: defaultMessageVal,
-		MergeMode:      mergeModeVal,
-		state:          attr.ValueStateKnown,
+	return ArchitectureManagementGroupsValue{
+		Archetypes:  archetypesVal,
+		DisplayName: displayNameVal,
+		Exists:      existsVal,
+		Id:          idVal,
+		ParentId:    parentIdVal,
+		state:       attr.ValueStateKnown,
 	}, diags
 }
 
-func NewDefaultNonComplianceMessageSettingsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) DefaultNonComplianceMessageSettingsValue {
-	object, diags := NewDefaultNonComplianceMessageSettingsValue(attributeTypes, attributes)
+func NewArchitectureManagementGroupsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ArchitectureManagementGroupsValue {
+	object, diags := NewArchitectureManagementGroupsValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -716,15 +1266,15 @@ func NewDefaultNonComplianceMessageSettingsValueMust(attributeTypes map[string]a
 				diagnostic.Detail()))
 		}
 
-		panic("NewDefaultNonComplianceMessageSettingsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewArchitectureManagementGroupsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t DefaultNonComplianceMessageSettingsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t ArchitectureManagementGroupsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewDefaultNonComplianceMessageSettingsValueNull(), nil
+		return NewArchitectureManagementGroupsValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -732,11 +1282,11 @@ func (t DefaultNonComplianceMessageSettingsType) ValueFromTerraform(ctx context.
 	}
 
 	if !in.IsKnown() {
-		return NewDefaultNonComplianceMessageSettingsValueUnknown(), nil
+		return NewArchitectureManagementGroupsValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewDefaultNonComplianceMessageSettingsValueNull(), nil
+		return NewArchitectureManagementGroupsValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -759,51 +1309,83 @@ func (t DefaultNonComplianceMessageSettingsType) ValueFromTerraform(ctx context.
 		attributes[k] = a
 	}
 
-	return NewDefaultNonComplianceMessageSettingsValueMust(DefaultNonComplianceMessageSettingsValue{}.AttributeTypes(ctx), attributes), nil
+	return NewArchitectureManagementGroupsValueMust(ArchitectureManagementGroupsValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t DefaultNonComplianceMessageSettingsType) ValueType(ctx context.Context) attr.Value {
-	return DefaultNonComplianceMessageSettingsValue{}
+func (t ArchitectureManagementGroupsType) ValueType(ctx context.Context) attr.Value {
+	return ArchitectureManagementGroupsValue{}
 }
 
-var _ basetypes.ObjectValuable = DefaultNonComplianceMessageSettingsValue{}
+var _ basetypes.ObjectValuable = ArchitectureManagementGroupsValue{}
 
-type DefaultNonComplianceMessageSettingsValue struct {
-	DefaultMessage basetypes.StringValue `tfsdk:"default_message"`
-	MergeMode      basetypes.StringValue `tfsdk:"merge_mode"`
-	state          attr.ValueState
+type ArchitectureManagementGroupsValue struct {
+	Archetypes  basetypes.SetValue    `tfsdk:"archetypes"`
+	DisplayName basetypes.StringValue `tfsdk:"display_name"`
+	Exists      basetypes.BoolValue   `tfsdk:"exists"`
+	Id          basetypes.StringValue `tfsdk:"id"`
+	ParentId    basetypes.StringValue `tfsdk:"parent_id"`
+	state       attr.ValueState
 }
 
-func (v DefaultNonComplianceMessageSettingsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 2)
+func (v ArchitectureManagementGroupsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 5)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["default_message"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["merge_mode"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["archetypes"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["display_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["exists"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["parent_id"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 2)
+		vals := make(map[string]tftypes.Value, 5)
 
-		val, err = v.DefaultMessage.ToTerraformValue(ctx)
+		val, err = v.Archetypes.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["default_message"] = val
+		vals["archetypes"] = val
 
-		val, err = v.MergeMode.ToTerraformValue(ctx)
+		val, err = v.DisplayName.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["merge_mode"] = val
+		vals["display_name"] = val
+
+		val, err = v.Exists.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["exists"] = val
+
+		val, err = v.Id.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["id"] = val
+
+		val, err = v.ParentId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["parent_id"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -819,24 +1401,53 @@ func (v DefaultNonComplianceMessageSettingsValue) ToTerraformValue(ctx context.C
 	}
 }
 
-func (v DefaultNonComplianceMessageSettingsValue) IsNull() bool {
+func (v ArchitectureManagementGroupsValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v DefaultNonComplianceMessageSettingsValue) IsUnknown() bool {
+func (v ArchitectureManagementGroupsValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v DefaultNonComplianceMessageSettingsValue) String() string {
-	return "DefaultNonComplianceMessageSettingsValue"
+func (v ArchitectureManagementGroupsValue) String() string {
+	return "ArchitectureManagementGroupsValue"
 }
 
-func (v DefaultNonComplianceMessageSettingsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v ArchitectureManagementGroupsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	var archetypesVal basetypes.SetValue
+	switch {
+	case v.Archetypes.IsUnknown():
+		archetypesVal = types.SetUnknown(types.StringType)
+	case v.Archetypes.IsNull():
+		archetypesVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		archetypesVal, d = types.SetValue(types.StringType, v.Archetypes.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"archetypes": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"display_name": basetypes.StringType{},
+			"exists":       basetypes.BoolType{},
+			"id":           basetypes.StringType{},
+			"parent_id":    basetypes.StringType{},
+		}), diags
+	}
+
 	attributeTypes := map[string]attr.Type{
-		"default_message": basetypes.StringType{},
-		"merge_mode":      basetypes.StringType{},
+		"archetypes": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"display_name": basetypes.StringType{},
+		"exists":       basetypes.BoolType{},
+		"id":           basetypes.StringType{},
+		"parent_id":    basetypes.StringType{},
 	}
 
 	if v.IsNull() {
@@ -850,15 +1461,18 @@ func (v DefaultNonComplianceMessageSettingsValue) ToObjectValue(ctx context.Cont
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"default_message": v.DefaultMessage,
-			"merge_mode":      v.MergeMode,
+			"archetypes":   archetypesVal,
+			"display_name": v.DisplayName,
+			"exists":       v.Exists,
+			"id":           v.Id,
+			"parent_id":    v.ParentId,
 		})
 
 	return objVal, diags
 }
 
-func (v DefaultNonComplianceMessageSettingsValue) Equal(o attr.Value) bool {
-	other, ok := o.(DefaultNonComplianceMessageSettingsValue)
+func (v ArchitectureManagementGroupsValue) Equal(o attr.Value) bool {
+	other, ok := o.(ArchitectureManagementGroupsValue)
 
 	if !ok {
 		return false
@@ -872,40 +1486,57 @@ func (v DefaultNonComplianceMessageSettingsValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.DefaultMessage.Equal(other.DefaultMessage) {
+	if !v.Archetypes.Equal(other.Archetypes) {
 		return false
 	}
 
-	if !v.MergeMode.Equal(other.MergeMode) {
+	if !v.DisplayName.Equal(other.DisplayName) {
+		return false
+	}
+
+	if !v.Exists.Equal(other.Exists) {
+		return false
+	}
+
+	if !v.Id.Equal(other.Id) {
+		return false
+	}
+
+	if !v.ParentId.Equal(other.ParentId) {
 		return false
 	}
 
 	return true
 }
 
-func (v DefaultNonComplianceMessageSettingsValue) Type(ctx context.Context) attr.Type {
-	return DefaultNonComplianceMessageSettingsType{
+func (v ArchitectureManagementGroupsValue) Type(ctx context.Context) attr.Type {
+	return ArchitectureManagementGroupsType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v DefaultNonComplianceMessageSettingsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v ArchitectureManagementGroupsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"default_message": basetypes.StringType{},
-		"merge_mode":      basetypes.StringType{},
+		"archetypes": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"display_name": basetypes.StringType{},
+		"exists":       basetypes.BoolType{},
+		"id":           basetypes.StringType{},
+		"parent_id":    basetypes.StringType{},
 	}
 }
 
-var _ basetypes.ObjectTypable = ManagementGroupsType{}
+var _ basetypes.ObjectTypable = AssetDependenciesType{}
 
-type ManagementGroupsType struct {
+type AssetDependenciesType struct {
 	basetypes.ObjectType
 }
 
-func (t ManagementGroupsType) Equal(o attr.Type) bool {
-	other, ok := o.(ManagementGroupsType)
+func (t AssetDependenciesType) Equal(o attr.Type) bool {
+	other, ok := o.(AssetDependenciesType)
 
 	if !ok {
 		return false
@@ -914,208 +1545,75 @@ func (t ManagementGroupsType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t ManagementGroupsType) String() string {
-	return "ManagementGroupsType"
+func (t AssetDependenciesType) String() string {
+	return "AssetDependenciesType"
 }
 
-func (t ManagementGroupsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t AssetDependenciesType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	displayNameAttribute, ok := attributes["display_name"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`display_name is missing from object`)
-
-		return nil, diags
-	}
-
-	displayNameVal, ok := displayNameAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`display_name expected to be basetypes.StringValue, was: %T`, displayNameAttribute))
-	}
-
-	existsAttribute, ok := attributes["exists"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`exists is missing from object`)
-
-		return nil, diags
-	}
-
-	existsVal, ok := existsAttribute.(basetypes.BoolValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`exists expected to be basetypes.BoolValue, was: %T`, existsAttribute))
-	}
-
-	idAttribute, ok := attributes["id"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`id is missing from object`)
-
-		return nil, diags
-	}
-
-	idVal, ok := idAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
-	}
-
-	levelAttribute, ok := attributes["level"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`level is missing from object`)
-
-		return nil, diags
-	}
-
-	levelVal, ok := levelAttribute.(basetypes.NumberValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`level expected to be basetypes.NumberValue, was: %T`, levelAttribute))
-	}
-
-	parentIdAttribute, ok := attributes["parent_id"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`parent_id is missing from object`)
-
-		return nil, diags
-	}
-
-	parentIdVal, ok := parentIdAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`parent_id expected to be basetypes.StringValue, was: %T`, parentIdAttribute))
-	}
-
-	policyAssignmentsAttribute, ok := attributes["policy_assignments"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`policy_assignments is missing from object`)
-
-		return nil, diags
-	}
-
-	policyAssignmentsVal, ok := policyAssignmentsAttribute.(basetypes.MapValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`policy_assignments expected to be basetypes.MapValue, was: %T`, policyAssignmentsAttribute))
-	}
-
-	policyDefinitionsAttribute, ok := attributes["policy_definitions"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`policy_definitions is missing from object`)
-
-		return nil, diags
-	}
-
-	policyDefinitionsVal, ok := policyDefinitionsAttribute.(basetypes.MapValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`policy_definitions expected to be basetypes.MapValue, was: %T`, policyDefinitionsAttribute))
-	}
-
-	policySetDefinitionsAttribute, ok := attributes["policy_set_definitions"]
+	dependsOnResourceIdAttribute, ok := attributes["depends_on_resource_id"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`policy_set_definitions is missing from object`)
+			`depends_on_resource_id is missing from object`)
 
 		return nil, diags
 	}
 
-	policySetDefinitionsVal, ok := policySetDefinitionsAttribute.(basetypes.MapValue)
+	dependsOnResourceIdVal, ok := dependsOnResourceIdAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`policy_set_definitions expected to be basetypes.MapValue, was: %T`, policySetDefinitionsAttribute))
+			fmt.Sprintf(`depends_on_resource_id expected to be basetypes.StringValue, was: %T`, dependsOnResourceIdAttribute))
 	}
 
-	roleDefinitionsAttribute, ok := attributes["role_definitions"]
+	resourceIdAttribute, ok := attributes["resource_id"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`role_definitions is missing from object`)
+			`resource_id is missing from object`)
 
 		return nil, diags
 	}
 
-	roleDefinitionsVal, ok := roleDefinitionsAttribute.(basetypes.MapValue)
+	resourceIdVal, ok := resourceIdAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`role_definitions expected to be basetypes.MapValue, was: %T`, roleDefinitionsAttribute))
+			fmt.Sprintf(`resource_id expected to be basetypes.StringValue, was: %T`, resourceIdAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return ManagementGroupsValue{
-		DisplayName:          displayNameVal,
-		Exists:               existsVal,
-		Id:                   idVal,
-		Level:                levelVal,
-		ParentId:             parentIdVal,
-		PolicyAssignments:    policyAssignmentsVal,
-		PolicyDefinitions:    policyDefinitionsVal,
-		PolicySetDefinitions: policySetDefinitionsVal,
-		RoleDefinitions:      roleDefinitionsVal,
-		state:                attr.ValueStateKnown,
+	return AssetDependenciesValue{
+		DependsOnResourceId: dependsOnResourceIdVal,
+		ResourceId:          resourceIdVal,
+		state:               attr.ValueStateKnown,
 	}, diags
 }
 
-func NewManagementGroupsValueNull() ManagementGroupsValue {
-	return ManagementGroupsValue{
+func NewAssetDependenciesValueNull() AssetDependenciesValue {
+	return AssetDependenciesValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewManagementGroupsValueUnknown() ManagementGroupsValue {
-	return ManagementGroupsValue{
+func NewAssetDependenciesValueUnknown() AssetDependenciesValue {
+	return AssetDependenciesValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewManagementGroupsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ManagementGroupsValue, diag.Diagnostics) {
+func NewAssetDependenciesValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (AssetDependenciesValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -1126,11 +1624,11 @@ func NewManagementGroupsValue(attributeTypes map[string]attr.Type, attributes ma
 
 		if !ok {
 			diags.AddError(
-				"Missing ManagementGroupsValue Attribute Value",
-				"While creating a ManagementGroupsValue value, a missing attribute value was detected. "+
-					"A ManagementGroupsValue must contain values for all attributes, even if null or unknown. "+
+				"Missing AssetDependenciesValue Attribute Value",
+				"While creating a AssetDependenciesValue value, a missing attribute value was detected. "+
+					"A AssetDependenciesValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("ManagementGroupsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("AssetDependenciesValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -1138,12 +1636,12 @@ func NewManagementGroupsValue(attributeTypes map[string]attr.Type, attributes ma
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid ManagementGroupsValue Attribute Type",
-				"While creating a ManagementGroupsValue value, an invalid attribute value was detected. "+
-					"A ManagementGroupsValue must use a matching attribute type for the value. "+
+				"Invalid AssetDependenciesValue Attribute Type",
+				"While creating a AssetDependenciesValue value, an invalid attribute value was detected. "+
+					"A AssetDependenciesValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("ManagementGroupsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("ManagementGroupsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("AssetDependenciesValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("AssetDependenciesValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -1153,201 +1651,447 @@ func NewManagementGroupsValue(attributeTypes map[string]attr.Type, attributes ma
 
 		if !ok {
 			diags.AddError(
-				"Extra ManagementGroupsValue Attribute Value",
-				"While creating a ManagementGroupsValue value, an extra attribute value was detected. "+
-					"A ManagementGroupsValue must not contain values beyond the expected attribute types. "+
+				"Extra AssetDependenciesValue Attribute Value",
+				"While creating a AssetDependenciesValue value, an extra attribute value was detected. "+
+					"A AssetDependenciesValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra ManagementGroupsValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra AssetDependenciesValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewManagementGroupsValueUnknown(), diags
+		return NewAssetDependenciesValueUnknown(), diags
 	}
 
-	displayNameAttribute, ok := attributes["display_name"]
+	dependsOnResourceIdAttribute, ok := attributes["depends_on_resource_id"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`display_name is missing from object`)
+			`depends_on_resource_id is missing from object`)
 
-		return NewManagementGroupsValueUnknown(), diags
+		return NewAssetDependenciesValueUnknown(), diags
 	}
 
-	displayNameVal, ok := displayNameAttribute.(basetypes.StringValue)
+	dependsOnResourceIdVal, ok := dependsOnResourceIdAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`display_name expected to be basetypes.StringValue, was: %T`, displayNameAttribute))
+			fmt.Sprintf(`depends_on_resource_id expected to be basetypes.StringValue, was: %T`, dependsOnResourceIdAttribute))
 	}
 
-	existsAttribute, ok := attributes["exists"]
+	resourceIdAttribute, ok := attributes["resource_id"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`exists is missing from object`)
+			`resource_id is missing from object`)
 
-		return NewManagementGroupsValueUnknown(), diags
+		return NewAssetDependenciesValueUnknown(), diags
 	}
 
-	existsVal, ok := existsAttribute.(basetypes.BoolValue)
+	resourceIdVal, ok := resourceIdAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`exists expected to be basetypes.BoolValue, was: %T`, existsAttribute))
+			fmt.Sprintf(`resource_id expected to be basetypes.StringValue, was: %T`, resourceIdAttribute))
 	}
 
-	idAttribute, ok := attributes["id"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`id is missing from object`)
-
-		return NewManagementGroupsValueUnknown(), diags
+	if diags.HasError() {
+		return NewAssetDependenciesValueUnknown(), diags
 	}
 
-	idVal, ok := idAttribute.(basetypes.StringValue)
+	return AssetDependenciesValue{
+		DependsOnResourceId: dependsOnResourceIdVal,
+		ResourceId:          resourceIdVal,
+		state:               attr.ValueStateKnown,
+	}, diags
+}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
-	}
+func NewAssetDependenciesValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) AssetDependenciesValue {
+	object, diags := NewAssetDependenciesValue(attributeTypes, attributes)
 
-	levelAttribute, ok := attributes["level"]
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
 
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`level is missing from object`)
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
 
-		return NewManagementGroupsValueUnknown(), diags
+		panic("NewAssetDependenciesValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
-	levelVal, ok := levelAttribute.(basetypes.NumberValue)
+	return object
+}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`level expected to be basetypes.NumberValue, was: %T`, levelAttribute))
+func (t AssetDependenciesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewAssetDependenciesValueNull(), nil
 	}
 
-	parentIdAttribute, ok := attributes["parent_id"]
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`parent_id is missing from object`)
+	if !in.IsKnown() {
+		return NewAssetDependenciesValueUnknown(), nil
+	}
 
-		return NewManagementGroupsValueUnknown(), diags
+	if in.IsNull() {
+		return NewAssetDependenciesValueNull(), nil
 	}
 
-	parentIdVal, ok := parentIdAttribute.(basetypes.StringValue)
+	attributes := map[string]attr.Value{}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`parent_id expected to be basetypes.StringValue, was: %T`, parentIdAttribute))
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
 	}
 
-	policyAssignmentsAttribute, ok := attributes["policy_assignments"]
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
 
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`policy_assignments is missing from object`)
+		if err != nil {
+			return nil, err
+		}
 
-		return NewManagementGroupsValueUnknown(), diags
+		attributes[k] = a
 	}
 
-	policyAssignmentsVal, ok := policyAssignmentsAttribute.(basetypes.MapValue)
+	return NewAssetDependenciesValueMust(AssetDependenciesValue{}.AttributeTypes(ctx), attributes), nil
+}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`policy_assignments expected to be basetypes.MapValue, was: %T`, policyAssignmentsAttribute))
-	}
+func (t AssetDependenciesType) ValueType(ctx context.Context) attr.Value {
+	return AssetDependenciesValue{}
+}
 
-	policyDefinitionsAttribute, ok := attributes["policy_definitions"]
+var _ basetypes.ObjectValuable = AssetDependenciesValue{}
+
+type AssetDependenciesValue struct {
+	DependsOnResourceId basetypes.StringValue `tfsdk:"depends_on_resource_id"`
+	ResourceId          basetypes.StringValue `tfsdk:"resource_id"`
+	state               attr.ValueState
+}
+
+func (v AssetDependenciesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 2)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["depends_on_resource_id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["resource_id"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 2)
+
+		val, err = v.DependsOnResourceId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["depends_on_resource_id"] = val
+
+		val, err = v.ResourceId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["resource_id"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v AssetDependenciesValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v AssetDependenciesValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v AssetDependenciesValue) String() string {
+	return "AssetDependenciesValue"
+}
+
+func (v AssetDependenciesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"depends_on_resource_id": basetypes.StringType{},
+		"resource_id":            basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"depends_on_resource_id": v.DependsOnResourceId,
+			"resource_id":            v.ResourceId,
+		})
+
+	return objVal, diags
+}
+
+func (v AssetDependenciesValue) Equal(o attr.Value) bool {
+	other, ok := o.(AssetDependenciesValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.DependsOnResourceId.Equal(other.DependsOnResourceId) {
+		return false
+	}
+
+	if !v.ResourceId.Equal(other.ResourceId) {
+		return false
+	}
+
+	return true
+}
+
+func (v AssetDependenciesValue) Type(ctx context.Context) attr.Type {
+	return AssetDependenciesType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v AssetDependenciesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"depends_on_resource_id": basetypes.StringType{},
+		"resource_id":            basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = DefaultIdentityType{}
+
+type DefaultIdentityType struct {
+	basetypes.ObjectType
+}
+
+func (t DefaultIdentityType) Equal(o attr.Type) bool {
+	other, ok := o.(DefaultIdentityType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t DefaultIdentityType) String() string {
+	return "DefaultIdentityType"
+}
+
+func (t DefaultIdentityType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	identityIdAttribute, ok := attributes["identity_id"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`policy_definitions is missing from object`)
+			`identity_id is missing from object`)
 
-		return NewManagementGroupsValueUnknown(), diags
+		return nil, diags
 	}
 
-	policyDefinitionsVal, ok := policyDefinitionsAttribute.(basetypes.MapValue)
+	identityIdVal, ok := identityIdAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`policy_definitions expected to be basetypes.MapValue, was: %T`, policyDefinitionsAttribute))
+			fmt.Sprintf(`identity_id expected to be basetypes.StringValue, was: %T`, identityIdAttribute))
 	}
 
-	policySetDefinitionsAttribute, ok := attributes["policy_set_definitions"]
+	managementGroupIdentityIdsAttribute, ok := attributes["management_group_identity_ids"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`policy_set_definitions is missing from object`)
+			`management_group_identity_ids is missing from object`)
 
-		return NewManagementGroupsValueUnknown(), diags
+		return nil, diags
 	}
 
-	policySetDefinitionsVal, ok := policySetDefinitionsAttribute.(basetypes.MapValue)
+	managementGroupIdentityIdsVal, ok := managementGroupIdentityIdsAttribute.(basetypes.MapValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`policy_set_definitions expected to be basetypes.MapValue, was: %T`, policySetDefinitionsAttribute))
+			fmt.Sprintf(`management_group_identity_ids expected to be basetypes.MapValue, was: %T`, managementGroupIdentityIdsAttribute))
 	}
 
-	roleDefinitionsAttribute, ok := attributes["role_definitions"]
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return DefaultIdentityValue{
+		IdentityId:                 identityIdVal,
+		ManagementGroupIdentityIds: managementGroupIdentityIdsVal,
+		state:                      attr.ValueStateKnown,
+	}, diags
+}
+
+func NewDefaultIdentityValueNull() DefaultIdentityValue {
+	return DefaultIdentityValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewDefaultIdentityValueUnknown() DefaultIdentityValue {
+	return DefaultIdentityValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewDefaultIdentityValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (DefaultIdentityValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing DefaultIdentityValue Attribute Value",
+				"While creating a DefaultIdentityValue value, a missing attribute value was detected. "+
+					"A DefaultIdentityValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("DefaultIdentityValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid DefaultIdentityValue Attribute Type",
+				"While creating a DefaultIdentityValue value, an invalid attribute value was detected. "+
+					"A DefaultIdentityValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("DefaultIdentityValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("DefaultIdentityValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra DefaultIdentityValue Attribute Value",
+				"While creating a DefaultIdentityValue value, an extra attribute value was detected. "+
+					"A DefaultIdentityValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra DefaultIdentityValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewDefaultIdentityValueUnknown(), diags
+	}
+
+	identityIdAttribute, ok := attributes["identity_id"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`role_definitions is missing from object`)
+			`identity_id is missing from object`)
 
-		return NewManagementGroupsValueUnknown(), diags
+		return NewDefaultIdentityValueUnknown(), diags
 	}
 
-	roleDefinitionsVal, ok := roleDefinitionsAttribute.(basetypes.MapValue)
+	identityIdVal, ok := identityIdAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`role_definitions expected to be basetypes.MapValue, was: %T`, roleDefinitionsAttribute))
+			fmt.Sprintf(`identity_id expected to be basetypes.StringValue, was: %T`, identityIdAttribute))
+	}
+
+	managementGroupIdentityIdsAttribute, ok := attributes["management_group_identity_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`management_group_identity_ids is missing from object`)
+
+		return NewDefaultIdentityValueUnknown(), diags
+	}
+
+	managementGroupIdentityIdsVal, ok := managementGroupIdentityIdsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`management_group_identity_ids expected to be basetypes.MapValue, was: %T`, managementGroupIdentityIdsAttribute))
 	}
 
 	if diags.HasError() {
-		return NewManagementGroupsValueUnknown(), diags
+		return NewDefaultIdentityValueUnknown(), diags
 	}
 
-	return ManagementGroupsValue{
-		DisplayName:          displayNameVal,
-		Exists:               existsVal,
-		Id:                   idVal,
-		Level:                levelVal,
-		ParentId:             parentIdVal,
-		PolicyAssignments:    policyAssignmentsVal,
-		PolicyDefinitions:    policyDefinitionsVal,
-		PolicySetDefinitions: policySetDefinitionsVal,
-		RoleDefinitions:      roleDefinitionsVal,
-		state:                attr.ValueStateKnown,
+	return DefaultIdentityValue{
+		IdentityId:                 identityIdVal,
+		ManagementGroupIdentityIds: managementGroupIdentityIdsVal,
+		state:                      attr.ValueStateKnown,
 	}, diags
 }
 
-func NewManagementGroupsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ManagementGroupsValue {
-	object, diags := NewManagementGroupsValue(attributeTypes, attributes)
+func NewDefaultIdentityValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) DefaultIdentityValue {
+	object, diags := NewDefaultIdentityValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -1361,15 +2105,15 @@ func NewManagementGroupsValueMust(attributeTypes map[string]attr.Type, attribute
 				diagnostic.Detail()))
 		}
 
-		panic("NewManagementGroupsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewDefaultIdentityValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t ManagementGroupsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t DefaultIdentityType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewManagementGroupsValueNull(), nil
+		return NewDefaultIdentityValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -1377,11 +2121,11 @@ func (t ManagementGroupsType) ValueFromTerraform(ctx context.Context, in tftypes
 	}
 
 	if !in.IsKnown() {
-		return NewManagementGroupsValueUnknown(), nil
+		return NewDefaultIdentityValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewManagementGroupsValueNull(), nil
+		return NewDefaultIdentityValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -1404,49 +2148,29 @@ func (t ManagementGroupsType) ValueFromTerraform(ctx context.Context, in tftypes
 		attributes[k] = a
 	}
 
-	return NewManagementGroupsValueMust(ManagementGroupsValue{}.AttributeTypes(ctx), attributes), nil
+	return NewDefaultIdentityValueMust(DefaultIdentityValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t ManagementGroupsType) ValueType(ctx context.Context) attr.Value {
-	return ManagementGroupsValue{}
+func (t DefaultIdentityType) ValueType(ctx context.Context) attr.Value {
+	return DefaultIdentityValue{}
 }
 
-var _ basetypes.ObjectValuable = ManagementGroupsValue{}
+var _ basetypes.ObjectValuable = DefaultIdentityValue{}
 
-type ManagementGroupsValue struct {
-	DisplayName          basetypes.StringValue `tfsdk:"display_name"`
-	Exists               basetypes.BoolValue   `tfsdk:"exists"`
-	Id                   basetypes.StringValue `tfsdk:"id"`
-	Level                basetypes.NumberValue `tfsdk:"level"`
-	ParentId             basetypes.StringValue `tfsdk:"parent_id"`
-	PolicyAssignments    basetypes.MapValue    `tfsdk:"policy_assignments"`
-	PolicyDefinitions    basetypes.MapValue    `tfsdk:"policy_definitions"`
-	PolicySetDefinitions basetypes.MapValue    `tfsdk:"policy_set_definitions"`
-	RoleDefinitions      basetypes.MapValue    `tfsdk:"role_definitions"`
-	state                attr.ValueState
+type DefaultIdentityValue struct {
+	IdentityId                 basetypes.StringValue `tfsdk:"identity_id"`
+	ManagementGroupIdentityIds basetypes.MapValue    `tfsdk:"management_group_identity_ids"`
+	state                      attr.ValueState
 }
 
-func (v ManagementGroupsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 9)
+func (v DefaultIdentityValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 2)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["display_name"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["exists"] = basetypes.BoolType{}.TerraformType(ctx)
-	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["level"] = basetypes.NumberType{}.TerraformType(ctx)
-	attrTypes["parent_id"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["policy_assignments"] = basetypes.MapType{
-		ElemType: types.StringType,
-	}.TerraformType(ctx)
-	attrTypes["policy_definitions"] = basetypes.MapType{
-		ElemType: types.StringType,
-	}.TerraformType(ctx)
-	attrTypes["policy_set_definitions"] = basetypes.MapType{
-		ElemType: types.StringType,
-	}.TerraformType(ctx)
-	attrTypes["role_definitions"] = basetypes.MapType{
+	attrTypes["identity_id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["management_group_identity_ids"] = basetypes.MapType{
 		ElemType: types.StringType,
 	}.TerraformType(ctx)
 
@@ -1454,261 +2178,77 @@ func (v ManagementGroupsValue) ToTerraformValue(ctx context.Context) (tftypes.Va
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 9)
+		vals := make(map[string]tftypes.Value, 2)
 
-		val, err = v.DisplayName.ToTerraformValue(ctx)
+		val, err = v.IdentityId.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["display_name"] = val
+		vals["identity_id"] = val
 
-		val, err = v.Exists.ToTerraformValue(ctx)
+		val, err = v.ManagementGroupIdentityIds.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["exists"] = val
-
-		val, err = v.Id.ToTerraformValue(ctx)
+		vals["management_group_identity_ids"] = val
 
-		if err != nil {
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["id"] = val
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
 
-		val, err = v.Level.ToTerraformValue(ctx)
+func (v DefaultIdentityValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
 
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["level"] = val
-
-		val, err = v.ParentId.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["parent_id"] = val
-
-		val, err = v.PolicyAssignments.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["policy_assignments"] = val
-
-		val, err = v.PolicyDefinitions.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["policy_definitions"] = val
-
-		val, err = v.PolicySetDefinitions.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["policy_set_definitions"] = val
-
-		val, err = v.RoleDefinitions.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["role_definitions"] = val
-
-		if err := tftypes.ValidateValue(objectType, vals); err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		return tftypes.NewValue(objectType, vals), nil
-	case attr.ValueStateNull:
-		return tftypes.NewValue(objectType, nil), nil
-	case attr.ValueStateUnknown:
-		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
-	default:
-		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
-	}
-}
-
-func (v ManagementGroupsValue) IsNull() bool {
-	return v.state == attr.ValueStateNull
-}
-
-func (v ManagementGroupsValue) IsUnknown() bool {
+func (v DefaultIdentityValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v ManagementGroupsValue) String() string {
-	return "ManagementGroupsValue"
+func (v DefaultIdentityValue) String() string {
+	return "DefaultIdentityValue"
 }
 
-func (v ManagementGroupsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v DefaultIdentityValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	var policyAssignmentsVal basetypes.MapValue
-	switch {
-	case v.PolicyAssignments.IsUnknown():
-		policyAssignmentsVal = types.MapUnknown(types.StringType)
-	case v.PolicyAssignments.IsNull():
-		policyAssignmentsVal = types.MapNull(types.StringType)
-	default:
-		var d diag.Diagnostics
-		policyAssignmentsVal, d = types.MapValue(types.StringType, v.PolicyAssignments.Elements())
-		diags.Append(d...)
-	}
-
-	if diags.HasError() {
-		return types.ObjectUnknown(map[string]attr.Type{
-			"display_name": basetypes.StringType{},
-			"exists":       basetypes.BoolType{},
-			"id":           basetypes.StringType{},
-			"level":        basetypes.NumberType{},
-			"parent_id":    basetypes.StringType{},
-			"policy_assignments": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-			"policy_definitions": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-			"policy_set_definitions": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-			"role_definitions": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-		}), diags
-	}
-
-	var policyDefinitionsVal basetypes.MapValue
-	switch {
-	case v.PolicyDefinitions.IsUnknown():
-		policyDefinitionsVal = types.MapUnknown(types.StringType)
-	case v.PolicyDefinitions.IsNull():
-		policyDefinitionsVal = types.MapNull(types.StringType)
-	default:
-		var d diag.Diagnostics
-		policyDefinitionsVal, d = types.MapValue(types.StringType, v.PolicyDefinitions.Elements())
-		diags.Append(d...)
-	}
-
-	if diags.HasError() {
-		return types.ObjectUnknown(map[string]attr.Type{
-			"display_name": basetypes.StringType{},
-			"exists":       basetypes.BoolType{},
-			"id":           basetypes.StringType{},
-			"level":        basetypes.NumberType{},
-			"parent_id":    basetypes.StringType{},
-			"policy_assignments": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-			"policy_definitions": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-			"policy_set_definitions": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-			"role_definitions": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-		}), diags
-	}
-
-	var policySetDefinitionsVal basetypes.MapValue
-	switch {
-	case v.PolicySetDefinitions.IsUnknown():
-		policySetDefinitionsVal = types.MapUnknown(types.StringType)
-	case v.PolicySetDefinitions.IsNull():
-		policySetDefinitionsVal = types.MapNull(types.StringType)
-	default:
-		var d diag.Diagnostics
-		policySetDefinitionsVal, d = types.MapValue(types.StringType, v.PolicySetDefinitions.Elements())
-		diags.Append(d...)
-	}
-
-	if diags.HasError() {
-		return types.ObjectUnknown(map[string]attr.Type{
-			"display_name": basetypes.StringType{},
-			"exists":       basetypes.BoolType{},
-			"id":           basetypes.StringType{},
-			"level":        basetypes.NumberType{},
-			"parent_id":    basetypes.StringType{},
-			"policy_assignments": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-			"policy_definitions": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-			"policy_set_definitions": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-			"role_definitions": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-		}), diags
-	}
-
-	var roleDefinitionsVal basetypes.MapValue
+	var managementGroupIdentityIdsVal basetypes.MapValue
 	switch {
-	case v.RoleDefinitions.IsUnknown():
-		roleDefinitionsVal = types.MapUnknown(types.StringType)
-	case v.RoleDefinitions.IsNull():
-		roleDefinitionsVal = types.MapNull(types.StringType)
+	case v.ManagementGroupIdentityIds.IsUnknown():
+		managementGroupIdentityIdsVal = types.MapUnknown(types.StringType)
+	case v.ManagementGroupIdentityIds.IsNull():
+		managementGroupIdentityIdsVal = types.MapNull(types.StringType)
 	default:
 		var d diag.Diagnostics
-		roleDefinitionsVal, d = types.MapValue(types.StringType, v.RoleDefinitions.Elements())
+		managementGroupIdentityIdsVal, d = types.MapValue(types.StringType, v.ManagementGroupIdentityIds.Elements())
 		diags.Append(d...)
 	}
 
 	if diags.HasError() {
 		return types.ObjectUnknown(map[string]attr.Type{
-			"display_name": basetypes.StringType{},
-			"exists":       basetypes.BoolType{},
-			"id":           basetypes.StringType{},
-			"level":        basetypes.NumberType{},
-			"parent_id":    basetypes.StringType{},
-			"policy_assignments": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-			"policy_definitions": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-			"policy_set_definitions": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-			"role_definitions": basetypes.MapType{
+			"identity_id": basetypes.StringType{},
+			"management_group_identity_ids": basetypes.MapType{
 				ElemType: types.StringType,
 			},
 		}), diags
 	}
 
 	attributeTypes := map[string]attr.Type{
-		"display_name": basetypes.StringType{},
-		"exists":       basetypes.BoolType{},
-		"id":           basetypes.StringType{},
-		"level":        basetypes.NumberType{},
-		"parent_id":    basetypes.StringType{},
-		"policy_assignments": basetypes.MapType{
-			ElemType: types.StringType,
-		},
-		"policy_definitions": basetypes.MapType{
-			ElemType: types.StringType,
-		},
-		"policy_set_definitions": basetypes.MapType{
-			ElemType: types.StringType,
-		},
-		"role_definitions": basetypes.MapType{
+		"identity_id": basetypes.StringType{},
+		"management_group_identity_ids": basetypes.MapType{
 			ElemType: types.StringType,
 		},
 	}
@@ -1724,22 +2264,15 @@ func (v ManagementGroupsValue) ToObjectValue(ctx context.Context) (basetypes.Obj
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"display_name":           v.DisplayName,
-			"exists":                 v.Exists,
-			"id":                     v.Id,
-			"level":                  v.Level,
-			"parent_id":              v.ParentId,
-			"policy_assignments":     policyAssignmentsVal,
-			"policy_definitions":     policyDefinitionsVal,
-			"policy_set_definitions": policySetDefinitionsVal,
-			"role_definitions":       roleDefinitionsVal,
+			"identity_id":                   v.IdentityId,
+			"management_group_identity_ids": managementGroupIdentityIdsVal,
 		})
 
 	return objVal, diags
 }
 
-func (v ManagementGroupsValue) Equal(o attr.Value) bool {
-	other, ok := o.(ManagementGroupsValue)
+func (v DefaultIdentityValue) Equal(o attr.Value) bool {
+	other, ok := o.(DefaultIdentityValue)
 
 	if !ok {
 		return false
@@ -1753,160 +2286,4663 @@ func (v ManagementGroupsValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.DisplayName.Equal(other.DisplayName) {
+	if !v.IdentityId.Equal(other.IdentityId) {
 		return false
 	}
 
-	if !v.Exists.Equal(other.Exists) {
+	if !v.ManagementGroupIdentityIds.Equal(other.ManagementGroupIdentityIds) {
 		return false
 	}
 
-	if !v.Id.Equal(other.Id) {
-		return false
-	}
+	return true
+}
 
-	if !v.Level.Equal(other.Level) {
-		return false
+func (v DefaultIdentityValue) Type(ctx context.Context) attr.Type {
+	return DefaultIdentityType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
 	}
+}
 
-	if !v.ParentId.Equal(other.ParentId) {
-		return false
+func (v DefaultIdentityValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"identity_id": basetypes.StringType{},
+		"management_group_identity_ids": basetypes.MapType{
+			ElemType: types.StringType,
+		},
 	}
+}
 
-	if !v.PolicyAssignments.Equal(other.PolicyAssignments) {
-		return false
-	}
+var _ basetypes.ObjectTypable = DefaultNonComplianceMessageSettingsType{}
 
-	if !v.PolicyDefinitions.Equal(other.PolicyDefinitions) {
+type DefaultNonComplianceMessageSettingsType struct {
+	basetypes.ObjectType
+}
+
+func (t DefaultNonComplianceMessageSettingsType) Equal(o attr.Type) bool {
+	other, ok := o.(DefaultNonComplianceMessageSettingsType)
+
+	if !ok {
 		return false
 	}
 
-	if !v.PolicySetDefinitions.Equal(other.PolicySetDefinitions) {
-		return false
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t DefaultNonComplianceMessageSettingsType) String() string {
+	return "DefaultNonComplianceMessageSettingsType"
+}
+
+func (t DefaultNonComplianceMessageSettingsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	defaultMessageAttribute, ok := attributes["default_message"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`default_message is missing from object`)
+
+		return nil, diags
+	}
+
+	defaultMessageVal, ok := defaultMessageAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`default_message expected to be basetypes.StringValue, was: %T`, defaultMessageAttribute))
+	}
+
+	mergeModeAttribute, ok := attributes["merge_mode"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`merge_mode is missing from object`)
+
+		return nil, diags
+	}
+
+	mergeModeVal, ok := mergeModeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`merge_mode expected to be basetypes.StringValue, was: %T`, mergeModeAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return DefaultNonComplianceMessageSettingsValue{
+		DefaultMessage: defaultMessageVal,
+		MergeMode:      mergeModeVal,
+		state:          attr.ValueStateKnown,
+	}, diags
+}
+
+func NewDefaultNonComplianceMessageSettingsValueNull() DefaultNonComplianceMessageSettingsValue {
+	return DefaultNonComplianceMessageSettingsValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewDefaultNonComplianceMessageSettingsValueUnknown() DefaultNonComplianceMessageSettingsValue {
+	return DefaultNonComplianceMessageSettingsValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewDefaultNonComplianceMessageSettingsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (DefaultNonComplianceMessageSettingsValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing DefaultNonComplianceMessageSettingsValue Attribute Value",
+				"While creating a DefaultNonComplianceMessageSettingsValue value, a missing attribute value was detected. "+
+					"A DefaultNonComplianceMessageSettingsValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("DefaultNonComplianceMessageSettingsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid DefaultNonComplianceMessageSettingsValue Attribute Type",
+				"While creating a DefaultNonComplianceMessageSettingsValue value, an invalid attribute value was detected. "+
+					"A DefaultNonComplianceMessageSettingsValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("DefaultNonComplianceMessageSettingsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("DefaultNonComplianceMessageSettingsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra DefaultNonComplianceMessageSettingsValue Attribute Value",
+				"While creating a DefaultNonComplianceMessageSettingsValue value, an extra attribute value was detected. "+
+					"A DefaultNonComplianceMessageSettingsValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra DefaultNonComplianceMessageSettingsValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewDefaultNonComplianceMessageSettingsValueUnknown(), diags
+	}
+
+	defaultMessageAttribute, ok := attributes["default_message"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`default_message is missing from object`)
+
+		return NewDefaultNonComplianceMessageSettingsValueUnknown(), diags
+	}
+
+	defaultMessageVal, ok := defaultMessageAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`default_message expected to be basetypes.StringValue, was: %T`, defaultMessageAttribute))
+	}
+
+	mergeModeAttribute, ok := attributes["merge_mode"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`merge_mode is missing from object`)
+
+		return NewDefaultNonComplianceMessageSettingsValueUnknown(), diags
+	}
+
+	mergeModeVal, ok := mergeModeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`merge_mode expected to be basetypes.StringValue, was: %T`, mergeModeAttribute))
+	}
+
+	if diags.HasError() {
+		return NewDefaultNonComplianceMessageSettingsValueUnknown(), diags
+	}
+
+	return DefaultNonComplianceMessageSettingsValue{
+		DefaultMessage: defaultMessageVal,
+		MergeMode:      mergeModeVal,
+		state:          attr.ValueStateKnown,
+	}, diags
+}
+
+func NewDefaultNonComplianceMessageSettingsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) DefaultNonComplianceMessageSettingsValue {
+	object, diags := NewDefaultNonComplianceMessageSettingsValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewDefaultNonComplianceMessageSettingsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t DefaultNonComplianceMessageSettingsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewDefaultNonComplianceMessageSettingsValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewDefaultNonComplianceMessageSettingsValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewDefaultNonComplianceMessageSettingsValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewDefaultNonComplianceMessageSettingsValueMust(DefaultNonComplianceMessageSettingsValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t DefaultNonComplianceMessageSettingsType) ValueType(ctx context.Context) attr.Value {
+	return DefaultNonComplianceMessageSettingsValue{}
+}
+
+var _ basetypes.ObjectValuable = DefaultNonComplianceMessageSettingsValue{}
+
+type DefaultNonComplianceMessageSettingsValue struct {
+	DefaultMessage basetypes.StringValue `tfsdk:"default_message"`
+	MergeMode      basetypes.StringValue `tfsdk:"merge_mode"`
+	state          attr.ValueState
+}
+
+func (v DefaultNonComplianceMessageSettingsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 2)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["default_message"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["merge_mode"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 2)
+
+		val, err = v.DefaultMessage.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["default_message"] = val
+
+		val, err = v.MergeMode.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["merge_mode"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v DefaultNonComplianceMessageSettingsValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v DefaultNonComplianceMessageSettingsValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v DefaultNonComplianceMessageSettingsValue) String() string {
+	return "DefaultNonComplianceMessageSettingsValue"
+}
+
+func (v DefaultNonComplianceMessageSettingsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"default_message": basetypes.StringType{},
+		"merge_mode":      basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"default_message": v.DefaultMessage,
+			"merge_mode":      v.MergeMode,
+		})
+
+	return objVal, diags
+}
+
+func (v DefaultNonComplianceMessageSettingsValue) Equal(o attr.Value) bool {
+	other, ok := o.(DefaultNonComplianceMessageSettingsValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.DefaultMessage.Equal(other.DefaultMessage) {
+		return false
+	}
+
+	if !v.MergeMode.Equal(other.MergeMode) {
+		return false
+	}
+
+	return true
+}
+
+func (v DefaultNonComplianceMessageSettingsValue) Type(ctx context.Context) attr.Type {
+	return DefaultNonComplianceMessageSettingsType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v DefaultNonComplianceMessageSettingsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"default_message": basetypes.StringType{},
+		"merge_mode":      basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = ManagementGroupNamingType{}
+
+type ManagementGroupNamingType struct {
+	basetypes.ObjectType
+}
+
+func (t ManagementGroupNamingType) Equal(o attr.Type) bool {
+	other, ok := o.(ManagementGroupNamingType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t ManagementGroupNamingType) String() string {
+	return "ManagementGroupNamingType"
+}
+
+func (t ManagementGroupNamingType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	displayNameTemplateAttribute, ok := attributes["display_name_template"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`display_name_template is missing from object`)
+
+		return nil, diags
+	}
+
+	displayNameTemplateVal, ok := displayNameTemplateAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`display_name_template expected to be basetypes.StringValue, was: %T`, displayNameTemplateAttribute))
+	}
+
+	displayNamesAttribute, ok := attributes["display_names"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`display_names is missing from object`)
+
+		return nil, diags
+	}
+
+	displayNamesVal, ok := displayNamesAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`display_names expected to be basetypes.MapValue, was: %T`, displayNamesAttribute))
+	}
+
+	idTemplateAttribute, ok := attributes["id_template"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id_template is missing from object`)
+
+		return nil, diags
+	}
+
+	idTemplateVal, ok := idTemplateAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id_template expected to be basetypes.StringValue, was: %T`, idTemplateAttribute))
+	}
+
+	idsAttribute, ok := attributes["ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ids is missing from object`)
+
+		return nil, diags
+	}
+
+	idsVal, ok := idsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ids expected to be basetypes.MapValue, was: %T`, idsAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return ManagementGroupNamingValue{
+		DisplayNameTemplate: displayNameTemplateVal,
+		DisplayNames:        displayNamesVal,
+		IdTemplate:          idTemplateVal,
+		Ids:                 idsVal,
+		state:               attr.ValueStateKnown,
+	}, diags
+}
+
+func NewManagementGroupNamingValueNull() ManagementGroupNamingValue {
+	return ManagementGroupNamingValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewManagementGroupNamingValueUnknown() ManagementGroupNamingValue {
+	return ManagementGroupNamingValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewManagementGroupNamingValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ManagementGroupNamingValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing ManagementGroupNamingValue Attribute Value",
+				"While creating a ManagementGroupNamingValue value, a missing attribute value was detected. "+
+					"A ManagementGroupNamingValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ManagementGroupNamingValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid ManagementGroupNamingValue Attribute Type",
+				"While creating a ManagementGroupNamingValue value, an invalid attribute value was detected. "+
+					"A ManagementGroupNamingValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ManagementGroupNamingValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("ManagementGroupNamingValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra ManagementGroupNamingValue Attribute Value",
+				"While creating a ManagementGroupNamingValue value, an extra attribute value was detected. "+
+					"A ManagementGroupNamingValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra ManagementGroupNamingValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewManagementGroupNamingValueUnknown(), diags
+	}
+
+	displayNameTemplateAttribute, ok := attributes["display_name_template"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`display_name_template is missing from object`)
+
+		return NewManagementGroupNamingValueUnknown(), diags
+	}
+
+	displayNameTemplateVal, ok := displayNameTemplateAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`display_name_template expected to be basetypes.StringValue, was: %T`, displayNameTemplateAttribute))
+	}
+
+	displayNamesAttribute, ok := attributes["display_names"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`display_names is missing from object`)
+
+		return NewManagementGroupNamingValueUnknown(), diags
+	}
+
+	displayNamesVal, ok := displayNamesAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`display_names expected to be basetypes.MapValue, was: %T`, displayNamesAttribute))
+	}
+
+	idTemplateAttribute, ok := attributes["id_template"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id_template is missing from object`)
+
+		return NewManagementGroupNamingValueUnknown(), diags
+	}
+
+	idTemplateVal, ok := idTemplateAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id_template expected to be basetypes.StringValue, was: %T`, idTemplateAttribute))
+	}
+
+	idsAttribute, ok := attributes["ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ids is missing from object`)
+
+		return NewManagementGroupNamingValueUnknown(), diags
+	}
+
+	idsVal, ok := idsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ids expected to be basetypes.MapValue, was: %T`, idsAttribute))
+	}
+
+	if diags.HasError() {
+		return NewManagementGroupNamingValueUnknown(), diags
+	}
+
+	return ManagementGroupNamingValue{
+		DisplayNameTemplate: displayNameTemplateVal,
+		DisplayNames:        displayNamesVal,
+		IdTemplate:          idTemplateVal,
+		Ids:                 idsVal,
+		state:               attr.ValueStateKnown,
+	}, diags
+}
+
+func NewManagementGroupNamingValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ManagementGroupNamingValue {
+	object, diags := NewManagementGroupNamingValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewManagementGroupNamingValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t ManagementGroupNamingType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewManagementGroupNamingValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewManagementGroupNamingValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewManagementGroupNamingValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewManagementGroupNamingValueMust(ManagementGroupNamingValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t ManagementGroupNamingType) ValueType(ctx context.Context) attr.Value {
+	return ManagementGroupNamingValue{}
+}
+
+var _ basetypes.ObjectValuable = ManagementGroupNamingValue{}
+
+type ManagementGroupNamingValue struct {
+	DisplayNameTemplate basetypes.StringValue `tfsdk:"display_name_template"`
+	DisplayNames        basetypes.MapValue    `tfsdk:"display_names"`
+	IdTemplate          basetypes.StringValue `tfsdk:"id_template"`
+	Ids                 basetypes.MapValue    `tfsdk:"ids"`
+	state               attr.ValueState
+}
+
+func (v ManagementGroupNamingValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 4)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["display_name_template"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["display_names"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["id_template"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["ids"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 4)
+
+		val, err = v.DisplayNameTemplate.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["display_name_template"] = val
+
+		val, err = v.DisplayNames.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["display_names"] = val
+
+		val, err = v.IdTemplate.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["id_template"] = val
+
+		val, err = v.Ids.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["ids"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v ManagementGroupNamingValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v ManagementGroupNamingValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v ManagementGroupNamingValue) String() string {
+	return "ManagementGroupNamingValue"
+}
+
+func (v ManagementGroupNamingValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var displayNamesVal basetypes.MapValue
+	switch {
+	case v.DisplayNames.IsUnknown():
+		displayNamesVal = types.MapUnknown(types.StringType)
+	case v.DisplayNames.IsNull():
+		displayNamesVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		displayNamesVal, d = types.MapValue(types.StringType, v.DisplayNames.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"display_name_template": basetypes.StringType{},
+			"display_names": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"id_template": basetypes.StringType{},
+			"ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var idsVal basetypes.MapValue
+	switch {
+	case v.Ids.IsUnknown():
+		idsVal = types.MapUnknown(types.StringType)
+	case v.Ids.IsNull():
+		idsVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		idsVal, d = types.MapValue(types.StringType, v.Ids.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"display_name_template": basetypes.StringType{},
+			"display_names": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"id_template": basetypes.StringType{},
+			"ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"display_name_template": basetypes.StringType{},
+		"display_names": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"id_template": basetypes.StringType{},
+		"ids": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"display_name_template": v.DisplayNameTemplate,
+			"display_names":         displayNamesVal,
+			"id_template":           v.IdTemplate,
+			"ids":                   idsVal,
+		})
+
+	return objVal, diags
+}
+
+func (v ManagementGroupNamingValue) Equal(o attr.Value) bool {
+	other, ok := o.(ManagementGroupNamingValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.DisplayNameTemplate.Equal(other.DisplayNameTemplate) {
+		return false
+	}
+
+	if !v.DisplayNames.Equal(other.DisplayNames) {
+		return false
+	}
+
+	if !v.IdTemplate.Equal(other.IdTemplate) {
+		return false
+	}
+
+	if !v.Ids.Equal(other.Ids) {
+		return false
+	}
+
+	return true
+}
+
+func (v ManagementGroupNamingValue) Type(ctx context.Context) attr.Type {
+	return ManagementGroupNamingType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v ManagementGroupNamingValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"display_name_template": basetypes.StringType{},
+		"display_names": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"id_template": basetypes.StringType{},
+		"ids": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+	}
+}
+
+var _ basetypes.ObjectTypable = ManagementGroupsType{}
+
+type ManagementGroupsType struct {
+	basetypes.ObjectType
+}
+
+func (t ManagementGroupsType) Equal(o attr.Type) bool {
+	other, ok := o.(ManagementGroupsType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t ManagementGroupsType) String() string {
+	return "ManagementGroupsType"
+}
+
+func (t ManagementGroupsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	displayNameAttribute, ok := attributes["display_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`display_name is missing from object`)
+
+		return nil, diags
+	}
+
+	displayNameVal, ok := displayNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`display_name expected to be basetypes.StringValue, was: %T`, displayNameAttribute))
+	}
+
+	existsAttribute, ok := attributes["exists"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`exists is missing from object`)
+
+		return nil, diags
+	}
+
+	existsVal, ok := existsAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`exists expected to be basetypes.BoolValue, was: %T`, existsAttribute))
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return nil, diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
+	}
+
+	levelAttribute, ok := attributes["level"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`level is missing from object`)
+
+		return nil, diags
+	}
+
+	levelVal, ok := levelAttribute.(basetypes.NumberValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`level expected to be basetypes.NumberValue, was: %T`, levelAttribute))
+	}
+
+	parentIdAttribute, ok := attributes["parent_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`parent_id is missing from object`)
+
+		return nil, diags
+	}
+
+	parentIdVal, ok := parentIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`parent_id expected to be basetypes.StringValue, was: %T`, parentIdAttribute))
+	}
+
+	policyAssignmentIdsAttribute, ok := attributes["policy_assignment_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignment_ids is missing from object`)
+
+		return nil, diags
+	}
+
+	policyAssignmentIdsVal, ok := policyAssignmentIdsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignment_ids expected to be basetypes.MapValue, was: %T`, policyAssignmentIdsAttribute))
+	}
+
+	policyAssignmentsAttribute, ok := attributes["policy_assignments"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignments is missing from object`)
+
+		return nil, diags
+	}
+
+	policyAssignmentsVal, ok := policyAssignmentsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignments expected to be basetypes.MapValue, was: %T`, policyAssignmentsAttribute))
+	}
+
+	policyDefinitionIdsAttribute, ok := attributes["policy_definition_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definition_ids is missing from object`)
+
+		return nil, diags
+	}
+
+	policyDefinitionIdsVal, ok := policyDefinitionIdsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definition_ids expected to be basetypes.MapValue, was: %T`, policyDefinitionIdsAttribute))
+	}
+
+	policyDefinitionsAttribute, ok := attributes["policy_definitions"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definitions is missing from object`)
+
+		return nil, diags
+	}
+
+	policyDefinitionsVal, ok := policyDefinitionsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definitions expected to be basetypes.MapValue, was: %T`, policyDefinitionsAttribute))
+	}
+
+	policyExemptionIdsAttribute, ok := attributes["policy_exemption_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_exemption_ids is missing from object`)
+
+		return nil, diags
+	}
+
+	policyExemptionIdsVal, ok := policyExemptionIdsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_exemption_ids expected to be basetypes.MapValue, was: %T`, policyExemptionIdsAttribute))
+	}
+
+	policyExemptionsAttribute, ok := attributes["policy_exemptions"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_exemptions is missing from object`)
+
+		return nil, diags
+	}
+
+	policyExemptionsVal, ok := policyExemptionsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_exemptions expected to be basetypes.MapValue, was: %T`, policyExemptionsAttribute))
+	}
+
+	policySetDefinitionIdsAttribute, ok := attributes["policy_set_definition_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_set_definition_ids is missing from object`)
+
+		return nil, diags
+	}
+
+	policySetDefinitionIdsVal, ok := policySetDefinitionIdsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_set_definition_ids expected to be basetypes.MapValue, was: %T`, policySetDefinitionIdsAttribute))
+	}
+
+	policySetDefinitionsAttribute, ok := attributes["policy_set_definitions"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_set_definitions is missing from object`)
+
+		return nil, diags
+	}
+
+	policySetDefinitionsVal, ok := policySetDefinitionsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_set_definitions expected to be basetypes.MapValue, was: %T`, policySetDefinitionsAttribute))
+	}
+
+	roleDefinitionIdsAttribute, ok := attributes["role_definition_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`role_definition_ids is missing from object`)
+
+		return nil, diags
+	}
+
+	roleDefinitionIdsVal, ok := roleDefinitionIdsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`role_definition_ids expected to be basetypes.MapValue, was: %T`, roleDefinitionIdsAttribute))
+	}
+
+	roleDefinitionsAttribute, ok := attributes["role_definitions"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`role_definitions is missing from object`)
+
+		return nil, diags
+	}
+
+	roleDefinitionsVal, ok := roleDefinitionsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`role_definitions expected to be basetypes.MapValue, was: %T`, roleDefinitionsAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return ManagementGroupsValue{
+		DisplayName:            displayNameVal,
+		Exists:                 existsVal,
+		Id:                     idVal,
+		Level:                  levelVal,
+		ParentId:               parentIdVal,
+		PolicyAssignmentIds:    policyAssignmentIdsVal,
+		PolicyAssignments:      policyAssignmentsVal,
+		PolicyDefinitionIds:    policyDefinitionIdsVal,
+		PolicyDefinitions:      policyDefinitionsVal,
+		PolicyExemptionIds:     policyExemptionIdsVal,
+		PolicyExemptions:       policyExemptionsVal,
+		PolicySetDefinitionIds: policySetDefinitionIdsVal,
+		PolicySetDefinitions:   policySetDefinitionsVal,
+		RoleDefinitionIds:      roleDefinitionIdsVal,
+		RoleDefinitions:        roleDefinitionsVal,
+		state:                  attr.ValueStateKnown,
+	}, diags
+}
+
+func NewManagementGroupsValueNull() ManagementGroupsValue {
+	return ManagementGroupsValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewManagementGroupsValueUnknown() ManagementGroupsValue {
+	return ManagementGroupsValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewManagementGroupsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ManagementGroupsValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing ManagementGroupsValue Attribute Value",
+				"While creating a ManagementGroupsValue value, a missing attribute value was detected. "+
+					"A ManagementGroupsValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ManagementGroupsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid ManagementGroupsValue Attribute Type",
+				"While creating a ManagementGroupsValue value, an invalid attribute value was detected. "+
+					"A ManagementGroupsValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ManagementGroupsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("ManagementGroupsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra ManagementGroupsValue Attribute Value",
+				"While creating a ManagementGroupsValue value, an extra attribute value was detected. "+
+					"A ManagementGroupsValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra ManagementGroupsValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	displayNameAttribute, ok := attributes["display_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`display_name is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	displayNameVal, ok := displayNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`display_name expected to be basetypes.StringValue, was: %T`, displayNameAttribute))
+	}
+
+	existsAttribute, ok := attributes["exists"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`exists is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	existsVal, ok := existsAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`exists expected to be basetypes.BoolValue, was: %T`, existsAttribute))
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
+	}
+
+	levelAttribute, ok := attributes["level"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`level is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	levelVal, ok := levelAttribute.(basetypes.NumberValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`level expected to be basetypes.NumberValue, was: %T`, levelAttribute))
+	}
+
+	parentIdAttribute, ok := attributes["parent_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`parent_id is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	parentIdVal, ok := parentIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`parent_id expected to be basetypes.StringValue, was: %T`, parentIdAttribute))
+	}
+
+	policyAssignmentIdsAttribute, ok := attributes["policy_assignment_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignment_ids is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	policyAssignmentIdsVal, ok := policyAssignmentIdsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignment_ids expected to be basetypes.MapValue, was: %T`, policyAssignmentIdsAttribute))
+	}
+
+	policyAssignmentsAttribute, ok := attributes["policy_assignments"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignments is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	policyAssignmentsVal, ok := policyAssignmentsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignments expected to be basetypes.MapValue, was: %T`, policyAssignmentsAttribute))
+	}
+
+	policyDefinitionIdsAttribute, ok := attributes["policy_definition_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definition_ids is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	policyDefinitionIdsVal, ok := policyDefinitionIdsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definition_ids expected to be basetypes.MapValue, was: %T`, policyDefinitionIdsAttribute))
+	}
+
+	policyDefinitionsAttribute, ok := attributes["policy_definitions"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definitions is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	policyDefinitionsVal, ok := policyDefinitionsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definitions expected to be basetypes.MapValue, was: %T`, policyDefinitionsAttribute))
+	}
+
+	policyExemptionIdsAttribute, ok := attributes["policy_exemption_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_exemption_ids is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	policyExemptionIdsVal, ok := policyExemptionIdsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_exemption_ids expected to be basetypes.MapValue, was: %T`, policyExemptionIdsAttribute))
+	}
+
+	policyExemptionsAttribute, ok := attributes["policy_exemptions"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_exemptions is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	policyExemptionsVal, ok := policyExemptionsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_exemptions expected to be basetypes.MapValue, was: %T`, policyExemptionsAttribute))
+	}
+
+	policySetDefinitionIdsAttribute, ok := attributes["policy_set_definition_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_set_definition_ids is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	policySetDefinitionIdsVal, ok := policySetDefinitionIdsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_set_definition_ids expected to be basetypes.MapValue, was: %T`, policySetDefinitionIdsAttribute))
+	}
+
+	policySetDefinitionsAttribute, ok := attributes["policy_set_definitions"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_set_definitions is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	policySetDefinitionsVal, ok := policySetDefinitionsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_set_definitions expected to be basetypes.MapValue, was: %T`, policySetDefinitionsAttribute))
+	}
+
+	roleDefinitionIdsAttribute, ok := attributes["role_definition_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`role_definition_ids is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	roleDefinitionIdsVal, ok := roleDefinitionIdsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`role_definition_ids expected to be basetypes.MapValue, was: %T`, roleDefinitionIdsAttribute))
+	}
+
+	roleDefinitionsAttribute, ok := attributes["role_definitions"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`role_definitions is missing from object`)
+
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	roleDefinitionsVal, ok := roleDefinitionsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`role_definitions expected to be basetypes.MapValue, was: %T`, roleDefinitionsAttribute))
+	}
+
+	if diags.HasError() {
+		return NewManagementGroupsValueUnknown(), diags
+	}
+
+	return ManagementGroupsValue{
+		DisplayName:            displayNameVal,
+		Exists:                 existsVal,
+		Id:                     idVal,
+		Level:                  levelVal,
+		ParentId:               parentIdVal,
+		PolicyAssignmentIds:    policyAssignmentIdsVal,
+		PolicyAssignments:      policyAssignmentsVal,
+		PolicyDefinitionIds:    policyDefinitionIdsVal,
+		PolicyDefinitions:      policyDefinitionsVal,
+		PolicyExemptionIds:     policyExemptionIdsVal,
+		PolicyExemptions:       policyExemptionsVal,
+		PolicySetDefinitionIds: policySetDefinitionIdsVal,
+		PolicySetDefinitions:   policySetDefinitionsVal,
+		RoleDefinitionIds:      roleDefinitionIdsVal,
+		RoleDefinitions:        roleDefinitionsVal,
+		state:                  attr.ValueStateKnown,
+	}, diags
+}
+
+func NewManagementGroupsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ManagementGroupsValue {
+	object, diags := NewManagementGroupsValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewManagementGroupsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t ManagementGroupsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewManagementGroupsValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewManagementGroupsValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewManagementGroupsValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewManagementGroupsValueMust(ManagementGroupsValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t ManagementGroupsType) ValueType(ctx context.Context) attr.Value {
+	return ManagementGroupsValue{}
+}
+
+var _ basetypes.ObjectValuable = ManagementGroupsValue{}
+
+type ManagementGroupsValue struct {
+	DisplayName            basetypes.StringValue `tfsdk:"display_name"`
+	Exists                 basetypes.BoolValue   `tfsdk:"exists"`
+	Id                     basetypes.StringValue `tfsdk:"id"`
+	Level                  basetypes.NumberValue `tfsdk:"level"`
+	ParentId               basetypes.StringValue `tfsdk:"parent_id"`
+	PolicyAssignmentIds    basetypes.MapValue    `tfsdk:"policy_assignment_ids"`
+	PolicyAssignments      basetypes.MapValue    `tfsdk:"policy_assignments"`
+	PolicyDefinitionIds    basetypes.MapValue    `tfsdk:"policy_definition_ids"`
+	PolicyDefinitions      basetypes.MapValue    `tfsdk:"policy_definitions"`
+	PolicyExemptionIds     basetypes.MapValue    `tfsdk:"policy_exemption_ids"`
+	PolicyExemptions       basetypes.MapValue    `tfsdk:"policy_exemptions"`
+	PolicySetDefinitionIds basetypes.MapValue    `tfsdk:"policy_set_definition_ids"`
+	PolicySetDefinitions   basetypes.MapValue    `tfsdk:"policy_set_definitions"`
+	RoleDefinitionIds      basetypes.MapValue    `tfsdk:"role_definition_ids"`
+	RoleDefinitions        basetypes.MapValue    `tfsdk:"role_definitions"`
+	state                  attr.ValueState
+}
+
+func (v ManagementGroupsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 15)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["display_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["exists"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["level"] = basetypes.NumberType{}.TerraformType(ctx)
+	attrTypes["parent_id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["policy_assignment_ids"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["policy_assignments"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["policy_definition_ids"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["policy_definitions"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["policy_exemption_ids"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["policy_exemptions"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["policy_set_definition_ids"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["policy_set_definitions"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["role_definition_ids"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["role_definitions"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 15)
+
+		val, err = v.DisplayName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["display_name"] = val
+
+		val, err = v.Exists.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["exists"] = val
+
+		val, err = v.Id.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["id"] = val
+
+		val, err = v.Level.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["level"] = val
+
+		val, err = v.ParentId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["parent_id"] = val
+
+		val, err = v.PolicyAssignmentIds.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_assignment_ids"] = val
+
+		val, err = v.PolicyAssignments.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_assignments"] = val
+
+		val, err = v.PolicyDefinitionIds.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_definition_ids"] = val
+
+		val, err = v.PolicyDefinitions.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_definitions"] = val
+
+		val, err = v.PolicyExemptionIds.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_exemption_ids"] = val
+
+		val, err = v.PolicyExemptions.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_exemptions"] = val
+
+		val, err = v.PolicySetDefinitionIds.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_set_definition_ids"] = val
+
+		val, err = v.PolicySetDefinitions.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_set_definitions"] = val
+
+		val, err = v.RoleDefinitionIds.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["role_definition_ids"] = val
+
+		val, err = v.RoleDefinitions.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["role_definitions"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v ManagementGroupsValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v ManagementGroupsValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v ManagementGroupsValue) String() string {
+	return "ManagementGroupsValue"
+}
+
+func (v ManagementGroupsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var policyAssignmentIdsVal basetypes.MapValue
+	switch {
+	case v.PolicyAssignmentIds.IsUnknown():
+		policyAssignmentIdsVal = types.MapUnknown(types.StringType)
+	case v.PolicyAssignmentIds.IsNull():
+		policyAssignmentIdsVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policyAssignmentIdsVal, d = types.MapValue(types.StringType, v.PolicyAssignmentIds.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"display_name": basetypes.StringType{},
+			"exists":       basetypes.BoolType{},
+			"id":           basetypes.StringType{},
+			"level":        basetypes.NumberType{},
+			"parent_id":    basetypes.StringType{},
+			"policy_assignment_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemption_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemptions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var policyAssignmentsVal basetypes.MapValue
+	switch {
+	case v.PolicyAssignments.IsUnknown():
+		policyAssignmentsVal = types.MapUnknown(types.StringType)
+	case v.PolicyAssignments.IsNull():
+		policyAssignmentsVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policyAssignmentsVal, d = types.MapValue(types.StringType, v.PolicyAssignments.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"display_name": basetypes.StringType{},
+			"exists":       basetypes.BoolType{},
+			"id":           basetypes.StringType{},
+			"level":        basetypes.NumberType{},
+			"parent_id":    basetypes.StringType{},
+			"policy_assignment_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemption_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemptions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var policyDefinitionIdsVal basetypes.MapValue
+	switch {
+	case v.PolicyDefinitionIds.IsUnknown():
+		policyDefinitionIdsVal = types.MapUnknown(types.StringType)
+	case v.PolicyDefinitionIds.IsNull():
+		policyDefinitionIdsVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policyDefinitionIdsVal, d = types.MapValue(types.StringType, v.PolicyDefinitionIds.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"display_name": basetypes.StringType{},
+			"exists":       basetypes.BoolType{},
+			"id":           basetypes.StringType{},
+			"level":        basetypes.NumberType{},
+			"parent_id":    basetypes.StringType{},
+			"policy_assignment_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemption_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemptions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var policyDefinitionsVal basetypes.MapValue
+	switch {
+	case v.PolicyDefinitions.IsUnknown():
+		policyDefinitionsVal = types.MapUnknown(types.StringType)
+	case v.PolicyDefinitions.IsNull():
+		policyDefinitionsVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policyDefinitionsVal, d = types.MapValue(types.StringType, v.PolicyDefinitions.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"display_name": basetypes.StringType{},
+			"exists":       basetypes.BoolType{},
+			"id":           basetypes.StringType{},
+			"level":        basetypes.NumberType{},
+			"parent_id":    basetypes.StringType{},
+			"policy_assignment_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemption_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemptions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var policyExemptionIdsVal basetypes.MapValue
+	switch {
+	case v.PolicyExemptionIds.IsUnknown():
+		policyExemptionIdsVal = types.MapUnknown(types.StringType)
+	case v.PolicyExemptionIds.IsNull():
+		policyExemptionIdsVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policyExemptionIdsVal, d = types.MapValue(types.StringType, v.PolicyExemptionIds.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"display_name": basetypes.StringType{},
+			"exists":       basetypes.BoolType{},
+			"id":           basetypes.StringType{},
+			"level":        basetypes.NumberType{},
+			"parent_id":    basetypes.StringType{},
+			"policy_assignment_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemption_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemptions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var policyExemptionsVal basetypes.MapValue
+	switch {
+	case v.PolicyExemptions.IsUnknown():
+		policyExemptionsVal = types.MapUnknown(types.StringType)
+	case v.PolicyExemptions.IsNull():
+		policyExemptionsVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policyExemptionsVal, d = types.MapValue(types.StringType, v.PolicyExemptions.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"display_name": basetypes.StringType{},
+			"exists":       basetypes.BoolType{},
+			"id":           basetypes.StringType{},
+			"level":        basetypes.NumberType{},
+			"parent_id":    basetypes.StringType{},
+			"policy_assignment_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemption_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemptions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var policySetDefinitionIdsVal basetypes.MapValue
+	switch {
+	case v.PolicySetDefinitionIds.IsUnknown():
+		policySetDefinitionIdsVal = types.MapUnknown(types.StringType)
+	case v.PolicySetDefinitionIds.IsNull():
+		policySetDefinitionIdsVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policySetDefinitionIdsVal, d = types.MapValue(types.StringType, v.PolicySetDefinitionIds.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"display_name": basetypes.StringType{},
+			"exists":       basetypes.BoolType{},
+			"id":           basetypes.StringType{},
+			"level":        basetypes.NumberType{},
+			"parent_id":    basetypes.StringType{},
+			"policy_assignment_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemption_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemptions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var policySetDefinitionsVal basetypes.MapValue
+	switch {
+	case v.PolicySetDefinitions.IsUnknown():
+		policySetDefinitionsVal = types.MapUnknown(types.StringType)
+	case v.PolicySetDefinitions.IsNull():
+		policySetDefinitionsVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policySetDefinitionsVal, d = types.MapValue(types.StringType, v.PolicySetDefinitions.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"display_name": basetypes.StringType{},
+			"exists":       basetypes.BoolType{},
+			"id":           basetypes.StringType{},
+			"level":        basetypes.NumberType{},
+			"parent_id":    basetypes.StringType{},
+			"policy_assignment_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemption_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemptions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var roleDefinitionIdsVal basetypes.MapValue
+	switch {
+	case v.RoleDefinitionIds.IsUnknown():
+		roleDefinitionIdsVal = types.MapUnknown(types.StringType)
+	case v.RoleDefinitionIds.IsNull():
+		roleDefinitionIdsVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		roleDefinitionIdsVal, d = types.MapValue(types.StringType, v.RoleDefinitionIds.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"display_name": basetypes.StringType{},
+			"exists":       basetypes.BoolType{},
+			"id":           basetypes.StringType{},
+			"level":        basetypes.NumberType{},
+			"parent_id":    basetypes.StringType{},
+			"policy_assignment_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemption_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemptions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var roleDefinitionsVal basetypes.MapValue
+	switch {
+	case v.RoleDefinitions.IsUnknown():
+		roleDefinitionsVal = types.MapUnknown(types.StringType)
+	case v.RoleDefinitions.IsNull():
+		roleDefinitionsVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		roleDefinitionsVal, d = types.MapValue(types.StringType, v.RoleDefinitions.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"display_name": basetypes.StringType{},
+			"exists":       basetypes.BoolType{},
+			"id":           basetypes.StringType{},
+			"level":        basetypes.NumberType{},
+			"parent_id":    basetypes.StringType{},
+			"policy_assignment_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_assignments": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemption_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_exemptions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"policy_set_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definition_ids": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"role_definitions": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"display_name": basetypes.StringType{},
+		"exists":       basetypes.BoolType{},
+		"id":           basetypes.StringType{},
+		"level":        basetypes.NumberType{},
+		"parent_id":    basetypes.StringType{},
+		"policy_assignment_ids": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"policy_assignments": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"policy_definition_ids": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"policy_definitions": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"policy_exemption_ids": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"policy_exemptions": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"policy_set_definition_ids": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"policy_set_definitions": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"role_definition_ids": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"role_definitions": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"display_name":              v.DisplayName,
+			"exists":                    v.Exists,
+			"id":                        v.Id,
+			"level":                     v.Level,
+			"parent_id":                 v.ParentId,
+			"policy_assignment_ids":     policyAssignmentIdsVal,
+			"policy_assignments":        policyAssignmentsVal,
+			"policy_definition_ids":     policyDefinitionIdsVal,
+			"policy_definitions":        policyDefinitionsVal,
+			"policy_exemption_ids":      policyExemptionIdsVal,
+			"policy_exemptions":         policyExemptionsVal,
+			"policy_set_definition_ids": policySetDefinitionIdsVal,
+			"policy_set_definitions":    policySetDefinitionsVal,
+			"role_definition_ids":       roleDefinitionIdsVal,
+			"role_definitions":          roleDefinitionsVal,
+		})
+
+	return objVal, diags
+}
+
+func (v ManagementGroupsValue) Equal(o attr.Value) bool {
+	other, ok := o.(ManagementGroupsValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.DisplayName.Equal(other.DisplayName) {
+		return false
+	}
+
+	if !v.Exists.Equal(other.Exists) {
+		return false
+	}
+
+	if !v.Id.Equal(other.Id) {
+		return false
+	}
+
+	if !v.Level.Equal(other.Level) {
+		return false
+	}
+
+	if !v.ParentId.Equal(other.ParentId) {
+		return false
+	}
+
+	if !v.PolicyAssignmentIds.Equal(other.PolicyAssignmentIds) {
+		return false
+	}
+
+	if !v.PolicyAssignments.Equal(other.PolicyAssignments) {
+		return false
+	}
+
+	if !v.PolicyDefinitionIds.Equal(other.PolicyDefinitionIds) {
+		return false
+	}
+
+	if !v.PolicyDefinitions.Equal(other.PolicyDefinitions) {
+		return false
+	}
+
+	if !v.PolicyExemptionIds.Equal(other.PolicyExemptionIds) {
+		return false
+	}
+
+	if !v.PolicyExemptions.Equal(other.PolicyExemptions) {
+		return false
+	}
+
+	if !v.PolicySetDefinitionIds.Equal(other.PolicySetDefinitionIds) {
+		return false
+	}
+
+	if !v.PolicySetDefinitions.Equal(other.PolicySetDefinitions) {
+		return false
+	}
+
+	if !v.RoleDefinitionIds.Equal(other.RoleDefinitionIds) {
+		return false
+	}
+
+	if !v.RoleDefinitions.Equal(other.RoleDefinitions) {
+		return false
+	}
+
+	return true
+}
+
+func (v ManagementGroupsValue) Type(ctx context.Context) attr.Type {
+	return ManagementGroupsType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v ManagementGroupsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"display_name": basetypes.StringType{},
+		"exists":       basetypes.BoolType{},
+		"id":           basetypes.StringType{},
+		"level":        basetypes.NumberType{},
+		"parent_id":    basetypes.StringType{},
+		"policy_assignment_ids": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"policy_assignments": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"policy_definition_ids": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"policy_definitions": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"policy_exemption_ids": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"policy_exemptions": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"policy_set_definition_ids": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"policy_set_definitions": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"role_definition_ids": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"role_definitions": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+	}
+}
+
+var _ basetypes.ObjectTypable = OverridePolicyDefinitionParameterAssignPermissionsSetType{}
+
+type OverridePolicyDefinitionParameterAssignPermissionsSetType struct {
+	basetypes.ObjectType
+}
+
+func (t OverridePolicyDefinitionParameterAssignPermissionsSetType) Equal(o attr.Type) bool {
+	other, ok := o.(OverridePolicyDefinitionParameterAssignPermissionsSetType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t OverridePolicyDefinitionParameterAssignPermissionsSetType) String() string {
+	return "OverridePolicyDefinitionParameterAssignPermissionsSetType"
+}
+
+func (t OverridePolicyDefinitionParameterAssignPermissionsSetType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	definitionNameAttribute, ok := attributes["definition_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`definition_name is missing from object`)
+
+		return nil, diags
+	}
+
+	definitionNameVal, ok := definitionNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`definition_name expected to be basetypes.StringValue, was: %T`, definitionNameAttribute))
+	}
+
+	parameterNameAttribute, ok := attributes["parameter_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`parameter_name is missing from object`)
+
+		return nil, diags
+	}
+
+	parameterNameVal, ok := parameterNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`parameter_name expected to be basetypes.StringValue, was: %T`, parameterNameAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return OverridePolicyDefinitionParameterAssignPermissionsSetValue{
+		DefinitionName: definitionNameVal,
+		ParameterName:  parameterNameVal,
+		state:          attr.ValueStateKnown,
+	}, diags
+}
+
+func NewOverridePolicyDefinitionParameterAssignPermissionsSetValueNull() OverridePolicyDefinitionParameterAssignPermissionsSetValue {
+	return OverridePolicyDefinitionParameterAssignPermissionsSetValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewOverridePolicyDefinitionParameterAssignPermissionsSetValueUnknown() OverridePolicyDefinitionParameterAssignPermissionsSetValue {
+	return OverridePolicyDefinitionParameterAssignPermissionsSetValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewOverridePolicyDefinitionParameterAssignPermissionsSetValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (OverridePolicyDefinitionParameterAssignPermissionsSetValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing OverridePolicyDefinitionParameterAssignPermissionsSetValue Attribute Value",
+				"While creating a OverridePolicyDefinitionParameterAssignPermissionsSetValue value, a missing attribute value was detected. "+
+					"A OverridePolicyDefinitionParameterAssignPermissionsSetValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("OverridePolicyDefinitionParameterAssignPermissionsSetValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid OverridePolicyDefinitionParameterAssignPermissionsSetValue Attribute Type",
+				"While creating a OverridePolicyDefinitionParameterAssignPermissionsSetValue value, an invalid attribute value was detected. "+
+					"A OverridePolicyDefinitionParameterAssignPermissionsSetValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("OverridePolicyDefinitionParameterAssignPermissionsSetValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("OverridePolicyDefinitionParameterAssignPermissionsSetValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra OverridePolicyDefinitionParameterAssignPermissionsSetValue Attribute Value",
+				"While creating a OverridePolicyDefinitionParameterAssignPermissionsSetValue value, an extra attribute value was detected. "+
+					"A OverridePolicyDefinitionParameterAssignPermissionsSetValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra OverridePolicyDefinitionParameterAssignPermissionsSetValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueUnknown(), diags
+	}
+
+	definitionNameAttribute, ok := attributes["definition_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`definition_name is missing from object`)
+
+		return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueUnknown(), diags
+	}
+
+	definitionNameVal, ok := definitionNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`definition_name expected to be basetypes.StringValue, was: %T`, definitionNameAttribute))
+	}
+
+	parameterNameAttribute, ok := attributes["parameter_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`parameter_name is missing from object`)
+
+		return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueUnknown(), diags
+	}
+
+	parameterNameVal, ok := parameterNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`parameter_name expected to be basetypes.StringValue, was: %T`, parameterNameAttribute))
+	}
+
+	if diags.HasError() {
+		return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueUnknown(), diags
+	}
+
+	return OverridePolicyDefinitionParameterAssignPermissionsSetValue{
+		DefinitionName: definitionNameVal,
+		ParameterName:  parameterNameVal,
+		state:          attr.ValueStateKnown,
+	}, diags
+}
+
+func NewOverridePolicyDefinitionParameterAssignPermissionsSetValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) OverridePolicyDefinitionParameterAssignPermissionsSetValue {
+	object, diags := NewOverridePolicyDefinitionParameterAssignPermissionsSetValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewOverridePolicyDefinitionParameterAssignPermissionsSetValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t OverridePolicyDefinitionParameterAssignPermissionsSetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueMust(OverridePolicyDefinitionParameterAssignPermissionsSetValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t OverridePolicyDefinitionParameterAssignPermissionsSetType) ValueType(ctx context.Context) attr.Value {
+	return OverridePolicyDefinitionParameterAssignPermissionsSetValue{}
+}
+
+var _ basetypes.ObjectValuable = OverridePolicyDefinitionParameterAssignPermissionsSetValue{}
+
+type OverridePolicyDefinitionParameterAssignPermissionsSetValue struct {
+	DefinitionName basetypes.StringValue `tfsdk:"definition_name"`
+	ParameterName  basetypes.StringValue `tfsdk:"parameter_name"`
+	state          attr.ValueState
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 2)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["definition_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["parameter_name"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 2)
+
+		val, err = v.DefinitionName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["definition_name"] = val
+
+		val, err = v.ParameterName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["parameter_name"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) String() string {
+	return "OverridePolicyDefinitionParameterAssignPermissionsSetValue"
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"definition_name": basetypes.StringType{},
+		"parameter_name":  basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"definition_name": v.DefinitionName,
+			"parameter_name":  v.ParameterName,
+		})
+
+	return objVal, diags
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) Equal(o attr.Value) bool {
+	other, ok := o.(OverridePolicyDefinitionParameterAssignPermissionsSetValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.DefinitionName.Equal(other.DefinitionName) {
+		return false
+	}
+
+	if !v.ParameterName.Equal(other.ParameterName) {
+		return false
+	}
+
+	return true
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) Type(ctx context.Context) attr.Type {
+	return OverridePolicyDefinitionParameterAssignPermissionsSetType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"definition_name": basetypes.StringType{},
+		"parameter_name":  basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = OverridePolicyDefinitionParameterAssignPermissionsUnsetType{}
+
+type OverridePolicyDefinitionParameterAssignPermissionsUnsetType struct {
+	basetypes.ObjectType
+}
+
+func (t OverridePolicyDefinitionParameterAssignPermissionsUnsetType) Equal(o attr.Type) bool {
+	other, ok := o.(OverridePolicyDefinitionParameterAssignPermissionsUnsetType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t OverridePolicyDefinitionParameterAssignPermissionsUnsetType) String() string {
+	return "OverridePolicyDefinitionParameterAssignPermissionsUnsetType"
+}
+
+func (t OverridePolicyDefinitionParameterAssignPermissionsUnsetType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	definitionNameAttribute, ok := attributes["definition_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`definition_name is missing from object`)
+
+		return nil, diags
+	}
+
+	definitionNameVal, ok := definitionNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`definition_name expected to be basetypes.StringValue, was: %T`, definitionNameAttribute))
+	}
+
+	parameterNameAttribute, ok := attributes["parameter_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`parameter_name is missing from object`)
+
+		return nil, diags
+	}
+
+	parameterNameVal, ok := parameterNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`parameter_name expected to be basetypes.StringValue, was: %T`, parameterNameAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return OverridePolicyDefinitionParameterAssignPermissionsUnsetValue{
+		DefinitionName: definitionNameVal,
+		ParameterName:  parameterNameVal,
+		state:          attr.ValueStateKnown,
+	}, diags
+}
+
+func NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueNull() OverridePolicyDefinitionParameterAssignPermissionsUnsetValue {
+	return OverridePolicyDefinitionParameterAssignPermissionsUnsetValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueUnknown() OverridePolicyDefinitionParameterAssignPermissionsUnsetValue {
+	return OverridePolicyDefinitionParameterAssignPermissionsUnsetValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (OverridePolicyDefinitionParameterAssignPermissionsUnsetValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing OverridePolicyDefinitionParameterAssignPermissionsUnsetValue Attribute Value",
+				"While creating a OverridePolicyDefinitionParameterAssignPermissionsUnsetValue value, a missing attribute value was detected. "+
+					"A OverridePolicyDefinitionParameterAssignPermissionsUnsetValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("OverridePolicyDefinitionParameterAssignPermissionsUnsetValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid OverridePolicyDefinitionParameterAssignPermissionsUnsetValue Attribute Type",
+				"While creating a OverridePolicyDefinitionParameterAssignPermissionsUnsetValue value, an invalid attribute value was detected. "+
+					"A OverridePolicyDefinitionParameterAssignPermissionsUnsetValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("OverridePolicyDefinitionParameterAssignPermissionsUnsetValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("OverridePolicyDefinitionParameterAssignPermissionsUnsetValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra OverridePolicyDefinitionParameterAssignPermissionsUnsetValue Attribute Value",
+				"While creating a OverridePolicyDefinitionParameterAssignPermissionsUnsetValue value, an extra attribute value was detected. "+
+					"A OverridePolicyDefinitionParameterAssignPermissionsUnsetValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra OverridePolicyDefinitionParameterAssignPermissionsUnsetValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueUnknown(), diags
+	}
+
+	definitionNameAttribute, ok := attributes["definition_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`definition_name is missing from object`)
+
+		return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueUnknown(), diags
+	}
+
+	definitionNameVal, ok := definitionNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`definition_name expected to be basetypes.StringValue, was: %T`, definitionNameAttribute))
+	}
+
+	parameterNameAttribute, ok := attributes["parameter_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`parameter_name is missing from object`)
+
+		return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueUnknown(), diags
+	}
+
+	parameterNameVal, ok := parameterNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`parameter_name expected to be basetypes.StringValue, was: %T`, parameterNameAttribute))
+	}
+
+	if diags.HasError() {
+		return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueUnknown(), diags
+	}
+
+	return OverridePolicyDefinitionParameterAssignPermissionsUnsetValue{
+		DefinitionName: definitionNameVal,
+		ParameterName:  parameterNameVal,
+		state:          attr.ValueStateKnown,
+	}, diags
+}
+
+func NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) OverridePolicyDefinitionParameterAssignPermissionsUnsetValue {
+	object, diags := NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t OverridePolicyDefinitionParameterAssignPermissionsUnsetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueMust(OverridePolicyDefinitionParameterAssignPermissionsUnsetValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t OverridePolicyDefinitionParameterAssignPermissionsUnsetType) ValueType(ctx context.Context) attr.Value {
+	return OverridePolicyDefinitionParameterAssignPermissionsUnsetValue{}
+}
+
+var _ basetypes.ObjectValuable = OverridePolicyDefinitionParameterAssignPermissionsUnsetValue{}
+
+type OverridePolicyDefinitionParameterAssignPermissionsUnsetValue struct {
+	DefinitionName basetypes.StringValue `tfsdk:"definition_name"`
+	ParameterName  basetypes.StringValue `tfsdk:"parameter_name"`
+	state          attr.ValueState
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 2)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["definition_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["parameter_name"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 2)
+
+		val, err = v.DefinitionName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["definition_name"] = val
+
+		val, err = v.ParameterName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["parameter_name"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) String() string {
+	return "OverridePolicyDefinitionParameterAssignPermissionsUnsetValue"
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"definition_name": basetypes.StringType{},
+		"parameter_name":  basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"definition_name": v.DefinitionName,
+			"parameter_name":  v.ParameterName,
+		})
+
+	return objVal, diags
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) Equal(o attr.Value) bool {
+	other, ok := o.(OverridePolicyDefinitionParameterAssignPermissionsUnsetValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.DefinitionName.Equal(other.DefinitionName) {
+		return false
+	}
+
+	if !v.ParameterName.Equal(other.ParameterName) {
+		return false
+	}
+
+	return true
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) Type(ctx context.Context) attr.Type {
+	return OverridePolicyDefinitionParameterAssignPermissionsUnsetType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"definition_name": basetypes.StringType{},
+		"parameter_name":  basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = PolicyAssignmentEffectsType{}
+
+type PolicyAssignmentEffectsType struct {
+	basetypes.ObjectType
+}
+
+func (t PolicyAssignmentEffectsType) Equal(o attr.Type) bool {
+	other, ok := o.(PolicyAssignmentEffectsType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t PolicyAssignmentEffectsType) String() string {
+	return "PolicyAssignmentEffectsType"
+}
+
+func (t PolicyAssignmentEffectsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	effectAttribute, ok := attributes["effect"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`effect is missing from object`)
+
+		return nil, diags
+	}
+
+	effectVal, ok := effectAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`effect expected to be basetypes.StringValue, was: %T`, effectAttribute))
+	}
+
+	effectSourceAttribute, ok := attributes["effect_source"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`effect_source is missing from object`)
+
+		return nil, diags
+	}
+
+	effectSourceVal, ok := effectSourceAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`effect_source expected to be basetypes.StringValue, was: %T`, effectSourceAttribute))
+	}
+
+	enforcementModeAttribute, ok := attributes["enforcement_mode"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`enforcement_mode is missing from object`)
+
+		return nil, diags
+	}
+
+	enforcementModeVal, ok := enforcementModeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`enforcement_mode expected to be basetypes.StringValue, was: %T`, enforcementModeAttribute))
+	}
+
+	managementGroupIdAttribute, ok := attributes["management_group_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`management_group_id is missing from object`)
+
+		return nil, diags
+	}
+
+	managementGroupIdVal, ok := managementGroupIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`management_group_id expected to be basetypes.StringValue, was: %T`, managementGroupIdAttribute))
+	}
+
+	policyAssignmentIdAttribute, ok := attributes["policy_assignment_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignment_id is missing from object`)
+
+		return nil, diags
+	}
+
+	policyAssignmentIdVal, ok := policyAssignmentIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignment_id expected to be basetypes.StringValue, was: %T`, policyAssignmentIdAttribute))
+	}
+
+	policyAssignmentNameAttribute, ok := attributes["policy_assignment_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignment_name is missing from object`)
+
+		return nil, diags
+	}
+
+	policyAssignmentNameVal, ok := policyAssignmentNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignment_name expected to be basetypes.StringValue, was: %T`, policyAssignmentNameAttribute))
+	}
+
+	policyDefinitionIdAttribute, ok := attributes["policy_definition_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definition_id is missing from object`)
+
+		return nil, diags
+	}
+
+	policyDefinitionIdVal, ok := policyDefinitionIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definition_id expected to be basetypes.StringValue, was: %T`, policyDefinitionIdAttribute))
+	}
+
+	policyDefinitionReferenceIdAttribute, ok := attributes["policy_definition_reference_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definition_reference_id is missing from object`)
+
+		return nil, diags
+	}
+
+	policyDefinitionReferenceIdVal, ok := policyDefinitionReferenceIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definition_reference_id expected to be basetypes.StringValue, was: %T`, policyDefinitionReferenceIdAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return PolicyAssignmentEffectsValue{
+		Effect:                      effectVal,
+		EffectSource:                effectSourceVal,
+		EnforcementMode:             enforcementModeVal,
+		ManagementGroupId:           managementGroupIdVal,
+		PolicyAssignmentId:          policyAssignmentIdVal,
+		PolicyAssignmentName:        policyAssignmentNameVal,
+		PolicyDefinitionId:          policyDefinitionIdVal,
+		PolicyDefinitionReferenceId: policyDefinitionReferenceIdVal,
+		state:                       attr.ValueStateKnown,
+	}, diags
+}
+
+func NewPolicyAssignmentEffectsValueNull() PolicyAssignmentEffectsValue {
+	return PolicyAssignmentEffectsValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewPolicyAssignmentEffectsValueUnknown() PolicyAssignmentEffectsValue {
+	return PolicyAssignmentEffectsValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewPolicyAssignmentEffectsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (PolicyAssignmentEffectsValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing PolicyAssignmentEffectsValue Attribute Value",
+				"While creating a PolicyAssignmentEffectsValue value, a missing attribute value was detected. "+
+					"A PolicyAssignmentEffectsValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("PolicyAssignmentEffectsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid PolicyAssignmentEffectsValue Attribute Type",
+				"While creating a PolicyAssignmentEffectsValue value, an invalid attribute value was detected. "+
+					"A PolicyAssignmentEffectsValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("PolicyAssignmentEffectsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("PolicyAssignmentEffectsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra PolicyAssignmentEffectsValue Attribute Value",
+				"While creating a PolicyAssignmentEffectsValue value, an extra attribute value was detected. "+
+					"A PolicyAssignmentEffectsValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra PolicyAssignmentEffectsValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewPolicyAssignmentEffectsValueUnknown(), diags
+	}
+
+	effectAttribute, ok := attributes["effect"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`effect is missing from object`)
+
+		return NewPolicyAssignmentEffectsValueUnknown(), diags
+	}
+
+	effectVal, ok := effectAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`effect expected to be basetypes.StringValue, was: %T`, effectAttribute))
+	}
+
+	effectSourceAttribute, ok := attributes["effect_source"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`effect_source is missing from object`)
+
+		return NewPolicyAssignmentEffectsValueUnknown(), diags
+	}
+
+	effectSourceVal, ok := effectSourceAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`effect_source expected to be basetypes.StringValue, was: %T`, effectSourceAttribute))
+	}
+
+	enforcementModeAttribute, ok := attributes["enforcement_mode"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`enforcement_mode is missing from object`)
+
+		return NewPolicyAssignmentEffectsValueUnknown(), diags
+	}
+
+	enforcementModeVal, ok := enforcementModeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`enforcement_mode expected to be basetypes.StringValue, was: %T`, enforcementModeAttribute))
+	}
+
+	managementGroupIdAttribute, ok := attributes["management_group_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`management_group_id is missing from object`)
+
+		return NewPolicyAssignmentEffectsValueUnknown(), diags
+	}
+
+	managementGroupIdVal, ok := managementGroupIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`management_group_id expected to be basetypes.StringValue, was: %T`, managementGroupIdAttribute))
+	}
+
+	policyAssignmentIdAttribute, ok := attributes["policy_assignment_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignment_id is missing from object`)
+
+		return NewPolicyAssignmentEffectsValueUnknown(), diags
+	}
+
+	policyAssignmentIdVal, ok := policyAssignmentIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignment_id expected to be basetypes.StringValue, was: %T`, policyAssignmentIdAttribute))
+	}
+
+	policyAssignmentNameAttribute, ok := attributes["policy_assignment_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignment_name is missing from object`)
+
+		return NewPolicyAssignmentEffectsValueUnknown(), diags
+	}
+
+	policyAssignmentNameVal, ok := policyAssignmentNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignment_name expected to be basetypes.StringValue, was: %T`, policyAssignmentNameAttribute))
+	}
+
+	policyDefinitionIdAttribute, ok := attributes["policy_definition_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definition_id is missing from object`)
+
+		return NewPolicyAssignmentEffectsValueUnknown(), diags
+	}
+
+	policyDefinitionIdVal, ok := policyDefinitionIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definition_id expected to be basetypes.StringValue, was: %T`, policyDefinitionIdAttribute))
+	}
+
+	policyDefinitionReferenceIdAttribute, ok := attributes["policy_definition_reference_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definition_reference_id is missing from object`)
+
+		return NewPolicyAssignmentEffectsValueUnknown(), diags
+	}
+
+	policyDefinitionReferenceIdVal, ok := policyDefinitionReferenceIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definition_reference_id expected to be basetypes.StringValue, was: %T`, policyDefinitionReferenceIdAttribute))
+	}
+
+	if diags.HasError() {
+		return NewPolicyAssignmentEffectsValueUnknown(), diags
+	}
+
+	return PolicyAssignmentEffectsValue{
+		Effect:                      effectVal,
+		EffectSource:                effectSourceVal,
+		EnforcementMode:             enforcementModeVal,
+		ManagementGroupId:           managementGroupIdVal,
+		PolicyAssignmentId:          policyAssignmentIdVal,
+		PolicyAssignmentName:        policyAssignmentNameVal,
+		PolicyDefinitionId:          policyDefinitionIdVal,
+		PolicyDefinitionReferenceId: policyDefinitionReferenceIdVal,
+		state:                       attr.ValueStateKnown,
+	}, diags
+}
+
+func NewPolicyAssignmentEffectsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) PolicyAssignmentEffectsValue {
+	object, diags := NewPolicyAssignmentEffectsValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewPolicyAssignmentEffectsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t PolicyAssignmentEffectsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewPolicyAssignmentEffectsValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewPolicyAssignmentEffectsValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewPolicyAssignmentEffectsValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewPolicyAssignmentEffectsValueMust(PolicyAssignmentEffectsValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t PolicyAssignmentEffectsType) ValueType(ctx context.Context) attr.Value {
+	return PolicyAssignmentEffectsValue{}
+}
+
+var _ basetypes.ObjectValuable = PolicyAssignmentEffectsValue{}
+
+type PolicyAssignmentEffectsValue struct {
+	Effect                      basetypes.StringValue `tfsdk:"effect"`
+	EffectSource                basetypes.StringValue `tfsdk:"effect_source"`
+	EnforcementMode             basetypes.StringValue `tfsdk:"enforcement_mode"`
+	ManagementGroupId           basetypes.StringValue `tfsdk:"management_group_id"`
+	PolicyAssignmentId          basetypes.StringValue `tfsdk:"policy_assignment_id"`
+	PolicyAssignmentName        basetypes.StringValue `tfsdk:"policy_assignment_name"`
+	PolicyDefinitionId          basetypes.StringValue `tfsdk:"policy_definition_id"`
+	PolicyDefinitionReferenceId basetypes.StringValue `tfsdk:"policy_definition_reference_id"`
+	state                       attr.ValueState
+}
+
+func (v PolicyAssignmentEffectsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 8)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["effect"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["effect_source"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["enforcement_mode"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["management_group_id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["policy_assignment_id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["policy_assignment_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["policy_definition_id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["policy_definition_reference_id"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 8)
+
+		val, err = v.Effect.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["effect"] = val
+
+		val, err = v.EffectSource.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["effect_source"] = val
+
+		val, err = v.EnforcementMode.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["enforcement_mode"] = val
+
+		val, err = v.ManagementGroupId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["management_group_id"] = val
+
+		val, err = v.PolicyAssignmentId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_assignment_id"] = val
+
+		val, err = v.PolicyAssignmentName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_assignment_name"] = val
+
+		val, err = v.PolicyDefinitionId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_definition_id"] = val
+
+		val, err = v.PolicyDefinitionReferenceId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_definition_reference_id"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v PolicyAssignmentEffectsValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v PolicyAssignmentEffectsValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v PolicyAssignmentEffectsValue) String() string {
+	return "PolicyAssignmentEffectsValue"
+}
+
+func (v PolicyAssignmentEffectsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"effect":                         basetypes.StringType{},
+		"effect_source":                  basetypes.StringType{},
+		"enforcement_mode":               basetypes.StringType{},
+		"management_group_id":            basetypes.StringType{},
+		"policy_assignment_id":           basetypes.StringType{},
+		"policy_assignment_name":         basetypes.StringType{},
+		"policy_definition_id":           basetypes.StringType{},
+		"policy_definition_reference_id": basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"effect":                         v.Effect,
+			"effect_source":                  v.EffectSource,
+			"enforcement_mode":               v.EnforcementMode,
+			"management_group_id":            v.ManagementGroupId,
+			"policy_assignment_id":           v.PolicyAssignmentId,
+			"policy_assignment_name":         v.PolicyAssignmentName,
+			"policy_definition_id":           v.PolicyDefinitionId,
+			"policy_definition_reference_id": v.PolicyDefinitionReferenceId,
+		})
+
+	return objVal, diags
+}
+
+func (v PolicyAssignmentEffectsValue) Equal(o attr.Value) bool {
+	other, ok := o.(PolicyAssignmentEffectsValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Effect.Equal(other.Effect) {
+		return false
+	}
+
+	if !v.EffectSource.Equal(other.EffectSource) {
+		return false
+	}
+
+	if !v.EnforcementMode.Equal(other.EnforcementMode) {
+		return false
+	}
+
+	if !v.ManagementGroupId.Equal(other.ManagementGroupId) {
+		return false
+	}
+
+	if !v.PolicyAssignmentId.Equal(other.PolicyAssignmentId) {
+		return false
+	}
+
+	if !v.PolicyAssignmentName.Equal(other.PolicyAssignmentName) {
+		return false
+	}
+
+	if !v.PolicyDefinitionId.Equal(other.PolicyDefinitionId) {
+		return false
+	}
+
+	if !v.PolicyDefinitionReferenceId.Equal(other.PolicyDefinitionReferenceId) {
+		return false
+	}
+
+	return true
+}
+
+func (v PolicyAssignmentEffectsValue) Type(ctx context.Context) attr.Type {
+	return PolicyAssignmentEffectsType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v PolicyAssignmentEffectsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"effect":                         basetypes.StringType{},
+		"effect_source":                  basetypes.StringType{},
+		"enforcement_mode":               basetypes.StringType{},
+		"management_group_id":            basetypes.StringType{},
+		"policy_assignment_id":           basetypes.StringType{},
+		"policy_assignment_name":         basetypes.StringType{},
+		"policy_definition_id":           basetypes.StringType{},
+		"policy_definition_reference_id": basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = PolicyAssignmentsToModifyType{}
+
+type PolicyAssignmentsToModifyType struct {
+	basetypes.ObjectType
+}
+
+func (t PolicyAssignmentsToModifyType) Equal(o attr.Type) bool {
+	other, ok := o.(PolicyAssignmentsToModifyType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t PolicyAssignmentsToModifyType) String() string {
+	return "PolicyAssignmentsToModifyType"
+}
+
+func (t PolicyAssignmentsToModifyType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	policyAssignmentsAttribute, ok := attributes["policy_assignments"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignments is missing from object`)
+
+		return nil, diags
+	}
+
+	policyAssignmentsVal, ok := policyAssignmentsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignments expected to be basetypes.MapValue, was: %T`, policyAssignmentsAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return PolicyAssignmentsToModifyValue{
+		PolicyAssignments: policyAssignmentsVal,
+		state:             attr.ValueStateKnown,
+	}, diags
+}
+
+func NewPolicyAssignmentsToModifyValueNull() PolicyAssignmentsToModifyValue {
+	return PolicyAssignmentsToModifyValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewPolicyAssignmentsToModifyValueUnknown() PolicyAssignmentsToModifyValue {
+	return PolicyAssignmentsToModifyValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewPolicyAssignmentsToModifyValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (PolicyAssignmentsToModifyValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing PolicyAssignmentsToModifyValue Attribute Value",
+				"While creating a PolicyAssignmentsToModifyValue value, a missing attribute value was detected. "+
+					"A PolicyAssignmentsToModifyValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("PolicyAssignmentsToModifyValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid PolicyAssignmentsToModifyValue Attribute Type",
+				"While creating a PolicyAssignmentsToModifyValue value, an invalid attribute value was detected. "+
+					"A PolicyAssignmentsToModifyValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("PolicyAssignmentsToModifyValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("PolicyAssignmentsToModifyValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra PolicyAssignmentsToModifyValue Attribute Value",
+				"While creating a PolicyAssignmentsToModifyValue value, an extra attribute value was detected. "+
+					"A PolicyAssignmentsToModifyValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra PolicyAssignmentsToModifyValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewPolicyAssignmentsToModifyValueUnknown(), diags
+	}
+
+	policyAssignmentsAttribute, ok := attributes["policy_assignments"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignments is missing from object`)
+
+		return NewPolicyAssignmentsToModifyValueUnknown(), diags
+	}
+
+	policyAssignmentsVal, ok := policyAssignmentsAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignments expected to be basetypes.MapValue, was: %T`, policyAssignmentsAttribute))
+	}
+
+	if diags.HasError() {
+		return NewPolicyAssignmentsToModifyValueUnknown(), diags
+	}
+
+	return PolicyAssignmentsToModifyValue{
+		PolicyAssignments: policyAssignmentsVal,
+		state:             attr.ValueStateKnown,
+	}, diags
+}
+
+func NewPolicyAssignmentsToModifyValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) PolicyAssignmentsToModifyValue {
+	object, diags := NewPolicyAssignmentsToModifyValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewPolicyAssignmentsToModifyValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t PolicyAssignmentsToModifyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewPolicyAssignmentsToModifyValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewPolicyAssignmentsToModifyValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewPolicyAssignmentsToModifyValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewPolicyAssignmentsToModifyValueMust(PolicyAssignmentsToModifyValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t PolicyAssignmentsToModifyType) ValueType(ctx context.Context) attr.Value {
+	return PolicyAssignmentsToModifyValue{}
+}
+
+var _ basetypes.ObjectValuable = PolicyAssignmentsToModifyValue{}
+
+type PolicyAssignmentsToModifyValue struct {
+	PolicyAssignments basetypes.MapValue `tfsdk:"policy_assignments"`
+	state             attr.ValueState
+}
+
+func (v PolicyAssignmentsToModifyValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 1)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["policy_assignments"] = basetypes.MapType{
+		ElemType: PolicyAssignmentsValue{}.Type(ctx),
+	}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 1)
+
+		val, err = v.PolicyAssignments.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_assignments"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v PolicyAssignmentsToModifyValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v PolicyAssignmentsToModifyValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v PolicyAssignmentsToModifyValue) String() string {
+	return "PolicyAssignmentsToModifyValue"
+}
+
+func (v PolicyAssignmentsToModifyValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policyAssignments := types.MapValueMust(
+		PolicyAssignmentsType{
+			basetypes.ObjectType{
+				AttrTypes: PolicyAssignmentsValue{}.AttributeTypes(ctx),
+			},
+		},
+		v.PolicyAssignments.Elements(),
+	)
+
+	if v.PolicyAssignments.IsNull() {
+		policyAssignments = types.MapNull(
+			PolicyAssignmentsType{
+				basetypes.ObjectType{
+					AttrTypes: PolicyAssignmentsValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	if v.PolicyAssignments.IsUnknown() {
+		policyAssignments = types.MapUnknown(
+			PolicyAssignmentsType{
+				basetypes.ObjectType{
+					AttrTypes: PolicyAssignmentsValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"policy_assignments": basetypes.MapType{
+			ElemType: PolicyAssignmentsValue{}.Type(ctx),
+		},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"policy_assignments": policyAssignments,
+		})
+
+	return objVal, diags
+}
+
+func (v PolicyAssignmentsToModifyValue) Equal(o attr.Value) bool {
+	other, ok := o.(PolicyAssignmentsToModifyValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.PolicyAssignments.Equal(other.PolicyAssignments) {
+		return false
+	}
+
+	return true
+}
+
+func (v PolicyAssignmentsToModifyValue) Type(ctx context.Context) attr.Type {
+	return PolicyAssignmentsToModifyType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v PolicyAssignmentsToModifyValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"policy_assignments": basetypes.MapType{
+			ElemType: PolicyAssignmentsValue{}.Type(ctx),
+		},
+	}
+}
+
+var _ basetypes.ObjectTypable = PolicyAssignmentsType{}
+
+type PolicyAssignmentsType struct {
+	basetypes.ObjectType
+}
+
+func (t PolicyAssignmentsType) Equal(o attr.Type) bool {
+	other, ok := o.(PolicyAssignmentsType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t PolicyAssignmentsType) String() string {
+	return "PolicyAssignmentsType"
+}
+
+func (t PolicyAssignmentsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	enforcementModeAttribute, ok := attributes["enforcement_mode"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`enforcement_mode is missing from object`)
+
+		return nil, diags
+	}
+
+	enforcementModeVal, ok := enforcementModeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`enforcement_mode expected to be basetypes.StringValue, was: %T`, enforcementModeAttribute))
+	}
+
+	identityAttribute, ok := attributes["identity"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`identity is missing from object`)
+
+		return nil, diags
+	}
+
+	identityVal, ok := identityAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`identity expected to be basetypes.StringValue, was: %T`, identityAttribute))
+	}
+
+	identityIdsAttribute, ok := attributes["identity_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`identity_ids is missing from object`)
+
+		return nil, diags
+	}
+
+	identityIdsVal, ok := identityIdsAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`identity_ids expected to be basetypes.SetValue, was: %T`, identityIdsAttribute))
 	}
 
-	if !v.RoleDefinitions.Equal(other.RoleDefinitions) {
-		return false
-	}
+	nonComplianceMessagesAttribute, ok := attributes["non_compliance_messages"]
 
-	return true
-}
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`non_compliance_messages is missing from object`)
 
-func (v ManagementGroupsValue) Type(ctx context.Context) attr.Type {
-	return ManagementGroupsType{
-		basetypes.ObjectType{
-			AttrTypes: v.AttributeTypes(ctx),
-		},
+		return nil, diags
 	}
-}
 
-func (v ManagementGroupsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
-	return map[string]attr.Type{
-		"display_name": basetypes.StringType{},
-		"exists":       basetypes.BoolType{},
-		"id":           basetypes.StringType{},
-		"level":        basetypes.NumberType{},
-		"parent_id":    basetypes.StringType{},
-		"policy_assignments": basetypes.MapType{
-			ElemType: types.StringType,
-		},
-		"policy_definitions": basetypes.MapType{
-			ElemType: types.StringType,
-		},
-		"policy_set_definitions": basetypes.MapType{
-			ElemType: types.StringType,
-		},
-		"role_definitions": basetypes.MapType{
-			ElemType: types.StringType,
-		},
+	nonComplianceMessagesVal, ok := nonComplianceMessagesAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`non_compliance_messages expected to be basetypes.SetValue, was: %T`, nonComplianceMessagesAttribute))
 	}
-}
 
-var _ basetypes.ObjectTypable = OverridePolicyDefinitionParameterAssignPermissionsSetType{}
+	notScopesAttribute, ok := attributes["not_scopes"]
 
-type OverridePolicyDefinitionParameterAssignPermissionsSetType struct {
-	basetypes.ObjectType
-}
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`not_scopes is missing from object`)
 
-func (t OverridePolicyDefinitionParameterAssignPermissionsSetType) Equal(o attr.Type) bool {
-	other, ok := o.(OverridePolicyDefinitionParameterAssignPermissionsSetType)
+		return nil, diags
+	}
+
+	notScopesVal, ok := notScopesAttribute.(basetypes.ListValue)
 
 	if !ok {
-		return false
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`not_scopes expected to be basetypes.ListValue, was: %T`, notScopesAttribute))
 	}
 
-	return t.ObjectType.Equal(other.ObjectType)
-}
+	overridesAttribute, ok := attributes["overrides"]
 
-func (t OverridePolicyDefinitionParameterAssignPermissionsSetType) String() string {
-	return "OverridePolicyDefinitionParameterAssignPermissionsSetType"
-}
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`overrides is missing from object`)
 
-func (t OverridePolicyDefinitionParameterAssignPermissionsSetType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
-	var diags diag.Diagnostics
+		return nil, diags
+	}
 
-	attributes := in.Attributes()
+	overridesVal, ok := overridesAttribute.(basetypes.ListValue)
 
-	definitionNameAttribute, ok := attributes["definition_name"]
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`overrides expected to be basetypes.ListValue, was: %T`, overridesAttribute))
+	}
+
+	parametersAttribute, ok := attributes["parameters"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`definition_name is missing from object`)
+			`parameters is missing from object`)
 
 		return nil, diags
 	}
 
-	definitionNameVal, ok := definitionNameAttribute.(basetypes.StringValue)
+	parametersVal, ok := parametersAttribute.(basetypes.MapValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`definition_name expected to be basetypes.StringValue, was: %T`, definitionNameAttribute))
+			fmt.Sprintf(`parameters expected to be basetypes.MapValue, was: %T`, parametersAttribute))
 	}
 
-	parameterNameAttribute, ok := attributes["parameter_name"]
+	resourceSelectorsAttribute, ok := attributes["resource_selectors"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`parameter_name is missing from object`)
+			`resource_selectors is missing from object`)
 
 		return nil, diags
 	}
 
-	parameterNameVal, ok := parameterNameAttribute.(basetypes.StringValue)
+	resourceSelectorsVal, ok := resourceSelectorsAttribute.(basetypes.ListValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`parameter_name expected to be basetypes.StringValue, was: %T`, parameterNameAttribute))
+			fmt.Sprintf(`resource_selectors expected to be basetypes.ListValue, was: %T`, resourceSelectorsAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return OverridePolicyDefinitionParameterAssignPermissionsSetValue{
-		DefinitionName: definitionNameVal,
-		ParameterName:  parameterNameVal,
-		state:          attr.ValueStateKnown,
+	return PolicyAssignmentsValue{
+		EnforcementMode:       enforcementModeVal,
+		Identity:              identityVal,
+		IdentityIds:           identityIdsVal,
+		NonComplianceMessages: nonComplianceMessagesVal,
+		NotScopes:             notScopesVal,
+		Overrides:             overridesVal,
+		Parameters:            parametersVal,
+		ResourceSelectors:     resourceSelectorsVal,
+		state:                 attr.ValueStateKnown,
 	}, diags
 }
 
-func NewOverridePolicyDefinitionParameterAssignPermissionsSetValueNull() OverridePolicyDefinitionParameterAssignPermissionsSetValue {
-	return OverridePolicyDefinitionParameterAssignPermissionsSetValue{
+func NewPolicyAssignmentsValueNull() PolicyAssignmentsValue {
+	return PolicyAssignmentsValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewOverridePolicyDefinitionParameterAssignPermissionsSetValueUnknown() OverridePolicyDefinitionParameterAssignPermissionsSetValue {
-	return OverridePolicyDefinitionParameterAssignPermissionsSetValue{
+func NewPolicyAssignmentsValueUnknown() PolicyAssignmentsValue {
+	return PolicyAssignmentsValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewOverridePolicyDefinitionParameterAssignPermissionsSetValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (OverridePolicyDefinitionParameterAssignPermissionsSetValue, diag.Diagnostics) {
+func NewPolicyAssignmentsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (PolicyAssignmentsValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -1917,11 +6953,11 @@ func NewOverridePolicyDefinitionParameterAssignPermissionsSetValue(attributeType
 
 		if !ok {
 			diags.AddError(
-				"Missing OverridePolicyDefinitionParameterAssignPermissionsSetValue Attribute Value",
-				"While creating a OverridePolicyDefinitionParameterAssignPermissionsSetValue value, a missing attribute value was detected. "+
-					"A OverridePolicyDefinitionParameterAssignPermissionsSetValue must contain values for all attributes, even if null or unknown. "+
+				"Missing PolicyAssignmentsValue Attribute Value",
+				"While creating a PolicyAssignmentsValue value, a missing attribute value was detected. "+
+					"A PolicyAssignmentsValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("OverridePolicyDefinitionParameterAssignPermissionsSetValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("PolicyAssignmentsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -1929,12 +6965,12 @@ func NewOverridePolicyDefinitionParameterAssignPermissionsSetValue(attributeType
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid OverridePolicyDefinitionParameterAssignPermissionsSetValue Attribute Type",
-				"While creating a OverridePolicyDefinitionParameterAssignPermissionsSetValue value, an invalid attribute value was detected. "+
-					"A OverridePolicyDefinitionParameterAssignPermissionsSetValue must use a matching attribute type for the value. "+
+				"Invalid PolicyAssignmentsValue Attribute Type",
+				"While creating a PolicyAssignmentsValue value, an invalid attribute value was detected. "+
+					"A PolicyAssignmentsValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("OverridePolicyDefinitionParameterAssignPermissionsSetValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("OverridePolicyDefinitionParameterAssignPermissionsSetValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("PolicyAssignmentsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("PolicyAssignmentsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -1944,68 +6980,182 @@ func NewOverridePolicyDefinitionParameterAssignPermissionsSetValue(attributeType
 
 		if !ok {
 			diags.AddError(
-				"Extra OverridePolicyDefinitionParameterAssignPermissionsSetValue Attribute Value",
-				"While creating a OverridePolicyDefinitionParameterAssignPermissionsSetValue value, an extra attribute value was detected. "+
-					"A OverridePolicyDefinitionParameterAssignPermissionsSetValue must not contain values beyond the expected attribute types. "+
+				"Extra PolicyAssignmentsValue Attribute Value",
+				"While creating a PolicyAssignmentsValue value, an extra attribute value was detected. "+
+					"A PolicyAssignmentsValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra OverridePolicyDefinitionParameterAssignPermissionsSetValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra PolicyAssignmentsValue Attribute Name: %s", name),
 			)
 		}
 	}
 
-	if diags.HasError() {
-		return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueUnknown(), diags
+	if diags.HasError() {
+		return NewPolicyAssignmentsValueUnknown(), diags
+	}
+
+	enforcementModeAttribute, ok := attributes["enforcement_mode"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`enforcement_mode is missing from object`)
+
+		return NewPolicyAssignmentsValueUnknown(), diags
+	}
+
+	enforcementModeVal, ok := enforcementModeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`enforcement_mode expected to be basetypes.StringValue, was: %T`, enforcementModeAttribute))
+	}
+
+	identityAttribute, ok := attributes["identity"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`identity is missing from object`)
+
+		return NewPolicyAssignmentsValueUnknown(), diags
+	}
+
+	identityVal, ok := identityAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`identity expected to be basetypes.StringValue, was: %T`, identityAttribute))
+	}
+
+	identityIdsAttribute, ok := attributes["identity_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`identity_ids is missing from object`)
+
+		return NewPolicyAssignmentsValueUnknown(), diags
+	}
+
+	identityIdsVal, ok := identityIdsAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`identity_ids expected to be basetypes.SetValue, was: %T`, identityIdsAttribute))
+	}
+
+	nonComplianceMessagesAttribute, ok := attributes["non_compliance_messages"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`non_compliance_messages is missing from object`)
+
+		return NewPolicyAssignmentsValueUnknown(), diags
+	}
+
+	nonComplianceMessagesVal, ok := nonComplianceMessagesAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`non_compliance_messages expected to be basetypes.SetValue, was: %T`, nonComplianceMessagesAttribute))
+	}
+
+	notScopesAttribute, ok := attributes["not_scopes"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`not_scopes is missing from object`)
+
+		return NewPolicyAssignmentsValueUnknown(), diags
+	}
+
+	notScopesVal, ok := notScopesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`not_scopes expected to be basetypes.ListValue, was: %T`, notScopesAttribute))
+	}
+
+	overridesAttribute, ok := attributes["overrides"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`overrides is missing from object`)
+
+		return NewPolicyAssignmentsValueUnknown(), diags
+	}
+
+	overridesVal, ok := overridesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`overrides expected to be basetypes.ListValue, was: %T`, overridesAttribute))
 	}
 
-	definitionNameAttribute, ok := attributes["definition_name"]
+	parametersAttribute, ok := attributes["parameters"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`definition_name is missing from object`)
+			`parameters is missing from object`)
 
-		return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueUnknown(), diags
+		return NewPolicyAssignmentsValueUnknown(), diags
 	}
 
-	definitionNameVal, ok := definitionNameAttribute.(basetypes.StringValue)
+	parametersVal, ok := parametersAttribute.(basetypes.MapValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`definition_name expected to be basetypes.StringValue, was: %T`, definitionNameAttribute))
+			fmt.Sprintf(`parameters expected to be basetypes.MapValue, was: %T`, parametersAttribute))
 	}
 
-	parameterNameAttribute, ok := attributes["parameter_name"]
+	resourceSelectorsAttribute, ok := attributes["resource_selectors"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`parameter_name is missing from object`)
+			`resource_selectors is missing from object`)
 
-		return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueUnknown(), diags
+		return NewPolicyAssignmentsValueUnknown(), diags
 	}
 
-	parameterNameVal, ok := parameterNameAttribute.(basetypes.StringValue)
+	resourceSelectorsVal, ok := resourceSelectorsAttribute.(basetypes.ListValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`parameter_name expected to be basetypes.StringValue, was: %T`, parameterNameAttribute))
+			fmt.Sprintf(`resource_selectors expected to be basetypes.ListValue, was: %T`, resourceSelectorsAttribute))
 	}
 
 	if diags.HasError() {
-		return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueUnknown(), diags
+		return NewPolicyAssignmentsValueUnknown(), diags
 	}
 
-	return OverridePolicyDefinitionParameterAssignPermissionsSetValue{
-		DefinitionName: definitionNameVal,
-		ParameterName:  parameterNameVal,
-		state:          attr.ValueStateKnown,
+	return PolicyAssignmentsValue{
+		EnforcementMode:       enforcementModeVal,
+		Identity:              identityVal,
+		IdentityIds:           identityIdsVal,
+		NonComplianceMessages: nonComplianceMessagesVal,
+		NotScopes:             notScopesVal,
+		Overrides:             overridesVal,
+		Parameters:            parametersVal,
+		ResourceSelectors:     resourceSelectorsVal,
+		state:                 attr.ValueStateKnown,
 	}, diags
 }
 
-func NewOverridePolicyDefinitionParameterAssignPermissionsSetValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) OverridePolicyDefinitionParameterAssignPermissionsSetValue {
-	object, diags := NewOverridePolicyDefinitionParameterAssignPermissionsSetValue(attributeTypes, attributes)
+func NewPolicyAssignmentsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) PolicyAssignmentsValue {
+	object, diags := NewPolicyAssignmentsValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -2019,15 +7169,15 @@ func NewOverridePolicyDefinitionParameterAssignPermissionsSetValueMust(attribute
 				diagnostic.Detail()))
 		}
 
-		panic("NewOverridePolicyDefinitionParameterAssignPermissionsSetValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewPolicyAssignmentsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t OverridePolicyDefinitionParameterAssignPermissionsSetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t PolicyAssignmentsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueNull(), nil
+		return NewPolicyAssignmentsValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -2035,11 +7185,11 @@ func (t OverridePolicyDefinitionParameterAssignPermissionsSetType) ValueFromTerr
 	}
 
 	if !in.IsKnown() {
-		return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueUnknown(), nil
+		return NewPolicyAssignmentsValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueNull(), nil
+		return NewPolicyAssignmentsValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -2062,84 +7212,372 @@ func (t OverridePolicyDefinitionParameterAssignPermissionsSetType) ValueFromTerr
 		attributes[k] = a
 	}
 
-	return NewOverridePolicyDefinitionParameterAssignPermissionsSetValueMust(OverridePolicyDefinitionParameterAssignPermissionsSetValue{}.AttributeTypes(ctx), attributes), nil
+	return NewPolicyAssignmentsValueMust(PolicyAssignmentsValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t OverridePolicyDefinitionParameterAssignPermissionsSetType) ValueType(ctx context.Context) attr.Value {
-	return OverridePolicyDefinitionParameterAssignPermissionsSetValue{}
+func (t PolicyAssignmentsType) ValueType(ctx context.Context) attr.Value {
+	return PolicyAssignmentsValue{}
 }
 
-var _ basetypes.ObjectValuable = OverridePolicyDefinitionParameterAssignPermissionsSetValue{}
+var _ basetypes.ObjectValuable = PolicyAssignmentsValue{}
 
-type OverridePolicyDefinitionParameterAssignPermissionsSetValue struct {
-	DefinitionName basetypes.StringValue `tfsdk:"definition_name"`
-	ParameterName  basetypes.StringValue `tfsdk:"parameter_name"`
-	state          attr.ValueState
+type PolicyAssignmentsValue struct {
+	EnforcementMode       basetypes.StringValue `tfsdk:"enforcement_mode"`
+	Identity              basetypes.StringValue `tfsdk:"identity"`
+	IdentityIds           basetypes.SetValue    `tfsdk:"identity_ids"`
+	NonComplianceMessages basetypes.SetValue    `tfsdk:"non_compliance_messages"`
+	NotScopes             basetypes.ListValue   `tfsdk:"not_scopes"`
+	Overrides             basetypes.ListValue   `tfsdk:"overrides"`
+	Parameters            basetypes.MapValue    `tfsdk:"parameters"`
+	ResourceSelectors     basetypes.ListValue   `tfsdk:"resource_selectors"`
+	state                 attr.ValueState
 }
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 2)
+func (v PolicyAssignmentsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 8)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["definition_name"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["parameter_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["enforcement_mode"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["identity"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["identity_ids"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["non_compliance_messages"] = basetypes.SetType{
+		ElemType: NonComplianceMessagesValue{}.Type(ctx),
+	}.TerraformType(ctx)
+	attrTypes["not_scopes"] = basetypes.ListType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["overrides"] = basetypes.ListType{
+		ElemType: OverridesValue{}.Type(ctx),
+	}.TerraformType(ctx)
+	attrTypes["parameters"] = basetypes.MapType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["resource_selectors"] = basetypes.ListType{
+		ElemType: ResourceSelectorsValue{}.Type(ctx),
+	}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 2)
+		vals := make(map[string]tftypes.Value, 8)
 
-		val, err = v.DefinitionName.ToTerraformValue(ctx)
+		val, err = v.EnforcementMode.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["definition_name"] = val
+		vals["enforcement_mode"] = val
 
-		val, err = v.ParameterName.ToTerraformValue(ctx)
+		val, err = v.Identity.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["identity"] = val
+
+		val, err = v.IdentityIds.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["identity_ids"] = val
+
+		val, err = v.NonComplianceMessages.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["parameter_name"] = val
+		vals["non_compliance_messages"] = val
+
+		val, err = v.NotScopes.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["not_scopes"] = val
+
+		val, err = v.Overrides.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["overrides"] = val
+
+		val, err = v.Parameters.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["parameters"] = val
+
+		val, err = v.ResourceSelectors.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["resource_selectors"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v PolicyAssignmentsValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v PolicyAssignmentsValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v PolicyAssignmentsValue) String() string {
+	return "PolicyAssignmentsValue"
+}
+
+func (v PolicyAssignmentsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	nonComplianceMessages := types.SetValueMust(
+		NonComplianceMessagesType{
+			basetypes.ObjectType{
+				AttrTypes: NonComplianceMessagesValue{}.AttributeTypes(ctx),
+			},
+		},
+		v.NonComplianceMessages.Elements(),
+	)
+
+	if v.NonComplianceMessages.IsNull() {
+		nonComplianceMessages = types.SetNull(
+			NonComplianceMessagesType{
+				basetypes.ObjectType{
+					AttrTypes: NonComplianceMessagesValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	if v.NonComplianceMessages.IsUnknown() {
+		nonComplianceMessages = types.SetUnknown(
+			NonComplianceMessagesType{
+				basetypes.ObjectType{
+					AttrTypes: NonComplianceMessagesValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	overrides := types.ListValueMust(
+		OverridesType{
+			basetypes.ObjectType{
+				AttrTypes: OverridesValue{}.AttributeTypes(ctx),
+			},
+		},
+		v.Overrides.Elements(),
+	)
+
+	if v.Overrides.IsNull() {
+		overrides = types.ListNull(
+			OverridesType{
+				basetypes.ObjectType{
+					AttrTypes: OverridesValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	if v.Overrides.IsUnknown() {
+		overrides = types.ListUnknown(
+			OverridesType{
+				basetypes.ObjectType{
+					AttrTypes: OverridesValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	resourceSelectors := types.ListValueMust(
+		ResourceSelectorsType{
+			basetypes.ObjectType{
+				AttrTypes: ResourceSelectorsValue{}.AttributeTypes(ctx),
+			},
+		},
+		v.ResourceSelectors.Elements(),
+	)
+
+	if v.ResourceSelectors.IsNull() {
+		resourceSelectors = types.ListNull(
+			ResourceSelectorsType{
+				basetypes.ObjectType{
+					AttrTypes: ResourceSelectorsValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
 
-		if err := tftypes.ValidateValue(objectType, vals); err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
+	if v.ResourceSelectors.IsUnknown() {
+		resourceSelectors = types.ListUnknown(
+			ResourceSelectorsType{
+				basetypes.ObjectType{
+					AttrTypes: ResourceSelectorsValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
 
-		return tftypes.NewValue(objectType, vals), nil
-	case attr.ValueStateNull:
-		return tftypes.NewValue(objectType, nil), nil
-	case attr.ValueStateUnknown:
-		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	var identityIdsVal basetypes.SetValue
+	switch {
+	case v.IdentityIds.IsUnknown():
+		identityIdsVal = types.SetUnknown(types.StringType)
+	case v.IdentityIds.IsNull():
+		identityIdsVal = types.SetNull(types.StringType)
 	default:
-		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+		var d diag.Diagnostics
+		identityIdsVal, d = types.SetValue(types.StringType, v.IdentityIds.Elements())
+		diags.Append(d...)
 	}
-}
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) IsNull() bool {
-	return v.state == attr.ValueStateNull
-}
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"enforcement_mode": basetypes.StringType{},
+			"identity":         basetypes.StringType{},
+			"identity_ids": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"non_compliance_messages": basetypes.SetType{
+				ElemType: NonComplianceMessagesValue{}.Type(ctx),
+			},
+			"not_scopes": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"overrides": basetypes.ListType{
+				ElemType: OverridesValue{}.Type(ctx),
+			},
+			"parameters": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"resource_selectors": basetypes.ListType{
+				ElemType: ResourceSelectorsValue{}.Type(ctx),
+			},
+		}), diags
+	}
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) IsUnknown() bool {
-	return v.state == attr.ValueStateUnknown
-}
+	var notScopesVal basetypes.ListValue
+	switch {
+	case v.NotScopes.IsUnknown():
+		notScopesVal = types.ListUnknown(types.StringType)
+	case v.NotScopes.IsNull():
+		notScopesVal = types.ListNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		notScopesVal, d = types.ListValue(types.StringType, v.NotScopes.Elements())
+		diags.Append(d...)
+	}
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) String() string {
-	return "OverridePolicyDefinitionParameterAssignPermissionsSetValue"
-}
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"enforcement_mode": basetypes.StringType{},
+			"identity":         basetypes.StringType{},
+			"identity_ids": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"non_compliance_messages": basetypes.SetType{
+				ElemType: NonComplianceMessagesValue{}.Type(ctx),
+			},
+			"not_scopes": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"overrides": basetypes.ListType{
+				ElemType: OverridesValue{}.Type(ctx),
+			},
+			"parameters": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"resource_selectors": basetypes.ListType{
+				ElemType: ResourceSelectorsValue{}.Type(ctx),
+			},
+		}), diags
+	}
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
-	var diags diag.Diagnostics
+	var parametersVal basetypes.MapValue
+	switch {
+	case v.Parameters.IsUnknown():
+		parametersVal = types.MapUnknown(types.StringType)
+	case v.Parameters.IsNull():
+		parametersVal = types.MapNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		parametersVal, d = types.MapValue(types.StringType, v.Parameters.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"enforcement_mode": basetypes.StringType{},
+			"identity":         basetypes.StringType{},
+			"identity_ids": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+			"non_compliance_messages": basetypes.SetType{
+				ElemType: NonComplianceMessagesValue{}.Type(ctx),
+			},
+			"not_scopes": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"overrides": basetypes.ListType{
+				ElemType: OverridesValue{}.Type(ctx),
+			},
+			"parameters": basetypes.MapType{
+				ElemType: types.StringType,
+			},
+			"resource_selectors": basetypes.ListType{
+				ElemType: ResourceSelectorsValue{}.Type(ctx),
+			},
+		}), diags
+	}
 
 	attributeTypes := map[string]attr.Type{
-		"definition_name": basetypes.StringType{},
-		"parameter_name":  basetypes.StringType{},
+		"enforcement_mode": basetypes.StringType{},
+		"identity":         basetypes.StringType{},
+		"identity_ids": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"non_compliance_messages": basetypes.SetType{
+			ElemType: NonComplianceMessagesValue{}.Type(ctx),
+		},
+		"not_scopes": basetypes.ListType{
+			ElemType: types.StringType,
+		},
+		"overrides": basetypes.ListType{
+			ElemType: OverridesValue{}.Type(ctx),
+		},
+		"parameters": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"resource_selectors": basetypes.ListType{
+			ElemType: ResourceSelectorsValue{}.Type(ctx),
+		},
 	}
 
 	if v.IsNull() {
@@ -2153,15 +7591,21 @@ func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) ToObjectValu
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"definition_name": v.DefinitionName,
-			"parameter_name":  v.ParameterName,
+			"enforcement_mode":        v.EnforcementMode,
+			"identity":                v.Identity,
+			"identity_ids":            identityIdsVal,
+			"non_compliance_messages": nonComplianceMessages,
+			"not_scopes":              notScopesVal,
+			"overrides":               overrides,
+			"parameters":              parametersVal,
+			"resource_selectors":      resourceSelectors,
 		})
 
 	return objVal, diags
 }
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) Equal(o attr.Value) bool {
-	other, ok := o.(OverridePolicyDefinitionParameterAssignPermissionsSetValue)
+func (v PolicyAssignmentsValue) Equal(o attr.Value) bool {
+	other, ok := o.(PolicyAssignmentsValue)
 
 	if !ok {
 		return false
@@ -2175,40 +7619,82 @@ func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) Equal(o attr
 		return true
 	}
 
-	if !v.DefinitionName.Equal(other.DefinitionName) {
+	if !v.EnforcementMode.Equal(other.EnforcementMode) {
 		return false
 	}
 
-	if !v.ParameterName.Equal(other.ParameterName) {
+	if !v.Identity.Equal(other.Identity) {
+		return false
+	}
+
+	if !v.IdentityIds.Equal(other.IdentityIds) {
+		return false
+	}
+
+	if !v.NonComplianceMessages.Equal(other.NonComplianceMessages) {
+		return false
+	}
+
+	if !v.NotScopes.Equal(other.NotScopes) {
+		return false
+	}
+
+	if !v.Overrides.Equal(other.Overrides) {
+		return false
+	}
+
+	if !v.Parameters.Equal(other.Parameters) {
+		return false
+	}
+
+	if !v.ResourceSelectors.Equal(other.ResourceSelectors) {
 		return false
 	}
 
 	return true
 }
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) Type(ctx context.Context) attr.Type {
-	return OverridePolicyDefinitionParameterAssignPermissionsSetType{
+func (v PolicyAssignmentsValue) Type(ctx context.Context) attr.Type {
+	return PolicyAssignmentsType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsSetValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v PolicyAssignmentsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"definition_name": basetypes.StringType{},
-		"parameter_name":  basetypes.StringType{},
+		"enforcement_mode": basetypes.StringType{},
+		"identity":         basetypes.StringType{},
+		"identity_ids": basetypes.SetType{
+			ElemType: types.StringType,
+		},
+		"non_compliance_messages": basetypes.SetType{
+			ElemType: NonComplianceMessagesValue{}.Type(ctx),
+		},
+		"not_scopes": basetypes.ListType{
+			ElemType: types.StringType,
+		},
+		"overrides": basetypes.ListType{
+			ElemType: OverridesValue{}.Type(ctx),
+		},
+		"parameters": basetypes.MapType{
+			ElemType: types.StringType,
+		},
+		"resource_selectors": basetypes.ListType{
+			ElemType: ResourceSelectorsValue{}.Type(ctx),
+		},
 	}
 }
 
-var _ basetypes.ObjectTypable = OverridePolicyDefinitionParameterAssignPermissionsUnsetType{}
+var _ basetypes.ObjectTypable = NonComplianceMessagesType{}
 
-type OverridePolicyDefinitionParameterAssignPermissionsUnsetType struct {
+type NonComplianceMessagesType struct {
 	basetypes.ObjectType
 }
 
-func (t OverridePolicyDefinitionParameterAssignPermissionsUnsetType) Equal(o attr.Type) bool {
-	other, ok := o.(OverridePolicyDefinitionParameterAssignPermissionsUnsetType)
+func (t NonComplianceMessagesType) Equal(o attr.Type) bool {
+	other, ok := o.(NonComplianceMessagesType)
 
 	if !ok {
 		return false
@@ -2217,75 +7703,75 @@ func (t OverridePolicyDefinitionParameterAssignPermissionsUnsetType) Equal(o att
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t OverridePolicyDefinitionParameterAssignPermissionsUnsetType) String() string {
-	return "OverridePolicyDefinitionParameterAssignPermissionsUnsetType"
+func (t NonComplianceMessagesType) String() string {
+	return "NonComplianceMessagesType"
 }
 
-func (t OverridePolicyDefinitionParameterAssignPermissionsUnsetType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t NonComplianceMessagesType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	definitionNameAttribute, ok := attributes["definition_name"]
+	messageAttribute, ok := attributes["message"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`definition_name is missing from object`)
+			`message is missing from object`)
 
 		return nil, diags
 	}
 
-	definitionNameVal, ok := definitionNameAttribute.(basetypes.StringValue)
+	messageVal, ok := messageAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`definition_name expected to be basetypes.StringValue, was: %T`, definitionNameAttribute))
+			fmt.Sprintf(`message expected to be basetypes.StringValue, was: %T`, messageAttribute))
 	}
 
-	parameterNameAttribute, ok := attributes["parameter_name"]
+	policyDefinitionReferenceIdAttribute, ok := attributes["policy_definition_reference_id"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`parameter_name is missing from object`)
+			`policy_definition_reference_id is missing from object`)
 
 		return nil, diags
 	}
 
-	parameterNameVal, ok := parameterNameAttribute.(basetypes.StringValue)
+	policyDefinitionReferenceIdVal, ok := policyDefinitionReferenceIdAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`parameter_name expected to be basetypes.StringValue, was: %T`, parameterNameAttribute))
+			fmt.Sprintf(`policy_definition_reference_id expected to be basetypes.StringValue, was: %T`, policyDefinitionReferenceIdAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return OverridePolicyDefinitionParameterAssignPermissionsUnsetValue{
-		DefinitionName: definitionNameVal,
-		ParameterName:  parameterNameVal,
-		state:          attr.ValueStateKnown,
+	return NonComplianceMessagesValue{
+		Message:                     messageVal,
+		PolicyDefinitionReferenceId: policyDefinitionReferenceIdVal,
+		state:                       attr.ValueStateKnown,
 	}, diags
 }
 
-func NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueNull() OverridePolicyDefinitionParameterAssignPermissionsUnsetValue {
-	return OverridePolicyDefinitionParameterAssignPermissionsUnsetValue{
+func NewNonComplianceMessagesValueNull() NonComplianceMessagesValue {
+	return NonComplianceMessagesValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueUnknown() OverridePolicyDefinitionParameterAssignPermissionsUnsetValue {
-	return OverridePolicyDefinitionParameterAssignPermissionsUnsetValue{
+func NewNonComplianceMessagesValueUnknown() NonComplianceMessagesValue {
+	return NonComplianceMessagesValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (OverridePolicyDefinitionParameterAssignPermissionsUnsetValue, diag.Diagnostics) {
+func NewNonComplianceMessagesValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (NonComplianceMessagesValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -2296,11 +7782,11 @@ func NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValue(attributeTy
 
 		if !ok {
 			diags.AddError(
-				"Missing OverridePolicyDefinitionParameterAssignPermissionsUnsetValue Attribute Value",
-				"While creating a OverridePolicyDefinitionParameterAssignPermissionsUnsetValue value, a missing attribute value was detected. "+
-					"A OverridePolicyDefinitionParameterAssignPermissionsUnsetValue must contain values for all attributes, even if null or unknown. "+
+				"Missing NonComplianceMessagesValue Attribute Value",
+				"While creating a NonComplianceMessagesValue value, a missing attribute value was detected. "+
+					"A NonComplianceMessagesValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("OverridePolicyDefinitionParameterAssignPermissionsUnsetValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("NonComplianceMessagesValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -2308,12 +7794,12 @@ func NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValue(attributeTy
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid OverridePolicyDefinitionParameterAssignPermissionsUnsetValue Attribute Type",
-				"While creating a OverridePolicyDefinitionParameterAssignPermissionsUnsetValue value, an invalid attribute value was detected. "+
-					"A OverridePolicyDefinitionParameterAssignPermissionsUnsetValue must use a matching attribute type for the value. "+
+				"Invalid NonComplianceMessagesValue Attribute Type",
+				"While creating a NonComplianceMessagesValue value, an invalid attribute value was detected. "+
+					"A NonComplianceMessagesValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("OverridePolicyDefinitionParameterAssignPermissionsUnsetValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("OverridePolicyDefinitionParameterAssignPermissionsUnsetValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("NonComplianceMessagesValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("NonComplianceMessagesValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -2323,68 +7809,68 @@ func NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValue(attributeTy
 
 		if !ok {
 			diags.AddError(
-				"Extra OverridePolicyDefinitionParameterAssignPermissionsUnsetValue Attribute Value",
-				"While creating a OverridePolicyDefinitionParameterAssignPermissionsUnsetValue value, an extra attribute value was detected. "+
-					"A OverridePolicyDefinitionParameterAssignPermissionsUnsetValue must not contain values beyond the expected attribute types. "+
+				"Extra NonComplianceMessagesValue Attribute Value",
+				"While creating a NonComplianceMessagesValue value, an extra attribute value was detected. "+
+					"A NonComplianceMessagesValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra OverridePolicyDefinitionParameterAssignPermissionsUnsetValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra NonComplianceMessagesValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueUnknown(), diags
+		return NewNonComplianceMessagesValueUnknown(), diags
 	}
 
-	definitionNameAttribute, ok := attributes["definition_name"]
+	messageAttribute, ok := attributes["message"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`definition_name is missing from object`)
+			`message is missing from object`)
 
-		return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueUnknown(), diags
+		return NewNonComplianceMessagesValueUnknown(), diags
 	}
 
-	definitionNameVal, ok := definitionNameAttribute.(basetypes.StringValue)
+	messageVal, ok := messageAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`definition_name expected to be basetypes.StringValue, was: %T`, definitionNameAttribute))
+			fmt.Sprintf(`message expected to be basetypes.StringValue, was: %T`, messageAttribute))
 	}
 
-	parameterNameAttribute, ok := attributes["parameter_name"]
+	policyDefinitionReferenceIdAttribute, ok := attributes["policy_definition_reference_id"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`parameter_name is missing from object`)
+			`policy_definition_reference_id is missing from object`)
 
-		return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueUnknown(), diags
+		return NewNonComplianceMessagesValueUnknown(), diags
 	}
 
-	parameterNameVal, ok := parameterNameAttribute.(basetypes.StringValue)
+	policyDefinitionReferenceIdVal, ok := policyDefinitionReferenceIdAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`parameter_name expected to be basetypes.StringValue, was: %T`, parameterNameAttribute))
+			fmt.Sprintf(`policy_definition_reference_id expected to be basetypes.StringValue, was: %T`, policyDefinitionReferenceIdAttribute))
 	}
 
 	if diags.HasError() {
-		return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueUnknown(), diags
+		return NewNonComplianceMessagesValueUnknown(), diags
 	}
 
-	return OverridePolicyDefinitionParameterAssignPermissionsUnsetValue{
-		DefinitionName: definitionNameVal,
-		ParameterName:  parameterNameVal,
-		state:          attr.ValueStateKnown,
+	return NonComplianceMessagesValue{
+		Message:                     messageVal,
+		PolicyDefinitionReferenceId: policyDefinitionReferenceIdVal,
+		state:                       attr.ValueStateKnown,
 	}, diags
 }
 
-func NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) OverridePolicyDefinitionParameterAssignPermissionsUnsetValue {
-	object, diags := NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValue(attributeTypes, attributes)
+func NewNonComplianceMessagesValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) NonComplianceMessagesValue {
+	object, diags := NewNonComplianceMessagesValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -2398,15 +7884,15 @@ func NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueMust(attribu
 				diagnostic.Detail()))
 		}
 
-		panic("NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewNonComplianceMessagesValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t OverridePolicyDefinitionParameterAssignPermissionsUnsetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t NonComplianceMessagesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueNull(), nil
+		return NewNonComplianceMessagesValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -2414,11 +7900,11 @@ func (t OverridePolicyDefinitionParameterAssignPermissionsUnsetType) ValueFromTe
 	}
 
 	if !in.IsKnown() {
-		return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueUnknown(), nil
+		return NewNonComplianceMessagesValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueNull(), nil
+		return NewNonComplianceMessagesValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -2441,29 +7927,29 @@ func (t OverridePolicyDefinitionParameterAssignPermissionsUnsetType) ValueFromTe
 		attributes[k] = a
 	}
 
-	return NewOverridePolicyDefinitionParameterAssignPermissionsUnsetValueMust(OverridePolicyDefinitionParameterAssignPermissionsUnsetValue{}.AttributeTypes(ctx), attributes), nil
+	return NewNonComplianceMessagesValueMust(NonComplianceMessagesValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t OverridePolicyDefinitionParameterAssignPermissionsUnsetType) ValueType(ctx context.Context) attr.Value {
-	return OverridePolicyDefinitionParameterAssignPermissionsUnsetValue{}
+func (t NonComplianceMessagesType) ValueType(ctx context.Context) attr.Value {
+	return NonComplianceMessagesValue{}
 }
 
-var _ basetypes.ObjectValuable = OverridePolicyDefinitionParameterAssignPermissionsUnsetValue{}
+var _ basetypes.ObjectValuable = NonComplianceMessagesValue{}
 
-type OverridePolicyDefinitionParameterAssignPermissionsUnsetValue struct {
-	DefinitionName basetypes.StringValue `tfsdk:"definition_name"`
-	ParameterName  basetypes.StringValue `tfsdk:"parameter_name"`
-	state          attr.ValueState
+type NonComplianceMessagesValue struct {
+	Message                     basetypes.StringValue `tfsdk:"message"`
+	PolicyDefinitionReferenceId basetypes.StringValue `tfsdk:"policy_definition_reference_id"`
+	state                       attr.ValueState
 }
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+func (v NonComplianceMessagesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 	attrTypes := make(map[string]tftypes.Type, 2)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["definition_name"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["parameter_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["message"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["policy_definition_reference_id"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
@@ -2471,21 +7957,21 @@ func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) ToTerrafor
 	case attr.ValueStateKnown:
 		vals := make(map[string]tftypes.Value, 2)
 
-		val, err = v.DefinitionName.ToTerraformValue(ctx)
+		val, err = v.Message.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["definition_name"] = val
+		vals["message"] = val
 
-		val, err = v.ParameterName.ToTerraformValue(ctx)
+		val, err = v.PolicyDefinitionReferenceId.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["parameter_name"] = val
+		vals["policy_definition_reference_id"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -2501,24 +7987,24 @@ func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) ToTerrafor
 	}
 }
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) IsNull() bool {
+func (v NonComplianceMessagesValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) IsUnknown() bool {
+func (v NonComplianceMessagesValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) String() string {
-	return "OverridePolicyDefinitionParameterAssignPermissionsUnsetValue"
+func (v NonComplianceMessagesValue) String() string {
+	return "NonComplianceMessagesValue"
 }
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v NonComplianceMessagesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributeTypes := map[string]attr.Type{
-		"definition_name": basetypes.StringType{},
-		"parameter_name":  basetypes.StringType{},
+		"message":                        basetypes.StringType{},
+		"policy_definition_reference_id": basetypes.StringType{},
 	}
 
 	if v.IsNull() {
@@ -2532,15 +8018,15 @@ func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) ToObjectVa
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"definition_name": v.DefinitionName,
-			"parameter_name":  v.ParameterName,
+			"message":                        v.Message,
+			"policy_definition_reference_id": v.PolicyDefinitionReferenceId,
 		})
 
 	return objVal, diags
 }
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) Equal(o attr.Value) bool {
-	other, ok := o.(OverridePolicyDefinitionParameterAssignPermissionsUnsetValue)
+func (v NonComplianceMessagesValue) Equal(o attr.Value) bool {
+	other, ok := o.(NonComplianceMessagesValue)
 
 	if !ok {
 		return false
@@ -2554,40 +8040,40 @@ func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) Equal(o at
 		return true
 	}
 
-	if !v.DefinitionName.Equal(other.DefinitionName) {
+	if !v.Message.Equal(other.Message) {
 		return false
 	}
 
-	if !v.ParameterName.Equal(other.ParameterName) {
+	if !v.PolicyDefinitionReferenceId.Equal(other.PolicyDefinitionReferenceId) {
 		return false
 	}
 
 	return true
 }
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) Type(ctx context.Context) attr.Type {
-	return OverridePolicyDefinitionParameterAssignPermissionsUnsetType{
+func (v NonComplianceMessagesValue) Type(ctx context.Context) attr.Type {
+	return NonComplianceMessagesType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v OverridePolicyDefinitionParameterAssignPermissionsUnsetValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v NonComplianceMessagesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"definition_name": basetypes.StringType{},
-		"parameter_name":  basetypes.StringType{},
+		"message":                        basetypes.StringType{},
+		"policy_definition_reference_id": basetypes.StringType{},
 	}
 }
 
-var _ basetypes.ObjectTypable = PolicyAssignmentsToModifyType{}
+var _ basetypes.ObjectTypable = OverridesType{}
 
-type PolicyAssignmentsToModifyType struct {
+type OverridesType struct {
 	basetypes.ObjectType
 }
 
-func (t PolicyAssignmentsToModifyType) Equal(o attr.Type) bool {
-	other, ok := o.(PolicyAssignmentsToModifyType)
+func (t OverridesType) Equal(o attr.Type) bool {
+	other, ok := o.(OverridesType)
 
 	if !ok {
 		return false
@@ -2596,56 +8082,94 @@ func (t PolicyAssignmentsToModifyType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t PolicyAssignmentsToModifyType) String() string {
-	return "PolicyAssignmentsToModifyType"
+func (t OverridesType) String() string {
+	return "OverridesType"
 }
 
-func (t PolicyAssignmentsToModifyType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t OverridesType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	policyAssignmentsAttribute, ok := attributes["policy_assignments"]
+	kindAttribute, ok := attributes["kind"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`policy_assignments is missing from object`)
+			`kind is missing from object`)
 
 		return nil, diags
 	}
 
-	policyAssignmentsVal, ok := policyAssignmentsAttribute.(basetypes.MapValue)
+	kindVal, ok := kindAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`policy_assignments expected to be basetypes.MapValue, was: %T`, policyAssignmentsAttribute))
+			fmt.Sprintf(`kind expected to be basetypes.StringValue, was: %T`, kindAttribute))
+	}
+
+	overrideSelectorsAttribute, ok := attributes["override_selectors"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`override_selectors is missing from object`)
+
+		return nil, diags
+	}
+
+	overrideSelectorsVal, ok := overrideSelectorsAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`override_selectors expected to be basetypes.ListValue, was: %T`, overrideSelectorsAttribute))
+	}
+
+	valueAttribute, ok := attributes["value"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`value is missing from object`)
+
+		return nil, diags
+	}
+
+	valueVal, ok := valueAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`value expected to be basetypes.StringValue, was: %T`, valueAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return PolicyAssignmentsToModifyValue{
-		PolicyAssignments: policyAssignmentsVal,
+	return OverridesValue{
+		Kind:              kindVal,
+		OverrideSelectors: overrideSelectorsVal,
+		Value:             valueVal,
 		state:             attr.ValueStateKnown,
 	}, diags
 }
 
-func NewPolicyAssignmentsToModifyValueNull() PolicyAssignmentsToModifyValue {
-	return PolicyAssignmentsToModifyValue{
+func NewOverridesValueNull() OverridesValue {
+	return OverridesValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewPolicyAssignmentsToModifyValueUnknown() PolicyAssignmentsToModifyValue {
-	return PolicyAssignmentsToModifyValue{
+func NewOverridesValueUnknown() OverridesValue {
+	return OverridesValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewPolicyAssignmentsToModifyValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (PolicyAssignmentsToModifyValue, diag.Diagnostics) {
+func NewOverridesValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (OverridesValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -2656,11 +8180,11 @@ func NewPolicyAssignmentsToModifyValue(attributeTypes map[string]attr.Type, attr
 
 		if !ok {
 			diags.AddError(
-				"Missing PolicyAssignmentsToModifyValue Attribute Value",
-				"While creating a PolicyAssignmentsToModifyValue value, a missing attribute value was detected. "+
-					"A PolicyAssignmentsToModifyValue must contain values for all attributes, even if null or unknown. "+
+				"Missing OverridesValue Attribute Value",
+				"While creating a OverridesValue value, a missing attribute value was detected. "+
+					"A OverridesValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("PolicyAssignmentsToModifyValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("OverridesValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -2668,12 +8192,12 @@ func NewPolicyAssignmentsToModifyValue(attributeTypes map[string]attr.Type, attr
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid PolicyAssignmentsToModifyValue Attribute Type",
-				"While creating a PolicyAssignmentsToModifyValue value, an invalid attribute value was detected. "+
-					"A PolicyAssignmentsToModifyValue must use a matching attribute type for the value. "+
+				"Invalid OverridesValue Attribute Type",
+				"While creating a OverridesValue value, an invalid attribute value was detected. "+
+					"A OverridesValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("PolicyAssignmentsToModifyValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("PolicyAssignmentsToModifyValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("OverridesValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("OverridesValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -2683,49 +8207,87 @@ func NewPolicyAssignmentsToModifyValue(attributeTypes map[string]attr.Type, attr
 
 		if !ok {
 			diags.AddError(
-				"Extra PolicyAssignmentsToModifyValue Attribute Value",
-				"While creating a PolicyAssignmentsToModifyValue value, an extra attribute value was detected. "+
-					"A PolicyAssignmentsToModifyValue must not contain values beyond the expected attribute types. "+
+				"Extra OverridesValue Attribute Value",
+				"While creating a OverridesValue value, an extra attribute value was detected. "+
+					"A OverridesValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra PolicyAssignmentsToModifyValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra OverridesValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewPolicyAssignmentsToModifyValueUnknown(), diags
+		return NewOverridesValueUnknown(), diags
 	}
 
-	policyAssignmentsAttribute, ok := attributes["policy_assignments"]
+	kindAttribute, ok := attributes["kind"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`policy_assignments is missing from object`)
+			`kind is missing from object`)
 
-		return NewPolicyAssignmentsToModifyValueUnknown(), diags
+		return NewOverridesValueUnknown(), diags
 	}
 
-	policyAssignmentsVal, ok := policyAssignmentsAttribute.(basetypes.MapValue)
+	kindVal, ok := kindAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`policy_assignments expected to be basetypes.MapValue, was: %T`, policyAssignmentsAttribute))
+			fmt.Sprintf(`kind expected to be basetypes.StringValue, was: %T`, kindAttribute))
+	}
+
+	overrideSelectorsAttribute, ok := attributes["override_selectors"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`override_selectors is missing from object`)
+
+		return NewOverridesValueUnknown(), diags
+	}
+
+	overrideSelectorsVal, ok := overrideSelectorsAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`override_selectors expected to be basetypes.ListValue, was: %T`, overrideSelectorsAttribute))
+	}
+
+	valueAttribute, ok := attributes["value"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`value is missing from object`)
+
+		return NewOverridesValueUnknown(), diags
+	}
+
+	valueVal, ok := valueAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`value expected to be basetypes.StringValue, was: %T`, valueAttribute))
 	}
 
 	if diags.HasError() {
-		return NewPolicyAssignmentsToModifyValueUnknown(), diags
+		return NewOverridesValueUnknown(), diags
 	}
 
-	return PolicyAssignmentsToModifyValue{
-		PolicyAssignments: policyAssignmentsVal,
+	return OverridesValue{
+		Kind:              kindVal,
+		OverrideSelectors: overrideSelectorsVal,
+		Value:             valueVal,
 		state:             attr.ValueStateKnown,
 	}, diags
 }
 
-func NewPolicyAssignmentsToModifyValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) PolicyAssignmentsToModifyValue {
-	object, diags := NewPolicyAssignmentsToModifyValue(attributeTypes, attributes)
+func NewOverridesValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) OverridesValue {
+	object, diags := NewOverridesValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -2739,15 +8301,15 @@ func NewPolicyAssignmentsToModifyValueMust(attributeTypes map[string]attr.Type,
 				diagnostic.Detail()))
 		}
 
-		panic("NewPolicyAssignmentsToModifyValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewOverridesValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t PolicyAssignmentsToModifyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t OverridesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewPolicyAssignmentsToModifyValueNull(), nil
+		return NewOverridesValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -2755,11 +8317,11 @@ func (t PolicyAssignmentsToModifyType) ValueFromTerraform(ctx context.Context, i
 	}
 
 	if !in.IsKnown() {
-		return NewPolicyAssignmentsToModifyValueUnknown(), nil
+		return NewOverridesValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewPolicyAssignmentsToModifyValueNull(), nil
+		return NewOverridesValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -2782,43 +8344,63 @@ func (t PolicyAssignmentsToModifyType) ValueFromTerraform(ctx context.Context, i
 		attributes[k] = a
 	}
 
-	return NewPolicyAssignmentsToModifyValueMust(PolicyAssignmentsToModifyValue{}.AttributeTypes(ctx), attributes), nil
+	return NewOverridesValueMust(OverridesValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t PolicyAssignmentsToModifyType) ValueType(ctx context.Context) attr.Value {
-	return PolicyAssignmentsToModifyValue{}
+func (t OverridesType) ValueType(ctx context.Context) attr.Value {
+	return OverridesValue{}
 }
 
-var _ basetypes.ObjectValuable = PolicyAssignmentsToModifyValue{}
+var _ basetypes.ObjectValuable = OverridesValue{}
 
-type PolicyAssignmentsToModifyValue struct {
-	PolicyAssignments basetypes.MapValue `tfsdk:"policy_assignments"`
+type OverridesValue struct {
+	Kind              basetypes.StringValue `tfsdk:"kind"`
+	OverrideSelectors basetypes.ListValue   `tfsdk:"override_selectors"`
+	Value             basetypes.StringValue `tfsdk:"value"`
 	state             attr.ValueState
 }
 
-func (v PolicyAssignmentsToModifyValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 1)
+func (v OverridesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 3)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["policy_assignments"] = basetypes.MapType{
-		ElemType: PolicyAssignmentsValue{}.Type(ctx),
+	attrTypes["kind"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["override_selectors"] = basetypes.ListType{
+		ElemType: OverrideSelectorsValue{}.Type(ctx),
 	}.TerraformType(ctx)
+	attrTypes["value"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 1)
+		vals := make(map[string]tftypes.Value, 3)
 
-		val, err = v.PolicyAssignments.ToTerraformValue(ctx)
+		val, err = v.Kind.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["policy_assignments"] = val
+		vals["kind"] = val
+
+		val, err = v.OverrideSelectors.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["override_selectors"] = val
+
+		val, err = v.Value.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["value"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -2834,54 +8416,56 @@ func (v PolicyAssignmentsToModifyValue) ToTerraformValue(ctx context.Context) (t
 	}
 }
 
-func (v PolicyAssignmentsToModifyValue) IsNull() bool {
+func (v OverridesValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v PolicyAssignmentsToModifyValue) IsUnknown() bool {
+func (v OverridesValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v PolicyAssignmentsToModifyValue) String() string {
-	return "PolicyAssignmentsToModifyValue"
+func (v OverridesValue) String() string {
+	return "OverridesValue"
 }
 
-func (v PolicyAssignmentsToModifyValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v OverridesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	policyAssignments := types.MapValueMust(
-		PolicyAssignmentsType{
+	overrideSelectors := types.ListValueMust(
+		OverrideSelectorsType{
 			basetypes.ObjectType{
-				AttrTypes: PolicyAssignmentsValue{}.AttributeTypes(ctx),
+				AttrTypes: OverrideSelectorsValue{}.AttributeTypes(ctx),
 			},
 		},
-		v.PolicyAssignments.Elements(),
+		v.OverrideSelectors.Elements(),
 	)
 
-	if v.PolicyAssignments.IsNull() {
-		policyAssignments = types.MapNull(
-			PolicyAssignmentsType{
+	if v.OverrideSelectors.IsNull() {
+		overrideSelectors = types.ListNull(
+			OverrideSelectorsType{
 				basetypes.ObjectType{
-					AttrTypes: PolicyAssignmentsValue{}.AttributeTypes(ctx),
+					AttrTypes: OverrideSelectorsValue{}.AttributeTypes(ctx),
 				},
 			},
 		)
 	}
 
-	if v.PolicyAssignments.IsUnknown() {
-		policyAssignments = types.MapUnknown(
-			PolicyAssignmentsType{
+	if v.OverrideSelectors.IsUnknown() {
+		overrideSelectors = types.ListUnknown(
+			OverrideSelectorsType{
 				basetypes.ObjectType{
-					AttrTypes: PolicyAssignmentsValue{}.AttributeTypes(ctx),
+					AttrTypes: OverrideSelectorsValue{}.AttributeTypes(ctx),
 				},
 			},
 		)
 	}
 
 	attributeTypes := map[string]attr.Type{
-		"policy_assignments": basetypes.MapType{
-			ElemType: PolicyAssignmentsValue{}.Type(ctx),
+		"kind": basetypes.StringType{},
+		"override_selectors": basetypes.ListType{
+			ElemType: OverrideSelectorsValue{}.Type(ctx),
 		},
+		"value": basetypes.StringType{},
 	}
 
 	if v.IsNull() {
@@ -2895,14 +8479,16 @@ func (v PolicyAssignmentsToModifyValue) ToObjectValue(ctx context.Context) (base
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"policy_assignments": policyAssignments,
+			"kind":               v.Kind,
+			"override_selectors": overrideSelectors,
+			"value":              v.Value,
 		})
 
 	return objVal, diags
 }
 
-func (v PolicyAssignmentsToModifyValue) Equal(o attr.Value) bool {
-	other, ok := o.(PolicyAssignmentsToModifyValue)
+func (v OverridesValue) Equal(o attr.Value) bool {
+	other, ok := o.(OverridesValue)
 
 	if !ok {
 		return false
@@ -2912,232 +8498,147 @@ func (v PolicyAssignmentsToModifyValue) Equal(o attr.Value) bool {
 		return false
 	}
 
-	if v.state != attr.ValueStateKnown {
-		return true
-	}
-
-	if !v.PolicyAssignments.Equal(other.PolicyAssignments) {
-		return false
-	}
-
-	return true
-}
-
-func (v PolicyAssignmentsToModifyValue) Type(ctx context.Context) attr.Type {
-	return PolicyAssignmentsToModifyType{
-		basetypes.ObjectType{
-			AttrTypes: v.AttributeTypes(ctx),
-		},
-	}
-}
-
-func (v PolicyAssignmentsToModifyValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
-	return map[string]attr.Type{
-		"policy_assignments": basetypes.MapType{
-			ElemType: PolicyAssignmentsValue{}.Type(ctx),
-		},
-	}
-}
-
-var _ basetypes.ObjectTypable = PolicyAssignmentsType{}
-
-type PolicyAssignmentsType struct {
-	basetypes.ObjectType
-}
-
-func (t PolicyAssignmentsType) Equal(o attr.Type) bool {
-	other, ok := o.(PolicyAssignmentsType)
-
-	if !ok {
-		return false
-	}
-
-	return t.ObjectType.Equal(other.ObjectType)
-}
-
-func (t PolicyAssignmentsType) String() string {
-	return "PolicyAssignmentsType"
-}
-
-func (t PolicyAssignmentsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	attributes := in.Attributes()
-
-	enforcementModeAttribute, ok := attributes["enforcement_mode"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`enforcement_mode is missing from object`)
-
-		return nil, diags
-	}
-
-	enforcementModeVal, ok := enforcementModeAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`enforcement_mode expected to be basetypes.StringValue, was: %T`, enforcementModeAttribute))
-	}
-
-	identityAttribute, ok := attributes["identity"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`identity is missing from object`)
-
-		return nil, diags
-	}
-
-	identityVal, ok := identityAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`identity expected to be basetypes.StringValue, was: %T`, identityAttribute))
+	if v.state != attr.ValueStateKnown {
+		return true
 	}
 
-	identityIdsAttribute, ok := attributes["identity_ids"]
+	if !v.Kind.Equal(other.Kind) {
+		return false
+	}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`identity_ids is missing from object`)
+	if !v.OverrideSelectors.Equal(other.OverrideSelectors) {
+		return false
+	}
 
-		return nil, diags
+	if !v.Value.Equal(other.Value) {
+		return false
 	}
 
-	identityIdsVal, ok := identityIdsAttribute.(basetypes.SetValue)
+	return true
+}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`identity_ids expected to be basetypes.SetValue, was: %T`, identityIdsAttribute))
+func (v OverridesValue) Type(ctx context.Context) attr.Type {
+	return OverridesType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
 	}
+}
 
-	nonComplianceMessagesAttribute, ok := attributes["non_compliance_messages"]
+func (v OverridesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"kind": basetypes.StringType{},
+		"override_selectors": basetypes.ListType{
+			ElemType: OverrideSelectorsValue{}.Type(ctx),
+		},
+		"value": basetypes.StringType{},
+	}
+}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`non_compliance_messages is missing from object`)
+var _ basetypes.ObjectTypable = OverrideSelectorsType{}
 
-		return nil, diags
-	}
+type OverrideSelectorsType struct {
+	basetypes.ObjectType
+}
 
-	nonComplianceMessagesVal, ok := nonComplianceMessagesAttribute.(basetypes.SetValue)
+func (t OverrideSelectorsType) Equal(o attr.Type) bool {
+	other, ok := o.(OverrideSelectorsType)
 
 	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`non_compliance_messages expected to be basetypes.SetValue, was: %T`, nonComplianceMessagesAttribute))
+		return false
 	}
 
-	notScopesAttribute, ok := attributes["not_scopes"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`not_scopes is missing from object`)
+	return t.ObjectType.Equal(other.ObjectType)
+}
 
-		return nil, diags
-	}
+func (t OverrideSelectorsType) String() string {
+	return "OverrideSelectorsType"
+}
 
-	notScopesVal, ok := notScopesAttribute.(basetypes.ListValue)
+func (t OverrideSelectorsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`not_scopes expected to be basetypes.ListValue, was: %T`, notScopesAttribute))
-	}
+	attributes := in.Attributes()
 
-	overridesAttribute, ok := attributes["overrides"]
+	inAttribute, ok := attributes["in"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`overrides is missing from object`)
+			`in is missing from object`)
 
 		return nil, diags
 	}
 
-	overridesVal, ok := overridesAttribute.(basetypes.ListValue)
+	inVal, ok := inAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`overrides expected to be basetypes.ListValue, was: %T`, overridesAttribute))
+			fmt.Sprintf(`in expected to be basetypes.SetValue, was: %T`, inAttribute))
 	}
 
-	parametersAttribute, ok := attributes["parameters"]
+	kindAttribute, ok := attributes["kind"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`parameters is missing from object`)
+			`kind is missing from object`)
 
 		return nil, diags
 	}
 
-	parametersVal, ok := parametersAttribute.(basetypes.MapValue)
+	kindVal, ok := kindAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`parameters expected to be basetypes.MapValue, was: %T`, parametersAttribute))
+			fmt.Sprintf(`kind expected to be basetypes.StringValue, was: %T`, kindAttribute))
 	}
 
-	resourceSelectorsAttribute, ok := attributes["resource_selectors"]
+	notInAttribute, ok := attributes["not_in"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`resource_selectors is missing from object`)
+			`not_in is missing from object`)
 
 		return nil, diags
 	}
 
-	resourceSelectorsVal, ok := resourceSelectorsAttribute.(basetypes.ListValue)
+	notInVal, ok := notInAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`resource_selectors expected to be basetypes.ListValue, was: %T`, resourceSelectorsAttribute))
+			fmt.Sprintf(`not_in expected to be basetypes.SetValue, was: %T`, notInAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return PolicyAssignmentsValue{
-		EnforcementMode:       enforcementModeVal,
-		Identity:              identityVal,
-		IdentityIds:           identityIdsVal,
-		NonComplianceMessages: nonComplianceMessagesVal,
-		NotScopes:             notScopesVal,
-		Overrides:             overridesVal,
-		Parameters:            parametersVal,
-		ResourceSelectors:     resourceSelectorsVal,
-		state:                 attr.ValueStateKnown,
+	return OverrideSelectorsValue{
+		In:    inVal,
+		Kind:  kindVal,
+		NotIn: notInVal,
+		state: attr.ValueStateKnown,
 	}, diags
 }
 
-func NewPolicyAssignmentsValueNull() PolicyAssignmentsValue {
-	return PolicyAssignmentsValue{
+func NewOverrideSelectorsValueNull() OverrideSelectorsValue {
+	return OverrideSelectorsValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewPolicyAssignmentsValueUnknown() PolicyAssignmentsValue {
-	return PolicyAssignmentsValue{
+func NewOverrideSelectorsValueUnknown() OverrideSelectorsValue {
+	return OverrideSelectorsValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewPolicyAssignmentsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (PolicyAssignmentsValue, diag.Diagnostics) {
+func NewOverrideSelectorsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (OverrideSelectorsValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -3148,11 +8649,11 @@ func NewPolicyAssignmentsValue(attributeTypes map[string]attr.Type, attributes m
 
 		if !ok {
 			diags.AddError(
-				"Missing PolicyAssignmentsValue Attribute Value",
-				"While creating a PolicyAssignmentsValue value, a missing attribute value was detected. "+
-					"A PolicyAssignmentsValue must contain values for all attributes, even if null or unknown. "+
+				"Missing OverrideSelectorsValue Attribute Value",
+				"While creating a OverrideSelectorsValue value, a missing attribute value was detected. "+
+					"A OverrideSelectorsValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("PolicyAssignmentsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("OverrideSelectorsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -3160,12 +8661,12 @@ func NewPolicyAssignmentsValue(attributeTypes map[string]attr.Type, attributes m
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid PolicyAssignmentsValue Attribute Type",
-				"While creating a PolicyAssignmentsValue value, an invalid attribute value was detected. "+
-					"A PolicyAssignmentsValue must use a matching attribute type for the value. "+
+				"Invalid OverrideSelectorsValue Attribute Type",
+				"While creating a OverrideSelectorsValue value, an invalid attribute value was detected. "+
+					"A OverrideSelectorsValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("PolicyAssignmentsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("PolicyAssignmentsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("OverrideSelectorsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("OverrideSelectorsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -3175,182 +8676,87 @@ func NewPolicyAssignmentsValue(attributeTypes map[string]attr.Type, attributes m
 
 		if !ok {
 			diags.AddError(
-				"Extra PolicyAssignmentsValue Attribute Value",
-				"While creating a PolicyAssignmentsValue value, an extra attribute value was detected. "+
-					"A PolicyAssignmentsValue must not contain values beyond the expected attribute types. "+
+				"Extra OverrideSelectorsValue Attribute Value",
+				"While creating a OverrideSelectorsValue value, an extra attribute value was detected. "+
+					"A OverrideSelectorsValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra PolicyAssignmentsValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra OverrideSelectorsValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewPolicyAssignmentsValueUnknown(), diags
-	}
-
-	enforcementModeAttribute, ok := attributes["enforcement_mode"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`enforcement_mode is missing from object`)
-
-		return NewPolicyAssignmentsValueUnknown(), diags
-	}
-
-	enforcementModeVal, ok := enforcementModeAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`enforcement_mode expected to be basetypes.StringValue, was: %T`, enforcementModeAttribute))
-	}
-
-	identityAttribute, ok := attributes["identity"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`identity is missing from object`)
-
-		return NewPolicyAssignmentsValueUnknown(), diags
-	}
-
-	identityVal, ok := identityAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`identity expected to be basetypes.StringValue, was: %T`, identityAttribute))
-	}
-
-	identityIdsAttribute, ok := attributes["identity_ids"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`identity_ids is missing from object`)
-
-		return NewPolicyAssignmentsValueUnknown(), diags
-	}
-
-	identityIdsVal, ok := identityIdsAttribute.(basetypes.SetValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`identity_ids expected to be basetypes.SetValue, was: %T`, identityIdsAttribute))
-	}
-
-	nonComplianceMessagesAttribute, ok := attributes["non_compliance_messages"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`non_compliance_messages is missing from object`)
-
-		return NewPolicyAssignmentsValueUnknown(), diags
-	}
-
-	nonComplianceMessagesVal, ok := nonComplianceMessagesAttribute.(basetypes.SetValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`non_compliance_messages expected to be basetypes.SetValue, was: %T`, nonComplianceMessagesAttribute))
-	}
-
-	notScopesAttribute, ok := attributes["not_scopes"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`not_scopes is missing from object`)
-
-		return NewPolicyAssignmentsValueUnknown(), diags
-	}
-
-	notScopesVal, ok := notScopesAttribute.(basetypes.ListValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`not_scopes expected to be basetypes.ListValue, was: %T`, notScopesAttribute))
+		return NewOverrideSelectorsValueUnknown(), diags
 	}
 
-	overridesAttribute, ok := attributes["overrides"]
+	inAttribute, ok := attributes["in"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`overrides is missing from object`)
+			`in is missing from object`)
 
-		return NewPolicyAssignmentsValueUnknown(), diags
+		return NewOverrideSelectorsValueUnknown(), diags
 	}
 
-	overridesVal, ok := overridesAttribute.(basetypes.ListValue)
+	inVal, ok := inAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`overrides expected to be basetypes.ListValue, was: %T`, overridesAttribute))
+			fmt.Sprintf(`in expected to be basetypes.SetValue, was: %T`, inAttribute))
 	}
 
-	parametersAttribute, ok := attributes["parameters"]
+	kindAttribute, ok := attributes["kind"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`parameters is missing from object`)
+			`kind is missing from object`)
 
-		return NewPolicyAssignmentsValueUnknown(), diags
+		return NewOverrideSelectorsValueUnknown(), diags
 	}
 
-	parametersVal, ok := parametersAttribute.(basetypes.MapValue)
+	kindVal, ok := kindAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`parameters expected to be basetypes.MapValue, was: %T`, parametersAttribute))
+			fmt.Sprintf(`kind expected to be basetypes.StringValue, was: %T`, kindAttribute))
 	}
 
-	resourceSelectorsAttribute, ok := attributes["resource_selectors"]
+	notInAttribute, ok := attributes["not_in"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`resource_selectors is missing from object`)
+			`not_in is missing from object`)
 
-		return NewPolicyAssignmentsValueUnknown(), diags
+		return NewOverrideSelectorsValueUnknown(), diags
 	}
 
-	resourceSelectorsVal, ok := resourceSelectorsAttribute.(basetypes.ListValue)
+	notInVal, ok := notInAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`resource_selectors expected to be basetypes.ListValue, was: %T`, resourceSelectorsAttribute))
+			fmt.Sprintf(`not_in expected to be basetypes.SetValue, was: %T`, notInAttribute))
 	}
 
 	if diags.HasError() {
-		return NewPolicyAssignmentsValueUnknown(), diags
+		return NewOverrideSelectorsValueUnknown(), diags
 	}
 
-	return PolicyAssignmentsValue{
-		EnforcementMode:       enforcementModeVal,
-		Identity:              identityVal,
-		IdentityIds:           identityIdsVal,
-		NonComplianceMessages: nonComplianceMessagesVal,
-		NotScopes:             notScopesVal,
-		Overrides:             overridesVal,
-		Parameters:            parametersVal,
-		ResourceSelectors:     resourceSelectorsVal,
-		state:                 attr.ValueStateKnown,
+	return OverrideSelectorsValue{
+		In:    inVal,
+		Kind:  kindVal,
+		NotIn: notInVal,
+		state: attr.ValueStateKnown,
 	}, diags
 }
 
-func NewPolicyAssignmentsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) PolicyAssignmentsValue {
-	object, diags := NewPolicyAssignmentsValue(attributeTypes, attributes)
+func NewOverrideSelectorsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) OverrideSelectorsValue {
+	object, diags := NewOverrideSelectorsValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -3364,15 +8770,15 @@ func NewPolicyAssignmentsValueMust(attributeTypes map[string]attr.Type, attribut
 				diagnostic.Detail()))
 		}
 
-		panic("NewPolicyAssignmentsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewOverrideSelectorsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t PolicyAssignmentsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t OverrideSelectorsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewPolicyAssignmentsValueNull(), nil
+		return NewOverrideSelectorsValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -3380,11 +8786,11 @@ func (t PolicyAssignmentsType) ValueFromTerraform(ctx context.Context, in tftype
 	}
 
 	if !in.IsKnown() {
-		return NewPolicyAssignmentsValueUnknown(), nil
+		return NewOverrideSelectorsValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewPolicyAssignmentsValueNull(), nil
+		return NewOverrideSelectorsValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -3407,372 +8813,151 @@ func (t PolicyAssignmentsType) ValueFromTerraform(ctx context.Context, in tftype
 		attributes[k] = a
 	}
 
-	return NewPolicyAssignmentsValueMust(PolicyAssignmentsValue{}.AttributeTypes(ctx), attributes), nil
+	return NewOverrideSelectorsValueMust(OverrideSelectorsValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t PolicyAssignmentsType) ValueType(ctx context.Context) attr.Value {
-	return PolicyAssignmentsValue{}
+func (t OverrideSelectorsType) ValueType(ctx context.Context) attr.Value {
+	return OverrideSelectorsValue{}
 }
 
-var _ basetypes.ObjectValuable = PolicyAssignmentsValue{}
+var _ basetypes.ObjectValuable = OverrideSelectorsValue{}
 
-type PolicyAssignmentsValue struct {
-	EnforcementMode       basetypes.StringValue `tfsdk:"enforcement_mode"`
-	Identity              basetypes.StringValue `tfsdk:"identity"`
-	IdentityIds           basetypes.SetValue    `tfsdk:"identity_ids"`
-	NonComplianceMessages basetypes.SetValue    `tfsdk:"non_compliance_messages"`
-	NotScopes             basetypes.ListValue   `tfsdk:"not_scopes"`
-	Overrides             basetypes.ListValue   `tfsdk:"overrides"`
-	Parameters            basetypes.MapValue    `tfsdk:"parameters"`
-	ResourceSelectors     basetypes.ListValue   `tfsdk:"resource_selectors"`
-	state                 attr.ValueState
+type OverrideSelectorsValue struct {
+	In    basetypes.SetValue    `tfsdk:"in"`
+	Kind  basetypes.StringValue `tfsdk:"kind"`
+	NotIn basetypes.SetValue    `tfsdk:"not_in"`
+	state attr.ValueState
 }
 
-func (v PolicyAssignmentsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 8)
+func (v OverrideSelectorsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 3)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["enforcement_mode"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["identity"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["identity_ids"] = basetypes.SetType{
-		ElemType: types.StringType,
-	}.TerraformType(ctx)
-	attrTypes["non_compliance_messages"] = basetypes.SetType{
-		ElemType: NonComplianceMessagesValue{}.Type(ctx),
-	}.TerraformType(ctx)
-	attrTypes["not_scopes"] = basetypes.ListType{
+	attrTypes["in"] = basetypes.SetType{
 		ElemType: types.StringType,
 	}.TerraformType(ctx)
-	attrTypes["overrides"] = basetypes.ListType{
-		ElemType: OverridesValue{}.Type(ctx),
-	}.TerraformType(ctx)
-	attrTypes["parameters"] = basetypes.MapType{
+	attrTypes["kind"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["not_in"] = basetypes.SetType{
 		ElemType: types.StringType,
 	}.TerraformType(ctx)
-	attrTypes["resource_selectors"] = basetypes.ListType{
-		ElemType: ResourceSelectorsValue{}.Type(ctx),
-	}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 8)
-
-		val, err = v.EnforcementMode.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["enforcement_mode"] = val
-
-		val, err = v.Identity.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["identity"] = val
-
-		val, err = v.IdentityIds.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["identity_ids"] = val
-
-		val, err = v.NonComplianceMessages.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["non_compliance_messages"] = val
-
-		val, err = v.NotScopes.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["not_scopes"] = val
-
-		val, err = v.Overrides.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["overrides"] = val
+		vals := make(map[string]tftypes.Value, 3)
 
-		val, err = v.Parameters.ToTerraformValue(ctx)
+		val, err = v.In.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["parameters"] = val
+		vals["in"] = val
 
-		val, err = v.ResourceSelectors.ToTerraformValue(ctx)
+		val, err = v.Kind.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["resource_selectors"] = val
-
-		if err := tftypes.ValidateValue(objectType, vals); err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		return tftypes.NewValue(objectType, vals), nil
-	case attr.ValueStateNull:
-		return tftypes.NewValue(objectType, nil), nil
-	case attr.ValueStateUnknown:
-		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
-	default:
-		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
-	}
-}
-
-func (v PolicyAssignmentsValue) IsNull() bool {
-	return v.state == attr.ValueStateNull
-}
-
-func (v PolicyAssignmentsValue) IsUnknown() bool {
-	return v.state == attr.ValueStateUnknown
-}
-
-func (v PolicyAssignmentsValue) String() string {
-	return "PolicyAssignmentsValue"
-}
-
-func (v PolicyAssignmentsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	nonComplianceMessages := types.SetValueMust(
-		NonComplianceMessagesType{
-			basetypes.ObjectType{
-				AttrTypes: NonComplianceMessagesValue{}.AttributeTypes(ctx),
-			},
-		},
-		v.NonComplianceMessages.Elements(),
-	)
-
-	if v.NonComplianceMessages.IsNull() {
-		nonComplianceMessages = types.SetNull(
-			NonComplianceMessagesType{
-				basetypes.ObjectType{
-					AttrTypes: NonComplianceMessagesValue{}.AttributeTypes(ctx),
-				},
-			},
-		)
-	}
-
-	if v.NonComplianceMessages.IsUnknown() {
-		nonComplianceMessages = types.SetUnknown(
-			NonComplianceMessagesType{
-				basetypes.ObjectType{
-					AttrTypes: NonComplianceMessagesValue{}.AttributeTypes(ctx),
-				},
-			},
-		)
-	}
-
-	overrides := types.ListValueMust(
-		OverridesType{
-			basetypes.ObjectType{
-				AttrTypes: OverridesValue{}.AttributeTypes(ctx),
-			},
-		},
-		v.Overrides.Elements(),
-	)
-
-	if v.Overrides.IsNull() {
-		overrides = types.ListNull(
-			OverridesType{
-				basetypes.ObjectType{
-					AttrTypes: OverridesValue{}.AttributeTypes(ctx),
-				},
-			},
-		)
-	}
-
-	if v.Overrides.IsUnknown() {
-		overrides = types.ListUnknown(
-			OverridesType{
-				basetypes.ObjectType{
-					AttrTypes: OverridesValue{}.AttributeTypes(ctx),
-				},
-			},
-		)
-	}
-
-	resourceSelectors := types.ListValueMust(
-		ResourceSelectorsType{
-			basetypes.ObjectType{
-				AttrTypes: ResourceSelectorsValue{}.AttributeTypes(ctx),
-			},
-		},
-		v.ResourceSelectors.Elements(),
-	)
+		vals["kind"] = val
 
-	if v.ResourceSelectors.IsNull() {
-		resourceSelectors = types.ListNull(
-			ResourceSelectorsType{
-				basetypes.ObjectType{
-					AttrTypes: ResourceSelectorsValue{}.AttributeTypes(ctx),
-				},
-			},
-		)
-	}
+		val, err = v.NotIn.ToTerraformValue(ctx)
 
-	if v.ResourceSelectors.IsUnknown() {
-		resourceSelectors = types.ListUnknown(
-			ResourceSelectorsType{
-				basetypes.ObjectType{
-					AttrTypes: ResourceSelectorsValue{}.AttributeTypes(ctx),
-				},
-			},
-		)
-	}
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
 
-	var identityIdsVal basetypes.SetValue
-	switch {
-	case v.IdentityIds.IsUnknown():
-		identityIdsVal = types.SetUnknown(types.StringType)
-	case v.IdentityIds.IsNull():
-		identityIdsVal = types.SetNull(types.StringType)
+		vals["not_in"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
 	default:
-		var d diag.Diagnostics
-		identityIdsVal, d = types.SetValue(types.StringType, v.IdentityIds.Elements())
-		diags.Append(d...)
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
 	}
+}
 
-	if diags.HasError() {
-		return types.ObjectUnknown(map[string]attr.Type{
-			"enforcement_mode": basetypes.StringType{},
-			"identity":         basetypes.StringType{},
-			"identity_ids": basetypes.SetType{
-				ElemType: types.StringType,
-			},
-			"non_compliance_messages": basetypes.SetType{
-				ElemType: NonComplianceMessagesValue{}.Type(ctx),
-			},
-			"not_scopes": basetypes.ListType{
-				ElemType: types.StringType,
-			},
-			"overrides": basetypes.ListType{
-				ElemType: OverridesValue{}.Type(ctx),
-			},
-			"parameters": basetypes.MapType{
-				ElemType: types.StringType,
-			},
-			"resource_selectors": basetypes.ListType{
-				ElemType: ResourceSelectorsValue{}.Type(ctx),
-			},
-		}), diags
-	}
+func (v OverrideSelectorsValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
 
-	var notScopesVal basetypes.ListValue
+func (v OverrideSelectorsValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v OverrideSelectorsValue) String() string {
+	return "OverrideSelectorsValue"
+}
+
+func (v OverrideSelectorsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var inVal basetypes.SetValue
 	switch {
-	case v.NotScopes.IsUnknown():
-		notScopesVal = types.ListUnknown(types.StringType)
-	case v.NotScopes.IsNull():
-		notScopesVal = types.ListNull(types.StringType)
+	case v.In.IsUnknown():
+		inVal = types.SetUnknown(types.StringType)
+	case v.In.IsNull():
+		inVal = types.SetNull(types.StringType)
 	default:
 		var d diag.Diagnostics
-		notScopesVal, d = types.ListValue(types.StringType, v.NotScopes.Elements())
+		inVal, d = types.SetValue(types.StringType, v.In.Elements())
 		diags.Append(d...)
 	}
 
 	if diags.HasError() {
 		return types.ObjectUnknown(map[string]attr.Type{
-			"enforcement_mode": basetypes.StringType{},
-			"identity":         basetypes.StringType{},
-			"identity_ids": basetypes.SetType{
-				ElemType: types.StringType,
-			},
-			"non_compliance_messages": basetypes.SetType{
-				ElemType: NonComplianceMessagesValue{}.Type(ctx),
-			},
-			"not_scopes": basetypes.ListType{
+			"in": basetypes.SetType{
 				ElemType: types.StringType,
 			},
-			"overrides": basetypes.ListType{
-				ElemType: OverridesValue{}.Type(ctx),
-			},
-			"parameters": basetypes.MapType{
+			"kind": basetypes.StringType{},
+			"not_in": basetypes.SetType{
 				ElemType: types.StringType,
 			},
-			"resource_selectors": basetypes.ListType{
-				ElemType: ResourceSelectorsValue{}.Type(ctx),
-			},
 		}), diags
 	}
 
-	var parametersVal basetypes.MapValue
+	var notInVal basetypes.SetValue
 	switch {
-	case v.Parameters.IsUnknown():
-		parametersVal = types.MapUnknown(types.StringType)
-	case v.Parameters.IsNull():
-		parametersVal = types.MapNull(types.StringType)
+	case v.NotIn.IsUnknown():
+		notInVal = types.SetUnknown(types.StringType)
+	case v.NotIn.IsNull():
+		notInVal = types.SetNull(types.StringType)
 	default:
 		var d diag.Diagnostics
-		parametersVal, d = types.MapValue(types.StringType, v.Parameters.Elements())
+		notInVal, d = types.SetValue(types.StringType, v.NotIn.Elements())
 		diags.Append(d...)
 	}
 
 	if diags.HasError() {
 		return types.ObjectUnknown(map[string]attr.Type{
-			"enforcement_mode": basetypes.StringType{},
-			"identity":         basetypes.StringType{},
-			"identity_ids": basetypes.SetType{
-				ElemType: types.StringType,
-			},
-			"non_compliance_messages": basetypes.SetType{
-				ElemType: NonComplianceMessagesValue{}.Type(ctx),
-			},
-			"not_scopes": basetypes.ListType{
+			"in": basetypes.SetType{
 				ElemType: types.StringType,
 			},
-			"overrides": basetypes.ListType{
-				ElemType: OverridesValue{}.Type(ctx),
-			},
-			"parameters": basetypes.MapType{
+			"kind": basetypes.StringType{},
+			"not_in": basetypes.SetType{
 				ElemType: types.StringType,
 			},
-			"resource_selectors": basetypes.ListType{
-				ElemType: ResourceSelectorsValue{}.Type(ctx),
-			},
 		}), diags
 	}
 
 	attributeTypes := map[string]attr.Type{
-		"enforcement_mode": basetypes.StringType{},
-		"identity":         basetypes.StringType{},
-		"identity_ids": basetypes.SetType{
-			ElemType: types.StringType,
-		},
-		"non_compliance_messages": basetypes.SetType{
-			ElemType: NonComplianceMessagesValue{}.Type(ctx),
-		},
-		"not_scopes": basetypes.ListType{
+		"in": basetypes.SetType{
 			ElemType: types.StringType,
 		},
-		"overrides": basetypes.ListType{
-			ElemType: OverridesValue{}.Type(ctx),
-		},
-		"parameters": basetypes.MapType{
+		"kind": basetypes.StringType{},
+		"not_in": basetypes.SetType{
 			ElemType: types.StringType,
 		},
-		"resource_selectors": basetypes.ListType{
-			ElemType: ResourceSelectorsValue{}.Type(ctx),
-		},
 	}
 
 	if v.IsNull() {
@@ -3786,21 +8971,16 @@ func (v PolicyAssignmentsValue) ToObjectValue(ctx context.Context) (basetypes.Ob
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"enforcement_mode":        v.EnforcementMode,
-			"identity":                v.Identity,
-			"identity_ids":            identityIdsVal,
-			"non_compliance_messages": nonComplianceMessages,
-			"not_scopes":              notScopesVal,
-			"overrides":               overrides,
-			"parameters":              parametersVal,
-			"resource_selectors":      resourceSelectors,
+			"in":     inVal,
+			"kind":   v.Kind,
+			"not_in": notInVal,
 		})
 
 	return objVal, diags
 }
 
-func (v PolicyAssignmentsValue) Equal(o attr.Value) bool {
-	other, ok := o.(PolicyAssignmentsValue)
+func (v OverrideSelectorsValue) Equal(o attr.Value) bool {
+	other, ok := o.(OverrideSelectorsValue)
 
 	if !ok {
 		return false
@@ -3814,82 +8994,49 @@ func (v PolicyAssignmentsValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.EnforcementMode.Equal(other.EnforcementMode) {
-		return false
-	}
-
-	if !v.Identity.Equal(other.Identity) {
-		return false
-	}
-
-	if !v.IdentityIds.Equal(other.IdentityIds) {
-		return false
-	}
-
-	if !v.NonComplianceMessages.Equal(other.NonComplianceMessages) {
-		return false
-	}
-
-	if !v.NotScopes.Equal(other.NotScopes) {
-		return false
-	}
-
-	if !v.Overrides.Equal(other.Overrides) {
+	if !v.In.Equal(other.In) {
 		return false
 	}
 
-	if !v.Parameters.Equal(other.Parameters) {
+	if !v.Kind.Equal(other.Kind) {
 		return false
 	}
 
-	if !v.ResourceSelectors.Equal(other.ResourceSelectors) {
+	if !v.NotIn.Equal(other.NotIn) {
 		return false
 	}
 
 	return true
 }
 
-func (v PolicyAssignmentsValue) Type(ctx context.Context) attr.Type {
-	return PolicyAssignmentsType{
+func (v OverrideSelectorsValue) Type(ctx context.Context) attr.Type {
+	return OverrideSelectorsType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v PolicyAssignmentsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v OverrideSelectorsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"enforcement_mode": basetypes.StringType{},
-		"identity":         basetypes.StringType{},
-		"identity_ids": basetypes.SetType{
-			ElemType: types.StringType,
-		},
-		"non_compliance_messages": basetypes.SetType{
-			ElemType: NonComplianceMessagesValue{}.Type(ctx),
-		},
-		"not_scopes": basetypes.ListType{
+		"in": basetypes.SetType{
 			ElemType: types.StringType,
 		},
-		"overrides": basetypes.ListType{
-			ElemType: OverridesValue{}.Type(ctx),
-		},
-		"parameters": basetypes.MapType{
+		"kind": basetypes.StringType{},
+		"not_in": basetypes.SetType{
 			ElemType: types.StringType,
 		},
-		"resource_selectors": basetypes.ListType{
-			ElemType: ResourceSelectorsValue{}.Type(ctx),
-		},
 	}
 }
 
-var _ basetypes.ObjectTypable = NonComplianceMessagesType{}
+var _ basetypes.ObjectTypable = ResourceSelectorsType{}
 
-type NonComplianceMessagesType struct {
+type ResourceSelectorsType struct {
 	basetypes.ObjectType
 }
 
-func (t NonComplianceMessagesType) Equal(o attr.Type) bool {
-	other, ok := o.(NonComplianceMessagesType)
+func (t ResourceSelectorsType) Equal(o attr.Type) bool {
+	other, ok := o.(ResourceSelectorsType)
 
 	if !ok {
 		return false
@@ -3898,75 +9045,75 @@ func (t NonComplianceMessagesType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t NonComplianceMessagesType) String() string {
-	return "NonComplianceMessagesType"
+func (t ResourceSelectorsType) String() string {
+	return "ResourceSelectorsType"
 }
 
-func (t NonComplianceMessagesType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t ResourceSelectorsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	messageAttribute, ok := attributes["message"]
+	nameAttribute, ok := attributes["name"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`message is missing from object`)
+			`name is missing from object`)
 
 		return nil, diags
 	}
 
-	messageVal, ok := messageAttribute.(basetypes.StringValue)
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`message expected to be basetypes.StringValue, was: %T`, messageAttribute))
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
 	}
 
-	policyDefinitionReferenceIdAttribute, ok := attributes["policy_definition_reference_id"]
+	resourceSelectorSelectorsAttribute, ok := attributes["resource_selector_selectors"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`policy_definition_reference_id is missing from object`)
+			`resource_selector_selectors is missing from object`)
 
 		return nil, diags
 	}
 
-	policyDefinitionReferenceIdVal, ok := policyDefinitionReferenceIdAttribute.(basetypes.StringValue)
+	resourceSelectorSelectorsVal, ok := resourceSelectorSelectorsAttribute.(basetypes.ListValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`policy_definition_reference_id expected to be basetypes.StringValue, was: %T`, policyDefinitionReferenceIdAttribute))
+			fmt.Sprintf(`resource_selector_selectors expected to be basetypes.ListValue, was: %T`, resourceSelectorSelectorsAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return NonComplianceMessagesValue{
-		Message:                     messageVal,
-		PolicyDefinitionReferenceId: policyDefinitionReferenceIdVal,
-		state:                       attr.ValueStateKnown,
+	return ResourceSelectorsValue{
+		Name:                      nameVal,
+		ResourceSelectorSelectors: resourceSelectorSelectorsVal,
+		state:                     attr.ValueStateKnown,
 	}, diags
 }
 
-func NewNonComplianceMessagesValueNull() NonComplianceMessagesValue {
-	return NonComplianceMessagesValue{
+func NewResourceSelectorsValueNull() ResourceSelectorsValue {
+	return ResourceSelectorsValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewNonComplianceMessagesValueUnknown() NonComplianceMessagesValue {
-	return NonComplianceMessagesValue{
+func NewResourceSelectorsValueUnknown() ResourceSelectorsValue {
+	return ResourceSelectorsValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewNonComplianceMessagesValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (NonComplianceMessagesValue, diag.Diagnostics) {
+func NewResourceSelectorsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ResourceSelectorsValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -3977,11 +9124,11 @@ func NewNonComplianceMessagesValue(attributeTypes map[string]attr.Type, attribut
 
 		if !ok {
 			diags.AddError(
-				"Missing NonComplianceMessagesValue Attribute Value",
-				"While creating a NonComplianceMessagesValue value, a missing attribute value was detected. "+
-					"A NonComplianceMessagesValue must contain values for all attributes, even if null or unknown. "+
+				"Missing ResourceSelectorsValue Attribute Value",
+				"While creating a ResourceSelectorsValue value, a missing attribute value was detected. "+
+					"A ResourceSelectorsValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("NonComplianceMessagesValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("ResourceSelectorsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -3989,12 +9136,12 @@ func NewNonComplianceMessagesValue(attributeTypes map[string]attr.Type, attribut
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid NonComplianceMessagesValue Attribute Type",
-				"While creating a NonComplianceMessagesValue value, an invalid attribute value was detected. "+
-					"A NonComplianceMessagesValue must use a matching attribute type for the value. "+
+				"Invalid ResourceSelectorsValue Attribute Type",
+				"While creating a ResourceSelectorsValue value, an invalid attribute value was detected. "+
+					"A ResourceSelectorsValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("NonComplianceMessagesValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("NonComplianceMessagesValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("ResourceSelectorsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("ResourceSelectorsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -4004,68 +9151,68 @@ func NewNonComplianceMessagesValue(attributeTypes map[string]attr.Type, attribut
 
 		if !ok {
 			diags.AddError(
-				"Extra NonComplianceMessagesValue Attribute Value",
-				"While creating a NonComplianceMessagesValue value, an extra attribute value was detected. "+
-					"A NonComplianceMessagesValue must not contain values beyond the expected attribute types. "+
+				"Extra ResourceSelectorsValue Attribute Value",
+				"While creating a ResourceSelectorsValue value, an extra attribute value was detected. "+
+					"A ResourceSelectorsValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra NonComplianceMessagesValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra ResourceSelectorsValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewNonComplianceMessagesValueUnknown(), diags
+		return NewResourceSelectorsValueUnknown(), diags
 	}
 
-	messageAttribute, ok := attributes["message"]
+	nameAttribute, ok := attributes["name"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`message is missing from object`)
+			`name is missing from object`)
 
-		return NewNonComplianceMessagesValueUnknown(), diags
+		return NewResourceSelectorsValueUnknown(), diags
 	}
 
-	messageVal, ok := messageAttribute.(basetypes.StringValue)
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`message expected to be basetypes.StringValue, was: %T`, messageAttribute))
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
 	}
 
-	policyDefinitionReferenceIdAttribute, ok := attributes["policy_definition_reference_id"]
+	resourceSelectorSelectorsAttribute, ok := attributes["resource_selector_selectors"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`policy_definition_reference_id is missing from object`)
+			`resource_selector_selectors is missing from object`)
 
-		return NewNonComplianceMessagesValueUnknown(), diags
+		return NewResourceSelectorsValueUnknown(), diags
 	}
 
-	policyDefinitionReferenceIdVal, ok := policyDefinitionReferenceIdAttribute.(basetypes.StringValue)
+	resourceSelectorSelectorsVal, ok := resourceSelectorSelectorsAttribute.(basetypes.ListValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`policy_definition_reference_id expected to be basetypes.StringValue, was: %T`, policyDefinitionReferenceIdAttribute))
+			fmt.Sprintf(`resource_selector_selectors expected to be basetypes.ListValue, was: %T`, resourceSelectorSelectorsAttribute))
 	}
 
 	if diags.HasError() {
-		return NewNonComplianceMessagesValueUnknown(), diags
+		return NewResourceSelectorsValueUnknown(), diags
 	}
 
-	return NonComplianceMessagesValue{
-		Message:                     messageVal,
-		PolicyDefinitionReferenceId: policyDefinitionReferenceIdVal,
-		state:                       attr.ValueStateKnown,
+	return ResourceSelectorsValue{
+		Name:                      nameVal,
+		ResourceSelectorSelectors: resourceSelectorSelectorsVal,
+		state:                     attr.ValueStateKnown,
 	}, diags
 }
 
-func NewNonComplianceMessagesValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) NonComplianceMessagesValue {
-	object, diags := NewNonComplianceMessagesValue(attributeTypes, attributes)
+func NewResourceSelectorsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ResourceSelectorsValue {
+	object, diags := NewResourceSelectorsValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -4079,15 +9226,15 @@ func NewNonComplianceMessagesValueMust(attributeTypes map[string]attr.Type, attr
 				diagnostic.Detail()))
 		}
 
-		panic("NewNonComplianceMessagesValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewResourceSelectorsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t NonComplianceMessagesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t ResourceSelectorsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewNonComplianceMessagesValueNull(), nil
+		return NewResourceSelectorsValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -4095,11 +9242,11 @@ func (t NonComplianceMessagesType) ValueFromTerraform(ctx context.Context, in tf
 	}
 
 	if !in.IsKnown() {
-		return NewNonComplianceMessagesValueUnknown(), nil
+		return NewResourceSelectorsValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewNonComplianceMessagesValueNull(), nil
+		return NewResourceSelectorsValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -4122,29 +9269,31 @@ func (t NonComplianceMessagesType) ValueFromTerraform(ctx context.Context, in tf
 		attributes[k] = a
 	}
 
-	return NewNonComplianceMessagesValueMust(NonComplianceMessagesValue{}.AttributeTypes(ctx), attributes), nil
+	return NewResourceSelectorsValueMust(ResourceSelectorsValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t NonComplianceMessagesType) ValueType(ctx context.Context) attr.Value {
-	return NonComplianceMessagesValue{}
+func (t ResourceSelectorsType) ValueType(ctx context.Context) attr.Value {
+	return ResourceSelectorsValue{}
 }
 
-var _ basetypes.ObjectValuable = NonComplianceMessagesValue{}
+var _ basetypes.ObjectValuable = ResourceSelectorsValue{}
 
-type NonComplianceMessagesValue struct {
-	Message                     basetypes.StringValue `tfsdk:"message"`
-	PolicyDefinitionReferenceId basetypes.StringValue `tfsdk:"policy_definition_reference_id"`
-	state                       attr.ValueState
+type ResourceSelectorsValue struct {
+	Name                      basetypes.StringValue `tfsdk:"name"`
+	ResourceSelectorSelectors basetypes.ListValue   `tfsdk:"resource_selector_selectors"`
+	state                     attr.ValueState
 }
 
-func (v NonComplianceMessagesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+func (v ResourceSelectorsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 	attrTypes := make(map[string]tftypes.Type, 2)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["message"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["policy_definition_reference_id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["resource_selector_selectors"] = basetypes.ListType{
+		ElemType: ResourceSelectorSelectorsValue{}.Type(ctx),
+	}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
@@ -4152,21 +9301,21 @@ func (v NonComplianceMessagesValue) ToTerraformValue(ctx context.Context) (tftyp
 	case attr.ValueStateKnown:
 		vals := make(map[string]tftypes.Value, 2)
 
-		val, err = v.Message.ToTerraformValue(ctx)
+		val, err = v.Name.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["message"] = val
+		vals["name"] = val
 
-		val, err = v.PolicyDefinitionReferenceId.ToTerraformValue(ctx)
+		val, err = v.ResourceSelectorSelectors.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["policy_definition_reference_id"] = val
+		vals["resource_selector_selectors"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -4182,24 +9331,55 @@ func (v NonComplianceMessagesValue) ToTerraformValue(ctx context.Context) (tftyp
 	}
 }
 
-func (v NonComplianceMessagesValue) IsNull() bool {
+func (v ResourceSelectorsValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v NonComplianceMessagesValue) IsUnknown() bool {
+func (v ResourceSelectorsValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v NonComplianceMessagesValue) String() string {
-	return "NonComplianceMessagesValue"
+func (v ResourceSelectorsValue) String() string {
+	return "ResourceSelectorsValue"
 }
 
-func (v NonComplianceMessagesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v ResourceSelectorsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	resourceSelectorSelectors := types.ListValueMust(
+		ResourceSelectorSelectorsType{
+			basetypes.ObjectType{
+				AttrTypes: ResourceSelectorSelectorsValue{}.AttributeTypes(ctx),
+			},
+		},
+		v.ResourceSelectorSelectors.Elements(),
+	)
+
+	if v.ResourceSelectorSelectors.IsNull() {
+		resourceSelectorSelectors = types.ListNull(
+			ResourceSelectorSelectorsType{
+				basetypes.ObjectType{
+					AttrTypes: ResourceSelectorSelectorsValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	if v.ResourceSelectorSelectors.IsUnknown() {
+		resourceSelectorSelectors = types.ListUnknown(
+			ResourceSelectorSelectorsType{
+				basetypes.ObjectType{
+					AttrTypes: ResourceSelectorSelectorsValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
 	attributeTypes := map[string]attr.Type{
-		"message":                        basetypes.StringType{},
-		"policy_definition_reference_id": basetypes.StringType{},
+		"name": basetypes.StringType{},
+		"resource_selector_selectors": basetypes.ListType{
+			ElemType: ResourceSelectorSelectorsValue{}.Type(ctx),
+		},
 	}
 
 	if v.IsNull() {
@@ -4213,15 +9393,15 @@ func (v NonComplianceMessagesValue) ToObjectValue(ctx context.Context) (basetype
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"message":                        v.Message,
-			"policy_definition_reference_id": v.PolicyDefinitionReferenceId,
+			"name":                        v.Name,
+			"resource_selector_selectors": resourceSelectorSelectors,
 		})
 
 	return objVal, diags
 }
 
-func (v NonComplianceMessagesValue) Equal(o attr.Value) bool {
-	other, ok := o.(NonComplianceMessagesValue)
+func (v ResourceSelectorsValue) Equal(o attr.Value) bool {
+	other, ok := o.(ResourceSelectorsValue)
 
 	if !ok {
 		return false
@@ -4235,40 +9415,42 @@ func (v NonComplianceMessagesValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.Message.Equal(other.Message) {
+	if !v.Name.Equal(other.Name) {
 		return false
 	}
 
-	if !v.PolicyDefinitionReferenceId.Equal(other.PolicyDefinitionReferenceId) {
+	if !v.ResourceSelectorSelectors.Equal(other.ResourceSelectorSelectors) {
 		return false
 	}
 
 	return true
 }
 
-func (v NonComplianceMessagesValue) Type(ctx context.Context) attr.Type {
-	return NonComplianceMessagesType{
+func (v ResourceSelectorsValue) Type(ctx context.Context) attr.Type {
+	return ResourceSelectorsType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v NonComplianceMessagesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v ResourceSelectorsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"message":                        basetypes.StringType{},
-		"policy_definition_reference_id": basetypes.StringType{},
+		"name": basetypes.StringType{},
+		"resource_selector_selectors": basetypes.ListType{
+			ElemType: ResourceSelectorSelectorsValue{}.Type(ctx),
+		},
 	}
 }
 
-var _ basetypes.ObjectTypable = OverridesType{}
+var _ basetypes.ObjectTypable = ResourceSelectorSelectorsType{}
 
-type OverridesType struct {
+type ResourceSelectorSelectorsType struct {
 	basetypes.ObjectType
 }
 
-func (t OverridesType) Equal(o attr.Type) bool {
-	other, ok := o.(OverridesType)
+func (t ResourceSelectorSelectorsType) Equal(o attr.Type) bool {
+	other, ok := o.(ResourceSelectorSelectorsType)
 
 	if !ok {
 		return false
@@ -4277,94 +9459,94 @@ func (t OverridesType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t OverridesType) String() string {
-	return "OverridesType"
+func (t ResourceSelectorSelectorsType) String() string {
+	return "ResourceSelectorSelectorsType"
 }
 
-func (t OverridesType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t ResourceSelectorSelectorsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	kindAttribute, ok := attributes["kind"]
+	inAttribute, ok := attributes["in"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`kind is missing from object`)
+			`in is missing from object`)
 
 		return nil, diags
 	}
 
-	kindVal, ok := kindAttribute.(basetypes.StringValue)
+	inVal, ok := inAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`kind expected to be basetypes.StringValue, was: %T`, kindAttribute))
+			fmt.Sprintf(`in expected to be basetypes.SetValue, was: %T`, inAttribute))
 	}
 
-	overrideSelectorsAttribute, ok := attributes["override_selectors"]
+	kindAttribute, ok := attributes["kind"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`override_selectors is missing from object`)
+			`kind is missing from object`)
 
 		return nil, diags
 	}
 
-	overrideSelectorsVal, ok := overrideSelectorsAttribute.(basetypes.ListValue)
+	kindVal, ok := kindAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`override_selectors expected to be basetypes.ListValue, was: %T`, overrideSelectorsAttribute))
+			fmt.Sprintf(`kind expected to be basetypes.StringValue, was: %T`, kindAttribute))
 	}
 
-	valueAttribute, ok := attributes["value"]
+	notInAttribute, ok := attributes["not_in"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`value is missing from object`)
+			`not_in is missing from object`)
 
 		return nil, diags
 	}
 
-	valueVal, ok := valueAttribute.(basetypes.StringValue)
+	notInVal, ok := notInAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`value expected to be basetypes.StringValue, was: %T`, valueAttribute))
+			fmt.Sprintf(`not_in expected to be basetypes.SetValue, was: %T`, notInAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return OverridesValue{
-		Kind:              kindVal,
-		OverrideSelectors: overrideSelectorsVal,
-		Value:             valueVal,
-		state:             attr.ValueStateKnown,
+	return ResourceSelectorSelectorsValue{
+		In:    inVal,
+		Kind:  kindVal,
+		NotIn: notInVal,
+		state: attr.ValueStateKnown,
 	}, diags
 }
 
-func NewOverridesValueNull() OverridesValue {
-	return OverridesValue{
+func NewResourceSelectorSelectorsValueNull() ResourceSelectorSelectorsValue {
+	return ResourceSelectorSelectorsValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewOverridesValueUnknown() OverridesValue {
-	return OverridesValue{
+func NewResourceSelectorSelectorsValueUnknown() ResourceSelectorSelectorsValue {
+	return ResourceSelectorSelectorsValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewOverridesValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (OverridesValue, diag.Diagnostics) {
+func NewResourceSelectorSelectorsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ResourceSelectorSelectorsValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -4374,12 +9556,12 @@ func NewOverridesValue(attributeTypes map[string]attr.Type, attributes map[strin
 		attribute, ok := attributes[name]
 
 		if !ok {
-			diags.AddError(
-				"Missing OverridesValue Attribute Value",
-				"While creating a OverridesValue value, a missing attribute value was detected. "+
-					"A OverridesValue must contain values for all attributes, even if null or unknown. "+
+			diags.AddError(
+				"Missing ResourceSelectorSelectorsValue Attribute Value",
+				"While creating a ResourceSelectorSelectorsValue value, a missing attribute value was detected. "+
+					"A ResourceSelectorSelectorsValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("OverridesValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("ResourceSelectorSelectorsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -4387,12 +9569,12 @@ func NewOverridesValue(attributeTypes map[string]attr.Type, attributes map[strin
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid OverridesValue Attribute Type",
-				"While creating a OverridesValue value, an invalid attribute value was detected. "+
-					"A OverridesValue must use a matching attribute type for the value. "+
+				"Invalid ResourceSelectorSelectorsValue Attribute Type",
+				"While creating a ResourceSelectorSelectorsValue value, an invalid attribute value was detected. "+
+					"A ResourceSelectorSelectorsValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("OverridesValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("OverridesValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("ResourceSelectorSelectorsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("ResourceSelectorSelectorsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -4402,87 +9584,87 @@ func NewOverridesValue(attributeTypes map[string]attr.Type, attributes map[strin
 
 		if !ok {
 			diags.AddError(
-				"Extra OverridesValue Attribute Value",
-				"While creating a OverridesValue value, an extra attribute value was detected. "+
-					"A OverridesValue must not contain values beyond the expected attribute types. "+
+				"Extra ResourceSelectorSelectorsValue Attribute Value",
+				"While creating a ResourceSelectorSelectorsValue value, an extra attribute value was detected. "+
+					"A ResourceSelectorSelectorsValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra OverridesValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra ResourceSelectorSelectorsValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewOverridesValueUnknown(), diags
+		return NewResourceSelectorSelectorsValueUnknown(), diags
 	}
 
-	kindAttribute, ok := attributes["kind"]
+	inAttribute, ok := attributes["in"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`kind is missing from object`)
+			`in is missing from object`)
 
-		return NewOverridesValueUnknown(), diags
+		return NewResourceSelectorSelectorsValueUnknown(), diags
 	}
 
-	kindVal, ok := kindAttribute.(basetypes.StringValue)
+	inVal, ok := inAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`kind expected to be basetypes.StringValue, was: %T`, kindAttribute))
+			fmt.Sprintf(`in expected to be basetypes.SetValue, was: %T`, inAttribute))
 	}
 
-	overrideSelectorsAttribute, ok := attributes["override_selectors"]
+	kindAttribute, ok := attributes["kind"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`override_selectors is missing from object`)
+			`kind is missing from object`)
 
-		return NewOverridesValueUnknown(), diags
+		return NewResourceSelectorSelectorsValueUnknown(), diags
 	}
 
-	overrideSelectorsVal, ok := overrideSelectorsAttribute.(basetypes.ListValue)
+	kindVal, ok := kindAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`override_selectors expected to be basetypes.ListValue, was: %T`, overrideSelectorsAttribute))
+			fmt.Sprintf(`kind expected to be basetypes.StringValue, was: %T`, kindAttribute))
 	}
 
-	valueAttribute, ok := attributes["value"]
+	notInAttribute, ok := attributes["not_in"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`value is missing from object`)
+			`not_in is missing from object`)
 
-		return NewOverridesValueUnknown(), diags
+		return NewResourceSelectorSelectorsValueUnknown(), diags
 	}
 
-	valueVal, ok := valueAttribute.(basetypes.StringValue)
+	notInVal, ok := notInAttribute.(basetypes.SetValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`value expected to be basetypes.StringValue, was: %T`, valueAttribute))
+			fmt.Sprintf(`not_in expected to be basetypes.SetValue, was: %T`, notInAttribute))
 	}
 
 	if diags.HasError() {
-		return NewOverridesValueUnknown(), diags
+		return NewResourceSelectorSelectorsValueUnknown(), diags
 	}
 
-	return OverridesValue{
-		Kind:              kindVal,
-		OverrideSelectors: overrideSelectorsVal,
-		Value:             valueVal,
-		state:             attr.ValueStateKnown,
+	return ResourceSelectorSelectorsValue{
+		In:    inVal,
+		Kind:  kindVal,
+		NotIn: notInVal,
+		state: attr.ValueStateKnown,
 	}, diags
 }
 
-func NewOverridesValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) OverridesValue {
-	object, diags := NewOverridesValue(attributeTypes, attributes)
+func NewResourceSelectorSelectorsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ResourceSelectorSelectorsValue {
+	object, diags := NewResourceSelectorSelectorsValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -4496,15 +9678,15 @@ func NewOverridesValueMust(attributeTypes map[string]attr.Type, attributes map[s
 				diagnostic.Detail()))
 		}
 
-		panic("NewOverridesValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewResourceSelectorSelectorsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t OverridesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t ResourceSelectorSelectorsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewOverridesValueNull(), nil
+		return NewResourceSelectorSelectorsValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -4512,11 +9694,11 @@ func (t OverridesType) ValueFromTerraform(ctx context.Context, in tftypes.Value)
 	}
 
 	if !in.IsKnown() {
-		return NewOverridesValueUnknown(), nil
+		return NewResourceSelectorSelectorsValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewOverridesValueNull(), nil
+		return NewResourceSelectorSelectorsValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -4539,33 +9721,35 @@ func (t OverridesType) ValueFromTerraform(ctx context.Context, in tftypes.Value)
 		attributes[k] = a
 	}
 
-	return NewOverridesValueMust(OverridesValue{}.AttributeTypes(ctx), attributes), nil
+	return NewResourceSelectorSelectorsValueMust(ResourceSelectorSelectorsValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t OverridesType) ValueType(ctx context.Context) attr.Value {
-	return OverridesValue{}
+func (t ResourceSelectorSelectorsType) ValueType(ctx context.Context) attr.Value {
+	return ResourceSelectorSelectorsValue{}
 }
 
-var _ basetypes.ObjectValuable = OverridesValue{}
+var _ basetypes.ObjectValuable = ResourceSelectorSelectorsValue{}
 
-type OverridesValue struct {
-	Kind              basetypes.StringValue `tfsdk:"kind"`
-	OverrideSelectors basetypes.ListValue   `tfsdk:"override_selectors"`
-	Value             basetypes.StringValue `tfsdk:"value"`
-	state             attr.ValueState
+type ResourceSelectorSelectorsValue struct {
+	In    basetypes.SetValue    `tfsdk:"in"`
+	Kind  basetypes.StringValue `tfsdk:"kind"`
+	NotIn basetypes.SetValue    `tfsdk:"not_in"`
+	state attr.ValueState
 }
 
-func (v OverridesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+func (v ResourceSelectorSelectorsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 	attrTypes := make(map[string]tftypes.Type, 3)
 
 	var val tftypes.Value
 	var err error
 
+	attrTypes["in"] = basetypes.SetType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
 	attrTypes["kind"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["override_selectors"] = basetypes.ListType{
-		ElemType: OverrideSelectorsValue{}.Type(ctx),
+	attrTypes["not_in"] = basetypes.SetType{
+		ElemType: types.StringType,
 	}.TerraformType(ctx)
-	attrTypes["value"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
@@ -4573,29 +9757,29 @@ func (v OverridesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, er
 	case attr.ValueStateKnown:
 		vals := make(map[string]tftypes.Value, 3)
 
-		val, err = v.Kind.ToTerraformValue(ctx)
+		val, err = v.In.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["kind"] = val
+		vals["in"] = val
 
-		val, err = v.OverrideSelectors.ToTerraformValue(ctx)
+		val, err = v.Kind.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["override_selectors"] = val
+		vals["kind"] = val
 
-		val, err = v.Value.ToTerraformValue(ctx)
+		val, err = v.NotIn.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["value"] = val
+		vals["not_in"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -4611,56 +9795,77 @@ func (v OverridesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, er
 	}
 }
 
-func (v OverridesValue) IsNull() bool {
+func (v ResourceSelectorSelectorsValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v OverridesValue) IsUnknown() bool {
+func (v ResourceSelectorSelectorsValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v OverridesValue) String() string {
-	return "OverridesValue"
+func (v ResourceSelectorSelectorsValue) String() string {
+	return "ResourceSelectorSelectorsValue"
 }
 
-func (v OverridesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v ResourceSelectorSelectorsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	overrideSelectors := types.ListValueMust(
-		OverrideSelectorsType{
-			basetypes.ObjectType{
-				AttrTypes: OverrideSelectorsValue{}.AttributeTypes(ctx),
-			},
-		},
-		v.OverrideSelectors.Elements(),
-	)
+	var inVal basetypes.SetValue
+	switch {
+	case v.In.IsUnknown():
+		inVal = types.SetUnknown(types.StringType)
+	case v.In.IsNull():
+		inVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		inVal, d = types.SetValue(types.StringType, v.In.Elements())
+		diags.Append(d...)
+	}
 
-	if v.OverrideSelectors.IsNull() {
-		overrideSelectors = types.ListNull(
-			OverrideSelectorsType{
-				basetypes.ObjectType{
-					AttrTypes: OverrideSelectorsValue{}.AttributeTypes(ctx),
-				},
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"in": basetypes.SetType{
+				ElemType: types.StringType,
 			},
-		)
+			"kind": basetypes.StringType{},
+			"not_in": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+		}), diags
 	}
 
-	if v.OverrideSelectors.IsUnknown() {
-		overrideSelectors = types.ListUnknown(
-			OverrideSelectorsType{
-				basetypes.ObjectType{
-					AttrTypes: OverrideSelectorsValue{}.AttributeTypes(ctx),
-				},
+	var notInVal basetypes.SetValue
+	switch {
+	case v.NotIn.IsUnknown():
+		notInVal = types.SetUnknown(types.StringType)
+	case v.NotIn.IsNull():
+		notInVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		notInVal, d = types.SetValue(types.StringType, v.NotIn.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"in": basetypes.SetType{
+				ElemType: types.StringType,
 			},
-		)
+			"kind": basetypes.StringType{},
+			"not_in": basetypes.SetType{
+				ElemType: types.StringType,
+			},
+		}), diags
 	}
 
 	attributeTypes := map[string]attr.Type{
+		"in": basetypes.SetType{
+			ElemType: types.StringType,
+		},
 		"kind": basetypes.StringType{},
-		"override_selectors": basetypes.ListType{
-			ElemType: OverrideSelectorsValue{}.Type(ctx),
+		"not_in": basetypes.SetType{
+			ElemType: types.StringType,
 		},
-		"value": basetypes.StringType{},
 	}
 
 	if v.IsNull() {
@@ -4674,16 +9879,16 @@ func (v OverridesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValu
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"kind":               v.Kind,
-			"override_selectors": overrideSelectors,
-			"value":              v.Value,
+			"in":     inVal,
+			"kind":   v.Kind,
+			"not_in": notInVal,
 		})
 
 	return objVal, diags
 }
 
-func (v OverridesValue) Equal(o attr.Value) bool {
-	other, ok := o.(OverridesValue)
+func (v ResourceSelectorSelectorsValue) Equal(o attr.Value) bool {
+	other, ok := o.(ResourceSelectorSelectorsValue)
 
 	if !ok {
 		return false
@@ -4697,47 +9902,49 @@ func (v OverridesValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.Kind.Equal(other.Kind) {
+	if !v.In.Equal(other.In) {
 		return false
 	}
 
-	if !v.OverrideSelectors.Equal(other.OverrideSelectors) {
+	if !v.Kind.Equal(other.Kind) {
 		return false
 	}
 
-	if !v.Value.Equal(other.Value) {
+	if !v.NotIn.Equal(other.NotIn) {
 		return false
 	}
 
 	return true
 }
 
-func (v OverridesValue) Type(ctx context.Context) attr.Type {
-	return OverridesType{
+func (v ResourceSelectorSelectorsValue) Type(ctx context.Context) attr.Type {
+	return ResourceSelectorSelectorsType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v OverridesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v ResourceSelectorSelectorsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
+		"in": basetypes.SetType{
+			ElemType: types.StringType,
+		},
 		"kind": basetypes.StringType{},
-		"override_selectors": basetypes.ListType{
-			ElemType: OverrideSelectorsValue{}.Type(ctx),
+		"not_in": basetypes.SetType{
+			ElemType: types.StringType,
 		},
-		"value": basetypes.StringType{},
 	}
 }
 
-var _ basetypes.ObjectTypable = OverrideSelectorsType{}
+var _ basetypes.ObjectTypable = PolicyDefinitionChecksType{}
 
-type OverrideSelectorsType struct {
+type PolicyDefinitionChecksType struct {
 	basetypes.ObjectType
 }
 
-func (t OverrideSelectorsType) Equal(o attr.Type) bool {
-	other, ok := o.(OverrideSelectorsType)
+func (t PolicyDefinitionChecksType) Equal(o attr.Type) bool {
+	other, ok := o.(PolicyDefinitionChecksType)
 
 	if !ok {
 		return false
@@ -4746,94 +9953,75 @@ func (t OverrideSelectorsType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t OverrideSelectorsType) String() string {
-	return "OverrideSelectorsType"
-}
-
-func (t OverrideSelectorsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	attributes := in.Attributes()
-
-	inAttribute, ok := attributes["in"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`in is missing from object`)
-
-		return nil, diags
-	}
-
-	inVal, ok := inAttribute.(basetypes.SetValue)
+func (t PolicyDefinitionChecksType) String() string {
+	return "PolicyDefinitionChecksType"
+}
 
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`in expected to be basetypes.SetValue, was: %T`, inAttribute))
-	}
+func (t PolicyDefinitionChecksType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-	kindAttribute, ok := attributes["kind"]
+	attributes := in.Attributes()
+
+	deprecatedAttribute, ok := attributes["deprecated"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`kind is missing from object`)
+			`deprecated is missing from object`)
 
 		return nil, diags
 	}
 
-	kindVal, ok := kindAttribute.(basetypes.StringValue)
+	deprecatedVal, ok := deprecatedAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`kind expected to be basetypes.StringValue, was: %T`, kindAttribute))
+			fmt.Sprintf(`deprecated expected to be basetypes.StringValue, was: %T`, deprecatedAttribute))
 	}
 
-	notInAttribute, ok := attributes["not_in"]
+	previewAttribute, ok := attributes["preview"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`not_in is missing from object`)
+			`preview is missing from object`)
 
 		return nil, diags
 	}
 
-	notInVal, ok := notInAttribute.(basetypes.SetValue)
+	previewVal, ok := previewAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`not_in expected to be basetypes.SetValue, was: %T`, notInAttribute))
+			fmt.Sprintf(`preview expected to be basetypes.StringValue, was: %T`, previewAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return OverrideSelectorsValue{
-		In:    inVal,
-		Kind:  kindVal,
-		NotIn: notInVal,
-		state: attr.ValueStateKnown,
+	return PolicyDefinitionChecksValue{
+		Deprecated: deprecatedVal,
+		Preview:    previewVal,
+		state:      attr.ValueStateKnown,
 	}, diags
 }
 
-func NewOverrideSelectorsValueNull() OverrideSelectorsValue {
-	return OverrideSelectorsValue{
+func NewPolicyDefinitionChecksValueNull() PolicyDefinitionChecksValue {
+	return PolicyDefinitionChecksValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewOverrideSelectorsValueUnknown() OverrideSelectorsValue {
-	return OverrideSelectorsValue{
+func NewPolicyDefinitionChecksValueUnknown() PolicyDefinitionChecksValue {
+	return PolicyDefinitionChecksValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewOverrideSelectorsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (OverrideSelectorsValue, diag.Diagnostics) {
+func NewPolicyDefinitionChecksValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (PolicyDefinitionChecksValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -4844,11 +10032,11 @@ func NewOverrideSelectorsValue(attributeTypes map[string]attr.Type, attributes m
 
 		if !ok {
 			diags.AddError(
-				"Missing OverrideSelectorsValue Attribute Value",
-				"While creating a OverrideSelectorsValue value, a missing attribute value was detected. "+
-					"A OverrideSelectorsValue must contain values for all attributes, even if null or unknown. "+
+				"Missing PolicyDefinitionChecksValue Attribute Value",
+				"While creating a PolicyDefinitionChecksValue value, a missing attribute value was detected. "+
+					"A PolicyDefinitionChecksValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("OverrideSelectorsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("PolicyDefinitionChecksValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -4856,12 +10044,12 @@ func NewOverrideSelectorsValue(attributeTypes map[string]attr.Type, attributes m
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid OverrideSelectorsValue Attribute Type",
-				"While creating a OverrideSelectorsValue value, an invalid attribute value was detected. "+
-					"A OverrideSelectorsValue must use a matching attribute type for the value. "+
+				"Invalid PolicyDefinitionChecksValue Attribute Type",
+				"While creating a PolicyDefinitionChecksValue value, an invalid attribute value was detected. "+
+					"A PolicyDefinitionChecksValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("OverrideSelectorsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("OverrideSelectorsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("PolicyDefinitionChecksValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("PolicyDefinitionChecksValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -4871,87 +10059,68 @@ func NewOverrideSelectorsValue(attributeTypes map[string]attr.Type, attributes m
 
 		if !ok {
 			diags.AddError(
-				"Extra OverrideSelectorsValue Attribute Value",
-				"While creating a OverrideSelectorsValue value, an extra attribute value was detected. "+
-					"A OverrideSelectorsValue must not contain values beyond the expected attribute types. "+
+				"Extra PolicyDefinitionChecksValue Attribute Value",
+				"While creating a PolicyDefinitionChecksValue value, an extra attribute value was detected. "+
+					"A PolicyDefinitionChecksValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra OverrideSelectorsValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra PolicyDefinitionChecksValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewOverrideSelectorsValueUnknown(), diags
-	}
-
-	inAttribute, ok := attributes["in"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`in is missing from object`)
-
-		return NewOverrideSelectorsValueUnknown(), diags
-	}
-
-	inVal, ok := inAttribute.(basetypes.SetValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`in expected to be basetypes.SetValue, was: %T`, inAttribute))
+		return NewPolicyDefinitionChecksValueUnknown(), diags
 	}
 
-	kindAttribute, ok := attributes["kind"]
+	deprecatedAttribute, ok := attributes["deprecated"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`kind is missing from object`)
+			`deprecated is missing from object`)
 
-		return NewOverrideSelectorsValueUnknown(), diags
+		return NewPolicyDefinitionChecksValueUnknown(), diags
 	}
 
-	kindVal, ok := kindAttribute.(basetypes.StringValue)
+	deprecatedVal, ok := deprecatedAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`kind expected to be basetypes.StringValue, was: %T`, kindAttribute))
+			fmt.Sprintf(`deprecated expected to be basetypes.StringValue, was: %T`, deprecatedAttribute))
 	}
 
-	notInAttribute, ok := attributes["not_in"]
+	previewAttribute, ok := attributes["preview"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`not_in is missing from object`)
+			`preview is missing from object`)
 
-		return NewOverrideSelectorsValueUnknown(), diags
+		return NewPolicyDefinitionChecksValueUnknown(), diags
 	}
 
-	notInVal, ok := notInAttribute.(basetypes.SetValue)
+	previewVal, ok := previewAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`not_in expected to be basetypes.SetValue, was: %T`, notInAttribute))
+			fmt.Sprintf(`preview expected to be basetypes.StringValue, was: %T`, previewAttribute))
 	}
 
 	if diags.HasError() {
-		return NewOverrideSelectorsValueUnknown(), diags
+		return NewPolicyDefinitionChecksValueUnknown(), diags
 	}
 
-	return OverrideSelectorsValue{
-		In:    inVal,
-		Kind:  kindVal,
-		NotIn: notInVal,
-		state: attr.ValueStateKnown,
+	return PolicyDefinitionChecksValue{
+		Deprecated: deprecatedVal,
+		Preview:    previewVal,
+		state:      attr.ValueStateKnown,
 	}, diags
 }
 
-func NewOverrideSelectorsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) OverrideSelectorsValue {
-	object, diags := NewOverrideSelectorsValue(attributeTypes, attributes)
+func NewPolicyDefinitionChecksValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) PolicyDefinitionChecksValue {
+	object, diags := NewPolicyDefinitionChecksValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -4965,15 +10134,15 @@ func NewOverrideSelectorsValueMust(attributeTypes map[string]attr.Type, attribut
 				diagnostic.Detail()))
 		}
 
-		panic("NewOverrideSelectorsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewPolicyDefinitionChecksValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t OverrideSelectorsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t PolicyDefinitionChecksType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewOverrideSelectorsValueNull(), nil
+		return NewPolicyDefinitionChecksValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -4981,11 +10150,11 @@ func (t OverrideSelectorsType) ValueFromTerraform(ctx context.Context, in tftype
 	}
 
 	if !in.IsKnown() {
-		return NewOverrideSelectorsValueUnknown(), nil
+		return NewPolicyDefinitionChecksValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewOverrideSelectorsValueNull(), nil
+		return NewPolicyDefinitionChecksValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -5008,65 +10177,51 @@ func (t OverrideSelectorsType) ValueFromTerraform(ctx context.Context, in tftype
 		attributes[k] = a
 	}
 
-	return NewOverrideSelectorsValueMust(OverrideSelectorsValue{}.AttributeTypes(ctx), attributes), nil
+	return NewPolicyDefinitionChecksValueMust(PolicyDefinitionChecksValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t OverrideSelectorsType) ValueType(ctx context.Context) attr.Value {
-	return OverrideSelectorsValue{}
+func (t PolicyDefinitionChecksType) ValueType(ctx context.Context) attr.Value {
+	return PolicyDefinitionChecksValue{}
 }
 
-var _ basetypes.ObjectValuable = OverrideSelectorsValue{}
+var _ basetypes.ObjectValuable = PolicyDefinitionChecksValue{}
 
-type OverrideSelectorsValue struct {
-	In    basetypes.SetValue    `tfsdk:"in"`
-	Kind  basetypes.StringValue `tfsdk:"kind"`
-	NotIn basetypes.SetValue    `tfsdk:"not_in"`
-	state attr.ValueState
+type PolicyDefinitionChecksValue struct {
+	Deprecated basetypes.StringValue `tfsdk:"deprecated"`
+	Preview    basetypes.StringValue `tfsdk:"preview"`
+	state      attr.ValueState
 }
 
-func (v OverrideSelectorsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 3)
+func (v PolicyDefinitionChecksValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 2)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["in"] = basetypes.SetType{
-		ElemType: types.StringType,
-	}.TerraformType(ctx)
-	attrTypes["kind"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["not_in"] = basetypes.SetType{
-		ElemType: types.StringType,
-	}.TerraformType(ctx)
+	attrTypes["deprecated"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["preview"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 3)
-
-		val, err = v.In.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["in"] = val
+		vals := make(map[string]tftypes.Value, 2)
 
-		val, err = v.Kind.ToTerraformValue(ctx)
+		val, err = v.Deprecated.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["kind"] = val
+		vals["deprecated"] = val
 
-		val, err = v.NotIn.ToTerraformValue(ctx)
+		val, err = v.Preview.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["not_in"] = val
+		vals["preview"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -5082,77 +10237,24 @@ func (v OverrideSelectorsValue) ToTerraformValue(ctx context.Context) (tftypes.V
 	}
 }
 
-func (v OverrideSelectorsValue) IsNull() bool {
+func (v PolicyDefinitionChecksValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v OverrideSelectorsValue) IsUnknown() bool {
+func (v PolicyDefinitionChecksValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v OverrideSelectorsValue) String() string {
-	return "OverrideSelectorsValue"
+func (v PolicyDefinitionChecksValue) String() string {
+	return "PolicyDefinitionChecksValue"
 }
 
-func (v OverrideSelectorsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v PolicyDefinitionChecksValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	var inVal basetypes.SetValue
-	switch {
-	case v.In.IsUnknown():
-		inVal = types.SetUnknown(types.StringType)
-	case v.In.IsNull():
-		inVal = types.SetNull(types.StringType)
-	default:
-		var d diag.Diagnostics
-		inVal, d = types.SetValue(types.StringType, v.In.Elements())
-		diags.Append(d...)
-	}
-
-	if diags.HasError() {
-		return types.ObjectUnknown(map[string]attr.Type{
-			"in": basetypes.SetType{
-				ElemType: types.StringType,
-			},
-			"kind": basetypes.StringType{},
-			"not_in": basetypes.SetType{
-				ElemType: types.StringType,
-			},
-		}), diags
-	}
-
-	var notInVal basetypes.SetValue
-	switch {
-	case v.NotIn.IsUnknown():
-		notInVal = types.SetUnknown(types.StringType)
-	case v.NotIn.IsNull():
-		notInVal = types.SetNull(types.StringType)
-	default:
-		var d diag.Diagnostics
-		notInVal, d = types.SetValue(types.StringType, v.NotIn.Elements())
-		diags.Append(d...)
-	}
-
-	if diags.HasError() {
-		return types.ObjectUnknown(map[string]attr.Type{
-			"in": basetypes.SetType{
-				ElemType: types.StringType,
-			},
-			"kind": basetypes.StringType{},
-			"not_in": basetypes.SetType{
-				ElemType: types.StringType,
-			},
-		}), diags
-	}
-
 	attributeTypes := map[string]attr.Type{
-		"in": basetypes.SetType{
-			ElemType: types.StringType,
-		},
-		"kind": basetypes.StringType{},
-		"not_in": basetypes.SetType{
-			ElemType: types.StringType,
-		},
+		"deprecated": basetypes.StringType{},
+		"preview":    basetypes.StringType{},
 	}
 
 	if v.IsNull() {
@@ -5166,16 +10268,15 @@ func (v OverrideSelectorsValue) ToObjectValue(ctx context.Context) (basetypes.Ob
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"in":     inVal,
-			"kind":   v.Kind,
-			"not_in": notInVal,
+			"deprecated": v.Deprecated,
+			"preview":    v.Preview,
 		})
 
 	return objVal, diags
 }
 
-func (v OverrideSelectorsValue) Equal(o attr.Value) bool {
-	other, ok := o.(OverrideSelectorsValue)
+func (v PolicyDefinitionChecksValue) Equal(o attr.Value) bool {
+	other, ok := o.(PolicyDefinitionChecksValue)
 
 	if !ok {
 		return false
@@ -5189,65 +10290,146 @@ func (v OverrideSelectorsValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.In.Equal(other.In) {
-		return false
-	}
-
-	if !v.Kind.Equal(other.Kind) {
+	if !v.Deprecated.Equal(other.Deprecated) {
 		return false
 	}
 
-	if !v.NotIn.Equal(other.NotIn) {
+	if !v.Preview.Equal(other.Preview) {
 		return false
 	}
 
 	return true
 }
 
-func (v OverrideSelectorsValue) Type(ctx context.Context) attr.Type {
-	return OverrideSelectorsType{
+func (v PolicyDefinitionChecksValue) Type(ctx context.Context) attr.Type {
+	return PolicyDefinitionChecksType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v OverrideSelectorsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v PolicyDefinitionChecksValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"in": basetypes.SetType{
-			ElemType: types.StringType,
-		},
-		"kind": basetypes.StringType{},
-		"not_in": basetypes.SetType{
-			ElemType: types.StringType,
-		},
+		"deprecated": basetypes.StringType{},
+		"preview":    basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = PolicyExemptionsType{}
+
+type PolicyExemptionsType struct {
+	basetypes.ObjectType
+}
+
+func (t PolicyExemptionsType) Equal(o attr.Type) bool {
+	other, ok := o.(PolicyExemptionsType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t PolicyExemptionsType) String() string {
+	return "PolicyExemptionsType"
+}
+
+func (t PolicyExemptionsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	descriptionAttribute, ok := attributes["description"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`description is missing from object`)
+
+		return nil, diags
+	}
+
+	descriptionVal, ok := descriptionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`description expected to be basetypes.StringValue, was: %T`, descriptionAttribute))
+	}
+
+	displayNameAttribute, ok := attributes["display_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`display_name is missing from object`)
+
+		return nil, diags
+	}
+
+	displayNameVal, ok := displayNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`display_name expected to be basetypes.StringValue, was: %T`, displayNameAttribute))
+	}
+
+	exemptionCategoryAttribute, ok := attributes["exemption_category"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`exemption_category is missing from object`)
+
+		return nil, diags
 	}
-}
 
-var _ basetypes.ObjectTypable = ResourceSelectorsType{}
+	exemptionCategoryVal, ok := exemptionCategoryAttribute.(basetypes.StringValue)
 
-type ResourceSelectorsType struct {
-	basetypes.ObjectType
-}
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`exemption_category expected to be basetypes.StringValue, was: %T`, exemptionCategoryAttribute))
+	}
 
-func (t ResourceSelectorsType) Equal(o attr.Type) bool {
-	other, ok := o.(ResourceSelectorsType)
+	expiresOnAttribute, ok := attributes["expires_on"]
 
 	if !ok {
-		return false
+		diags.AddError(
+			"Attribute Missing",
+			`expires_on is missing from object`)
+
+		return nil, diags
 	}
 
-	return t.ObjectType.Equal(other.ObjectType)
-}
+	expiresOnVal, ok := expiresOnAttribute.(basetypes.StringValue)
 
-func (t ResourceSelectorsType) String() string {
-	return "ResourceSelectorsType"
-}
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`expires_on expected to be basetypes.StringValue, was: %T`, expiresOnAttribute))
+	}
 
-func (t ResourceSelectorsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
-	var diags diag.Diagnostics
+	managementGroupIdAttribute, ok := attributes["management_group_id"]
 
-	attributes := in.Attributes()
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`management_group_id is missing from object`)
+
+		return nil, diags
+	}
+
+	managementGroupIdVal, ok := managementGroupIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`management_group_id expected to be basetypes.StringValue, was: %T`, managementGroupIdAttribute))
+	}
 
 	nameAttribute, ok := attributes["name"]
 
@@ -5267,48 +10449,72 @@ func (t ResourceSelectorsType) ValueFromObject(ctx context.Context, in basetypes
 			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
 	}
 
-	resourceSelectorSelectorsAttribute, ok := attributes["resource_selector_selectors"]
+	policyAssignmentNameAttribute, ok := attributes["policy_assignment_name"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`resource_selector_selectors is missing from object`)
+			`policy_assignment_name is missing from object`)
 
 		return nil, diags
 	}
 
-	resourceSelectorSelectorsVal, ok := resourceSelectorSelectorsAttribute.(basetypes.ListValue)
+	policyAssignmentNameVal, ok := policyAssignmentNameAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`resource_selector_selectors expected to be basetypes.ListValue, was: %T`, resourceSelectorSelectorsAttribute))
+			fmt.Sprintf(`policy_assignment_name expected to be basetypes.StringValue, was: %T`, policyAssignmentNameAttribute))
+	}
+
+	policyDefinitionReferenceIdsAttribute, ok := attributes["policy_definition_reference_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definition_reference_ids is missing from object`)
+
+		return nil, diags
+	}
+
+	policyDefinitionReferenceIdsVal, ok := policyDefinitionReferenceIdsAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definition_reference_ids expected to be basetypes.SetValue, was: %T`, policyDefinitionReferenceIdsAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return ResourceSelectorsValue{
-		Name:                      nameVal,
-		ResourceSelectorSelectors: resourceSelectorSelectorsVal,
-		state:                     attr.ValueStateKnown,
+	return PolicyExemptionsValue{
+		Description:                  descriptionVal,
+		DisplayName:                  displayNameVal,
+		ExemptionCategory:            exemptionCategoryVal,
+		ExpiresOn:                    expiresOnVal,
+		ManagementGroupId:            managementGroupIdVal,
+		Name:                         nameVal,
+		PolicyAssignmentName:         policyAssignmentNameVal,
+		PolicyDefinitionReferenceIds: policyDefinitionReferenceIdsVal,
+		state:                        attr.ValueStateKnown,
 	}, diags
 }
 
-func NewResourceSelectorsValueNull() ResourceSelectorsValue {
-	return ResourceSelectorsValue{
+func NewPolicyExemptionsValueNull() PolicyExemptionsValue {
+	return PolicyExemptionsValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewResourceSelectorsValueUnknown() ResourceSelectorsValue {
-	return ResourceSelectorsValue{
+func NewPolicyExemptionsValueUnknown() PolicyExemptionsValue {
+	return PolicyExemptionsValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewResourceSelectorsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ResourceSelectorsValue, diag.Diagnostics) {
+func NewPolicyExemptionsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (PolicyExemptionsValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -5319,11 +10525,11 @@ func NewResourceSelectorsValue(attributeTypes map[string]attr.Type, attributes m
 
 		if !ok {
 			diags.AddError(
-				"Missing ResourceSelectorsValue Attribute Value",
-				"While creating a ResourceSelectorsValue value, a missing attribute value was detected. "+
-					"A ResourceSelectorsValue must contain values for all attributes, even if null or unknown. "+
+				"Missing PolicyExemptionsValue Attribute Value",
+				"While creating a PolicyExemptionsValue value, a missing attribute value was detected. "+
+					"A PolicyExemptionsValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("ResourceSelectorsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("PolicyExemptionsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -5331,12 +10537,12 @@ func NewResourceSelectorsValue(attributeTypes map[string]attr.Type, attributes m
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid ResourceSelectorsValue Attribute Type",
-				"While creating a ResourceSelectorsValue value, an invalid attribute value was detected. "+
-					"A ResourceSelectorsValue must use a matching attribute type for the value. "+
+				"Invalid PolicyExemptionsValue Attribute Type",
+				"While creating a PolicyExemptionsValue value, an invalid attribute value was detected. "+
+					"A PolicyExemptionsValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("ResourceSelectorsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("ResourceSelectorsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("PolicyExemptionsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("PolicyExemptionsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -5346,17 +10552,107 @@ func NewResourceSelectorsValue(attributeTypes map[string]attr.Type, attributes m
 
 		if !ok {
 			diags.AddError(
-				"Extra ResourceSelectorsValue Attribute Value",
-				"While creating a ResourceSelectorsValue value, an extra attribute value was detected. "+
-					"A ResourceSelectorsValue must not contain values beyond the expected attribute types. "+
+				"Extra PolicyExemptionsValue Attribute Value",
+				"While creating a PolicyExemptionsValue value, an extra attribute value was detected. "+
+					"A PolicyExemptionsValue must not contain values beyond the expected attribute types. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra ResourceSelectorsValue Attribute Name: %s", name),
+					fmt.Sprintf("Extra PolicyExemptionsValue Attribute Name: %s", name),
 			)
 		}
 	}
 
 	if diags.HasError() {
-		return NewResourceSelectorsValueUnknown(), diags
+		return NewPolicyExemptionsValueUnknown(), diags
+	}
+
+	descriptionAttribute, ok := attributes["description"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`description is missing from object`)
+
+		return NewPolicyExemptionsValueUnknown(), diags
+	}
+
+	descriptionVal, ok := descriptionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`description expected to be basetypes.StringValue, was: %T`, descriptionAttribute))
+	}
+
+	displayNameAttribute, ok := attributes["display_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`display_name is missing from object`)
+
+		return NewPolicyExemptionsValueUnknown(), diags
+	}
+
+	displayNameVal, ok := displayNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`display_name expected to be basetypes.StringValue, was: %T`, displayNameAttribute))
+	}
+
+	exemptionCategoryAttribute, ok := attributes["exemption_category"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`exemption_category is missing from object`)
+
+		return NewPolicyExemptionsValueUnknown(), diags
+	}
+
+	exemptionCategoryVal, ok := exemptionCategoryAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`exemption_category expected to be basetypes.StringValue, was: %T`, exemptionCategoryAttribute))
+	}
+
+	expiresOnAttribute, ok := attributes["expires_on"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`expires_on is missing from object`)
+
+		return NewPolicyExemptionsValueUnknown(), diags
+	}
+
+	expiresOnVal, ok := expiresOnAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`expires_on expected to be basetypes.StringValue, was: %T`, expiresOnAttribute))
+	}
+
+	managementGroupIdAttribute, ok := attributes["management_group_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`management_group_id is missing from object`)
+
+		return NewPolicyExemptionsValueUnknown(), diags
+	}
+
+	managementGroupIdVal, ok := managementGroupIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`management_group_id expected to be basetypes.StringValue, was: %T`, managementGroupIdAttribute))
 	}
 
 	nameAttribute, ok := attributes["name"]
@@ -5366,7 +10662,7 @@ func NewResourceSelectorsValue(attributeTypes map[string]attr.Type, attributes m
 			"Attribute Missing",
 			`name is missing from object`)
 
-		return NewResourceSelectorsValueUnknown(), diags
+		return NewPolicyExemptionsValueUnknown(), diags
 	}
 
 	nameVal, ok := nameAttribute.(basetypes.StringValue)
@@ -5377,37 +10673,61 @@ func NewResourceSelectorsValue(attributeTypes map[string]attr.Type, attributes m
 			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
 	}
 
-	resourceSelectorSelectorsAttribute, ok := attributes["resource_selector_selectors"]
+	policyAssignmentNameAttribute, ok := attributes["policy_assignment_name"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`resource_selector_selectors is missing from object`)
+			`policy_assignment_name is missing from object`)
 
-		return NewResourceSelectorsValueUnknown(), diags
+		return NewPolicyExemptionsValueUnknown(), diags
 	}
 
-	resourceSelectorSelectorsVal, ok := resourceSelectorSelectorsAttribute.(basetypes.ListValue)
+	policyAssignmentNameVal, ok := policyAssignmentNameAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`resource_selector_selectors expected to be basetypes.ListValue, was: %T`, resourceSelectorSelectorsAttribute))
+			fmt.Sprintf(`policy_assignment_name expected to be basetypes.StringValue, was: %T`, policyAssignmentNameAttribute))
 	}
 
-	if diags.HasError() {
-		return NewResourceSelectorsValueUnknown(), diags
+	policyDefinitionReferenceIdsAttribute, ok := attributes["policy_definition_reference_ids"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definition_reference_ids is missing from object`)
+
+		return NewPolicyExemptionsValueUnknown(), diags
 	}
 
-	return ResourceSelectorsValue{
-		Name:                      nameVal,
-		ResourceSelectorSelectors: resourceSelectorSelectorsVal,
-		state:                     attr.ValueStateKnown,
+	policyDefinitionReferenceIdsVal, ok := policyDefinitionReferenceIdsAttribute.(basetypes.SetValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definition_reference_ids expected to be basetypes.SetValue, was: %T`, policyDefinitionReferenceIdsAttribute))
+	}
+
+	if diags.HasError() {
+		return NewPolicyExemptionsValueUnknown(), diags
+	}
+
+	return PolicyExemptionsValue{
+		Description:                  descriptionVal,
+		DisplayName:                  displayNameVal,
+		ExemptionCategory:            exemptionCategoryVal,
+		ExpiresOn:                    expiresOnVal,
+		ManagementGroupId:            managementGroupIdVal,
+		Name:                         nameVal,
+		PolicyAssignmentName:         policyAssignmentNameVal,
+		PolicyDefinitionReferenceIds: policyDefinitionReferenceIdsVal,
+		state:                        attr.ValueStateKnown,
 	}, diags
 }
 
-func NewResourceSelectorsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ResourceSelectorsValue {
-	object, diags := NewResourceSelectorsValue(attributeTypes, attributes)
+func NewPolicyExemptionsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) PolicyExemptionsValue {
+	object, diags := NewPolicyExemptionsValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -5421,15 +10741,15 @@ func NewResourceSelectorsValueMust(attributeTypes map[string]attr.Type, attribut
 				diagnostic.Detail()))
 		}
 
-		panic("NewResourceSelectorsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewPolicyExemptionsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t ResourceSelectorsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t PolicyExemptionsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewResourceSelectorsValueNull(), nil
+		return NewPolicyExemptionsValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -5437,11 +10757,11 @@ func (t ResourceSelectorsType) ValueFromTerraform(ctx context.Context, in tftype
 	}
 
 	if !in.IsKnown() {
-		return NewResourceSelectorsValueUnknown(), nil
+		return NewPolicyExemptionsValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewResourceSelectorsValueNull(), nil
+		return NewPolicyExemptionsValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -5464,53 +10784,113 @@ func (t ResourceSelectorsType) ValueFromTerraform(ctx context.Context, in tftype
 		attributes[k] = a
 	}
 
-	return NewResourceSelectorsValueMust(ResourceSelectorsValue{}.AttributeTypes(ctx), attributes), nil
+	return NewPolicyExemptionsValueMust(PolicyExemptionsValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t ResourceSelectorsType) ValueType(ctx context.Context) attr.Value {
-	return ResourceSelectorsValue{}
+func (t PolicyExemptionsType) ValueType(ctx context.Context) attr.Value {
+	return PolicyExemptionsValue{}
 }
 
-var _ basetypes.ObjectValuable = ResourceSelectorsValue{}
+var _ basetypes.ObjectValuable = PolicyExemptionsValue{}
 
-type ResourceSelectorsValue struct {
-	Name                      basetypes.StringValue `tfsdk:"name"`
-	ResourceSelectorSelectors basetypes.ListValue   `tfsdk:"resource_selector_selectors"`
-	state                     attr.ValueState
+type PolicyExemptionsValue struct {
+	Description                  basetypes.StringValue `tfsdk:"description"`
+	DisplayName                  basetypes.StringValue `tfsdk:"display_name"`
+	ExemptionCategory            basetypes.StringValue `tfsdk:"exemption_category"`
+	ExpiresOn                    basetypes.StringValue `tfsdk:"expires_on"`
+	ManagementGroupId            basetypes.StringValue `tfsdk:"management_group_id"`
+	Name                         basetypes.StringValue `tfsdk:"name"`
+	PolicyAssignmentName         basetypes.StringValue `tfsdk:"policy_assignment_name"`
+	PolicyDefinitionReferenceIds basetypes.SetValue    `tfsdk:"policy_definition_reference_ids"`
+	state                        attr.ValueState
 }
 
-func (v ResourceSelectorsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 2)
+func (v PolicyExemptionsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 8)
 
 	var val tftypes.Value
 	var err error
 
+	attrTypes["description"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["display_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["exemption_category"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["expires_on"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["management_group_id"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["resource_selector_selectors"] = basetypes.ListType{
-		ElemType: ResourceSelectorSelectorsValue{}.Type(ctx),
+	attrTypes["policy_assignment_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["policy_definition_reference_ids"] = basetypes.SetType{
+		ElemType: types.StringType,
 	}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 2)
+		vals := make(map[string]tftypes.Value, 8)
+
+		val, err = v.Description.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["description"] = val
+
+		val, err = v.DisplayName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["display_name"] = val
+
+		val, err = v.ExemptionCategory.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["exemption_category"] = val
+
+		val, err = v.ExpiresOn.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["expires_on"] = val
+
+		val, err = v.ManagementGroupId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["management_group_id"] = val
+
+		val, err = v.Name.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
 
-		val, err = v.Name.ToTerraformValue(ctx)
+		vals["name"] = val
+
+		val, err = v.PolicyAssignmentName.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["name"] = val
+		vals["policy_assignment_name"] = val
 
-		val, err = v.ResourceSelectorSelectors.ToTerraformValue(ctx)
+		val, err = v.PolicyDefinitionReferenceIds.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["resource_selector_selectors"] = val
+		vals["policy_definition_reference_ids"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -5526,54 +10906,58 @@ func (v ResourceSelectorsValue) ToTerraformValue(ctx context.Context) (tftypes.V
 	}
 }
 
-func (v ResourceSelectorsValue) IsNull() bool {
+func (v PolicyExemptionsValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v ResourceSelectorsValue) IsUnknown() bool {
+func (v PolicyExemptionsValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v ResourceSelectorsValue) String() string {
-	return "ResourceSelectorsValue"
+func (v PolicyExemptionsValue) String() string {
+	return "PolicyExemptionsValue"
 }
 
-func (v ResourceSelectorsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v PolicyExemptionsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	resourceSelectorSelectors := types.ListValueMust(
-		ResourceSelectorSelectorsType{
-			basetypes.ObjectType{
-				AttrTypes: ResourceSelectorSelectorsValue{}.AttributeTypes(ctx),
-			},
-		},
-		v.ResourceSelectorSelectors.Elements(),
-	)
-
-	if v.ResourceSelectorSelectors.IsNull() {
-		resourceSelectorSelectors = types.ListNull(
-			ResourceSelectorSelectorsType{
-				basetypes.ObjectType{
-					AttrTypes: ResourceSelectorSelectorsValue{}.AttributeTypes(ctx),
-				},
-			},
-		)
+	var policyDefinitionReferenceIdsVal basetypes.SetValue
+	switch {
+	case v.PolicyDefinitionReferenceIds.IsUnknown():
+		policyDefinitionReferenceIdsVal = types.SetUnknown(types.StringType)
+	case v.PolicyDefinitionReferenceIds.IsNull():
+		policyDefinitionReferenceIdsVal = types.SetNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		policyDefinitionReferenceIdsVal, d = types.SetValue(types.StringType, v.PolicyDefinitionReferenceIds.Elements())
+		diags.Append(d...)
 	}
 
-	if v.ResourceSelectorSelectors.IsUnknown() {
-		resourceSelectorSelectors = types.ListUnknown(
-			ResourceSelectorSelectorsType{
-				basetypes.ObjectType{
-					AttrTypes: ResourceSelectorSelectorsValue{}.AttributeTypes(ctx),
-				},
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"description":            basetypes.StringType{},
+			"display_name":           basetypes.StringType{},
+			"exemption_category":     basetypes.StringType{},
+			"expires_on":             basetypes.StringType{},
+			"management_group_id":    basetypes.StringType{},
+			"name":                   basetypes.StringType{},
+			"policy_assignment_name": basetypes.StringType{},
+			"policy_definition_reference_ids": basetypes.SetType{
+				ElemType: types.StringType,
 			},
-		)
+		}), diags
 	}
 
 	attributeTypes := map[string]attr.Type{
-		"name": basetypes.StringType{},
-		"resource_selector_selectors": basetypes.ListType{
-			ElemType: ResourceSelectorSelectorsValue{}.Type(ctx),
+		"description":            basetypes.StringType{},
+		"display_name":           basetypes.StringType{},
+		"exemption_category":     basetypes.StringType{},
+		"expires_on":             basetypes.StringType{},
+		"management_group_id":    basetypes.StringType{},
+		"name":                   basetypes.StringType{},
+		"policy_assignment_name": basetypes.StringType{},
+		"policy_definition_reference_ids": basetypes.SetType{
+			ElemType: types.StringType,
 		},
 	}
 
@@ -5588,15 +10972,21 @@ func (v ResourceSelectorsValue) ToObjectValue(ctx context.Context) (basetypes.Ob
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"name":                        v.Name,
-			"resource_selector_selectors": resourceSelectorSelectors,
+			"description":                     v.Description,
+			"display_name":                    v.DisplayName,
+			"exemption_category":              v.ExemptionCategory,
+			"expires_on":                      v.ExpiresOn,
+			"management_group_id":             v.ManagementGroupId,
+			"name":                            v.Name,
+			"policy_assignment_name":          v.PolicyAssignmentName,
+			"policy_definition_reference_ids": policyDefinitionReferenceIdsVal,
 		})
 
 	return objVal, diags
 }
 
-func (v ResourceSelectorsValue) Equal(o attr.Value) bool {
-	other, ok := o.(ResourceSelectorsValue)
+func (v PolicyExemptionsValue) Equal(o attr.Value) bool {
+	other, ok := o.(PolicyExemptionsValue)
 
 	if !ok {
 		return false
@@ -5610,42 +11000,72 @@ func (v ResourceSelectorsValue) Equal(o attr.Value) bool {
 		return true
 	}
 
+	if !v.Description.Equal(other.Description) {
+		return false
+	}
+
+	if !v.DisplayName.Equal(other.DisplayName) {
+		return false
+	}
+
+	if !v.ExemptionCategory.Equal(other.ExemptionCategory) {
+		return false
+	}
+
+	if !v.ExpiresOn.Equal(other.ExpiresOn) {
+		return false
+	}
+
+	if !v.ManagementGroupId.Equal(other.ManagementGroupId) {
+		return false
+	}
+
 	if !v.Name.Equal(other.Name) {
 		return false
 	}
 
-	if !v.ResourceSelectorSelectors.Equal(other.ResourceSelectorSelectors) {
+	if !v.PolicyAssignmentName.Equal(other.PolicyAssignmentName) {
+		return false
+	}
+
+	if !v.PolicyDefinitionReferenceIds.Equal(other.PolicyDefinitionReferenceIds) {
 		return false
 	}
 
 	return true
 }
 
-func (v ResourceSelectorsValue) Type(ctx context.Context) attr.Type {
-	return ResourceSelectorsType{
+func (v PolicyExemptionsValue) Type(ctx context.Context) attr.Type {
+	return PolicyExemptionsType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v ResourceSelectorsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v PolicyExemptionsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"name": basetypes.StringType{},
-		"resource_selector_selectors": basetypes.ListType{
-			ElemType: ResourceSelectorSelectorsValue{}.Type(ctx),
+		"description":            basetypes.StringType{},
+		"display_name":           basetypes.StringType{},
+		"exemption_category":     basetypes.StringType{},
+		"expires_on":             basetypes.StringType{},
+		"management_group_id":    basetypes.StringType{},
+		"name":                   basetypes.StringType{},
+		"policy_assignment_name": basetypes.StringType{},
+		"policy_definition_reference_ids": basetypes.SetType{
+			ElemType: types.StringType,
 		},
 	}
 }
 
-var _ basetypes.ObjectTypable = ResourceSelectorSelectorsType{}
+var _ basetypes.ObjectTypable = PolicyRemediationTargetsType{}
 
-type ResourceSelectorSelectorsType struct {
+type PolicyRemediationTargetsType struct {
 	basetypes.ObjectType
 }
 
-func (t ResourceSelectorSelectorsType) Equal(o attr.Type) bool {
-	other, ok := o.(ResourceSelectorSelectorsType)
+func (t PolicyRemediationTargetsType) Equal(o attr.Type) bool {
+	other, ok := o.(PolicyRemediationTargetsType)
 
 	if !ok {
 		return false
@@ -5654,94 +11074,170 @@ func (t ResourceSelectorSelectorsType) Equal(o attr.Type) bool {
 	return t.ObjectType.Equal(other.ObjectType)
 }
 
-func (t ResourceSelectorSelectorsType) String() string {
-	return "ResourceSelectorSelectorsType"
+func (t PolicyRemediationTargetsType) String() string {
+	return "PolicyRemediationTargetsType"
 }
 
-func (t ResourceSelectorSelectorsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+func (t PolicyRemediationTargetsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attributes := in.Attributes()
 
-	inAttribute, ok := attributes["in"]
+	effectAttribute, ok := attributes["effect"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`in is missing from object`)
+			`effect is missing from object`)
 
 		return nil, diags
 	}
 
-	inVal, ok := inAttribute.(basetypes.SetValue)
+	effectVal, ok := effectAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`in expected to be basetypes.SetValue, was: %T`, inAttribute))
+			fmt.Sprintf(`effect expected to be basetypes.StringValue, was: %T`, effectAttribute))
 	}
 
-	kindAttribute, ok := attributes["kind"]
+	managementGroupIdAttribute, ok := attributes["management_group_id"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`kind is missing from object`)
+			`management_group_id is missing from object`)
 
 		return nil, diags
 	}
 
-	kindVal, ok := kindAttribute.(basetypes.StringValue)
+	managementGroupIdVal, ok := managementGroupIdAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`kind expected to be basetypes.StringValue, was: %T`, kindAttribute))
+			fmt.Sprintf(`management_group_id expected to be basetypes.StringValue, was: %T`, managementGroupIdAttribute))
 	}
 
-	notInAttribute, ok := attributes["not_in"]
+	policyAssignmentIdAttribute, ok := attributes["policy_assignment_id"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`not_in is missing from object`)
+			`policy_assignment_id is missing from object`)
 
 		return nil, diags
 	}
 
-	notInVal, ok := notInAttribute.(basetypes.SetValue)
+	policyAssignmentIdVal, ok := policyAssignmentIdAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`not_in expected to be basetypes.SetValue, was: %T`, notInAttribute))
+			fmt.Sprintf(`policy_assignment_id expected to be basetypes.StringValue, was: %T`, policyAssignmentIdAttribute))
+	}
+
+	policyAssignmentNameAttribute, ok := attributes["policy_assignment_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignment_name is missing from object`)
+
+		return nil, diags
+	}
+
+	policyAssignmentNameVal, ok := policyAssignmentNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignment_name expected to be basetypes.StringValue, was: %T`, policyAssignmentNameAttribute))
+	}
+
+	policyDefinitionIdAttribute, ok := attributes["policy_definition_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definition_id is missing from object`)
+
+		return nil, diags
+	}
+
+	policyDefinitionIdVal, ok := policyDefinitionIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definition_id expected to be basetypes.StringValue, was: %T`, policyDefinitionIdAttribute))
+	}
+
+	policyDefinitionReferenceIdAttribute, ok := attributes["policy_definition_reference_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_definition_reference_id is missing from object`)
+
+		return nil, diags
+	}
+
+	policyDefinitionReferenceIdVal, ok := policyDefinitionReferenceIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_definition_reference_id expected to be basetypes.StringValue, was: %T`, policyDefinitionReferenceIdAttribute))
+	}
+
+	scopeAttribute, ok := attributes["scope"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`scope is missing from object`)
+
+		return nil, diags
+	}
+
+	scopeVal, ok := scopeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`scope expected to be basetypes.StringValue, was: %T`, scopeAttribute))
 	}
 
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return ResourceSelectorSelectorsValue{
-		In:    inVal,
-		Kind:  kindVal,
-		NotIn: notInVal,
-		state: attr.ValueStateKnown,
+	return PolicyRemediationTargetsValue{
+		Effect:                      effectVal,
+		ManagementGroupId:           managementGroupIdVal,
+		PolicyAssignmentId:          policyAssignmentIdVal,
+		PolicyAssignmentName:        policyAssignmentNameVal,
+		PolicyDefinitionId:          policyDefinitionIdVal,
+		PolicyDefinitionReferenceId: policyDefinitionReferenceIdVal,
+		Scope:                       scopeVal,
+		state:                       attr.ValueStateKnown,
 	}, diags
 }
 
-func NewResourceSelectorSelectorsValueNull() ResourceSelectorSelectorsValue {
-	return ResourceSelectorSelectorsValue{
+func NewPolicyRemediationTargetsValueNull() PolicyRemediationTargetsValue {
+	return PolicyRemediationTargetsValue{
 		state: attr.ValueStateNull,
 	}
 }
 
-func NewResourceSelectorSelectorsValueUnknown() ResourceSelectorSelectorsValue {
-	return ResourceSelectorSelectorsValue{
+func NewPolicyRemediationTargetsValueUnknown() PolicyRemediationTargetsValue {
+	return PolicyRemediationTargetsValue{
 		state: attr.ValueStateUnknown,
 	}
 }
 
-func NewResourceSelectorSelectorsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ResourceSelectorSelectorsValue, diag.Diagnostics) {
+func NewPolicyRemediationTargetsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (PolicyRemediationTargetsValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
@@ -5752,11 +11248,11 @@ func NewResourceSelectorSelectorsValue(attributeTypes map[string]attr.Type, attr
 
 		if !ok {
 			diags.AddError(
-				"Missing ResourceSelectorSelectorsValue Attribute Value",
-				"While creating a ResourceSelectorSelectorsValue value, a missing attribute value was detected. "+
-					"A ResourceSelectorSelectorsValue must contain values for all attributes, even if null or unknown. "+
+				"Missing PolicyRemediationTargetsValue Attribute Value",
+				"While creating a PolicyRemediationTargetsValue value, a missing attribute value was detected. "+
+					"A PolicyRemediationTargetsValue must contain values for all attributes, even if null or unknown. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("ResourceSelectorSelectorsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+					fmt.Sprintf("PolicyRemediationTargetsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
 			)
 
 			continue
@@ -5764,12 +11260,12 @@ func NewResourceSelectorSelectorsValue(attributeTypes map[string]attr.Type, attr
 
 		if !attributeType.Equal(attribute.Type(ctx)) {
 			diags.AddError(
-				"Invalid ResourceSelectorSelectorsValue Attribute Type",
-				"While creating a ResourceSelectorSelectorsValue value, an invalid attribute value was detected. "+
-					"A ResourceSelectorSelectorsValue must use a matching attribute type for the value. "+
+				"Invalid PolicyRemediationTargetsValue Attribute Type",
+				"While creating a PolicyRemediationTargetsValue value, an invalid attribute value was detected. "+
+					"A PolicyRemediationTargetsValue must use a matching attribute type for the value. "+
 					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("ResourceSelectorSelectorsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("ResourceSelectorSelectorsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+					fmt.Sprintf("PolicyRemediationTargetsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("PolicyRemediationTargetsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
 			)
 		}
 	}
@@ -5777,89 +11273,165 @@ func NewResourceSelectorSelectorsValue(attributeTypes map[string]attr.Type, attr
 	for name := range attributes {
 		_, ok := attributeTypes[name]
 
-		if !ok {
-			diags.AddError(
-				"Extra ResourceSelectorSelectorsValue Attribute Value",
-				"While creating a ResourceSelectorSelectorsValue value, an extra attribute value was detected. "+
-					"A ResourceSelectorSelectorsValue must not contain values beyond the expected attribute types. "+
-					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra ResourceSelectorSelectorsValue Attribute Name: %s", name),
-			)
-		}
+		if !ok {
+			diags.AddError(
+				"Extra PolicyRemediationTargetsValue Attribute Value",
+				"While creating a PolicyRemediationTargetsValue value, an extra attribute value was detected. "+
+					"A PolicyRemediationTargetsValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra PolicyRemediationTargetsValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewPolicyRemediationTargetsValueUnknown(), diags
+	}
+
+	effectAttribute, ok := attributes["effect"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`effect is missing from object`)
+
+		return NewPolicyRemediationTargetsValueUnknown(), diags
+	}
+
+	effectVal, ok := effectAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`effect expected to be basetypes.StringValue, was: %T`, effectAttribute))
+	}
+
+	managementGroupIdAttribute, ok := attributes["management_group_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`management_group_id is missing from object`)
+
+		return NewPolicyRemediationTargetsValueUnknown(), diags
+	}
+
+	managementGroupIdVal, ok := managementGroupIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`management_group_id expected to be basetypes.StringValue, was: %T`, managementGroupIdAttribute))
+	}
+
+	policyAssignmentIdAttribute, ok := attributes["policy_assignment_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignment_id is missing from object`)
+
+		return NewPolicyRemediationTargetsValueUnknown(), diags
+	}
+
+	policyAssignmentIdVal, ok := policyAssignmentIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignment_id expected to be basetypes.StringValue, was: %T`, policyAssignmentIdAttribute))
+	}
+
+	policyAssignmentNameAttribute, ok := attributes["policy_assignment_name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`policy_assignment_name is missing from object`)
+
+		return NewPolicyRemediationTargetsValueUnknown(), diags
 	}
 
-	if diags.HasError() {
-		return NewResourceSelectorSelectorsValueUnknown(), diags
+	policyAssignmentNameVal, ok := policyAssignmentNameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`policy_assignment_name expected to be basetypes.StringValue, was: %T`, policyAssignmentNameAttribute))
 	}
 
-	inAttribute, ok := attributes["in"]
+	policyDefinitionIdAttribute, ok := attributes["policy_definition_id"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`in is missing from object`)
+			`policy_definition_id is missing from object`)
 
-		return NewResourceSelectorSelectorsValueUnknown(), diags
+		return NewPolicyRemediationTargetsValueUnknown(), diags
 	}
 
-	inVal, ok := inAttribute.(basetypes.SetValue)
+	policyDefinitionIdVal, ok := policyDefinitionIdAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`in expected to be basetypes.SetValue, was: %T`, inAttribute))
+			fmt.Sprintf(`policy_definition_id expected to be basetypes.StringValue, was: %T`, policyDefinitionIdAttribute))
 	}
 
-	kindAttribute, ok := attributes["kind"]
+	policyDefinitionReferenceIdAttribute, ok := attributes["policy_definition_reference_id"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`kind is missing from object`)
+			`policy_definition_reference_id is missing from object`)
 
-		return NewResourceSelectorSelectorsValueUnknown(), diags
+		return NewPolicyRemediationTargetsValueUnknown(), diags
 	}
 
-	kindVal, ok := kindAttribute.(basetypes.StringValue)
+	policyDefinitionReferenceIdVal, ok := policyDefinitionReferenceIdAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`kind expected to be basetypes.StringValue, was: %T`, kindAttribute))
+			fmt.Sprintf(`policy_definition_reference_id expected to be basetypes.StringValue, was: %T`, policyDefinitionReferenceIdAttribute))
 	}
 
-	notInAttribute, ok := attributes["not_in"]
+	scopeAttribute, ok := attributes["scope"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`not_in is missing from object`)
+			`scope is missing from object`)
 
-		return NewResourceSelectorSelectorsValueUnknown(), diags
+		return NewPolicyRemediationTargetsValueUnknown(), diags
 	}
 
-	notInVal, ok := notInAttribute.(basetypes.SetValue)
+	scopeVal, ok := scopeAttribute.(basetypes.StringValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`not_in expected to be basetypes.SetValue, was: %T`, notInAttribute))
+			fmt.Sprintf(`scope expected to be basetypes.StringValue, was: %T`, scopeAttribute))
 	}
 
 	if diags.HasError() {
-		return NewResourceSelectorSelectorsValueUnknown(), diags
+		return NewPolicyRemediationTargetsValueUnknown(), diags
 	}
 
-	return ResourceSelectorSelectorsValue{
-		In:    inVal,
-		Kind:  kindVal,
-		NotIn: notInVal,
-		state: attr.ValueStateKnown,
+	return PolicyRemediationTargetsValue{
+		Effect:                      effectVal,
+		ManagementGroupId:           managementGroupIdVal,
+		PolicyAssignmentId:          policyAssignmentIdVal,
+		PolicyAssignmentName:        policyAssignmentNameVal,
+		PolicyDefinitionId:          policyDefinitionIdVal,
+		PolicyDefinitionReferenceId: policyDefinitionReferenceIdVal,
+		Scope:                       scopeVal,
+		state:                       attr.ValueStateKnown,
 	}, diags
 }
 
-func NewResourceSelectorSelectorsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ResourceSelectorSelectorsValue {
-	object, diags := NewResourceSelectorSelectorsValue(attributeTypes, attributes)
+func NewPolicyRemediationTargetsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) PolicyRemediationTargetsValue {
+	object, diags := NewPolicyRemediationTargetsValue(attributeTypes, attributes)
 
 	if diags.HasError() {
 		// This could potentially be added to the diag package.
@@ -5873,15 +11445,15 @@ func NewResourceSelectorSelectorsValueMust(attributeTypes map[string]attr.Type,
 				diagnostic.Detail()))
 		}
 
-		panic("NewResourceSelectorSelectorsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+		panic("NewPolicyRemediationTargetsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
 	}
 
 	return object
 }
 
-func (t ResourceSelectorSelectorsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+func (t PolicyRemediationTargetsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.Type() == nil {
-		return NewResourceSelectorSelectorsValueNull(), nil
+		return NewPolicyRemediationTargetsValueNull(), nil
 	}
 
 	if !in.Type().Equal(t.TerraformType(ctx)) {
@@ -5889,11 +11461,11 @@ func (t ResourceSelectorSelectorsType) ValueFromTerraform(ctx context.Context, i
 	}
 
 	if !in.IsKnown() {
-		return NewResourceSelectorSelectorsValueUnknown(), nil
+		return NewPolicyRemediationTargetsValueUnknown(), nil
 	}
 
 	if in.IsNull() {
-		return NewResourceSelectorSelectorsValueNull(), nil
+		return NewPolicyRemediationTargetsValueNull(), nil
 	}
 
 	attributes := map[string]attr.Value{}
@@ -5916,65 +11488,101 @@ func (t ResourceSelectorSelectorsType) ValueFromTerraform(ctx context.Context, i
 		attributes[k] = a
 	}
 
-	return NewResourceSelectorSelectorsValueMust(ResourceSelectorSelectorsValue{}.AttributeTypes(ctx), attributes), nil
+	return NewPolicyRemediationTargetsValueMust(PolicyRemediationTargetsValue{}.AttributeTypes(ctx), attributes), nil
 }
 
-func (t ResourceSelectorSelectorsType) ValueType(ctx context.Context) attr.Value {
-	return ResourceSelectorSelectorsValue{}
+func (t PolicyRemediationTargetsType) ValueType(ctx context.Context) attr.Value {
+	return PolicyRemediationTargetsValue{}
 }
 
-var _ basetypes.ObjectValuable = ResourceSelectorSelectorsValue{}
+var _ basetypes.ObjectValuable = PolicyRemediationTargetsValue{}
 
-type ResourceSelectorSelectorsValue struct {
-	In    basetypes.SetValue    `tfsdk:"in"`
-	Kind  basetypes.StringValue `tfsdk:"kind"`
-	NotIn basetypes.SetValue    `tfsdk:"not_in"`
-	state attr.ValueState
+type PolicyRemediationTargetsValue struct {
+	Effect                      basetypes.StringValue `tfsdk:"effect"`
+	ManagementGroupId           basetypes.StringValue `tfsdk:"management_group_id"`
+	PolicyAssignmentId          basetypes.StringValue `tfsdk:"policy_assignment_id"`
+	PolicyAssignmentName        basetypes.StringValue `tfsdk:"policy_assignment_name"`
+	PolicyDefinitionId          basetypes.StringValue `tfsdk:"policy_definition_id"`
+	PolicyDefinitionReferenceId basetypes.StringValue `tfsdk:"policy_definition_reference_id"`
+	Scope                       basetypes.StringValue `tfsdk:"scope"`
+	state                       attr.ValueState
 }
 
-func (v ResourceSelectorSelectorsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 3)
+func (v PolicyRemediationTargetsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 7)
 
 	var val tftypes.Value
 	var err error
 
-	attrTypes["in"] = basetypes.SetType{
-		ElemType: types.StringType,
-	}.TerraformType(ctx)
-	attrTypes["kind"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["not_in"] = basetypes.SetType{
-		ElemType: types.StringType,
-	}.TerraformType(ctx)
+	attrTypes["effect"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["management_group_id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["policy_assignment_id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["policy_assignment_name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["policy_definition_id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["policy_definition_reference_id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["scope"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 3)
+		vals := make(map[string]tftypes.Value, 7)
 
-		val, err = v.In.ToTerraformValue(ctx)
+		val, err = v.Effect.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["in"] = val
+		vals["effect"] = val
 
-		val, err = v.Kind.ToTerraformValue(ctx)
+		val, err = v.ManagementGroupId.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["kind"] = val
+		vals["management_group_id"] = val
 
-		val, err = v.NotIn.ToTerraformValue(ctx)
+		val, err = v.PolicyAssignmentId.ToTerraformValue(ctx)
 
 		if err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
 
-		vals["not_in"] = val
+		vals["policy_assignment_id"] = val
+
+		val, err = v.PolicyAssignmentName.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_assignment_name"] = val
+
+		val, err = v.PolicyDefinitionId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_definition_id"] = val
+
+		val, err = v.PolicyDefinitionReferenceId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["policy_definition_reference_id"] = val
+
+		val, err = v.Scope.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["scope"] = val
 
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
@@ -5990,77 +11598,29 @@ func (v ResourceSelectorSelectorsValue) ToTerraformValue(ctx context.Context) (t
 	}
 }
 
-func (v ResourceSelectorSelectorsValue) IsNull() bool {
+func (v PolicyRemediationTargetsValue) IsNull() bool {
 	return v.state == attr.ValueStateNull
 }
 
-func (v ResourceSelectorSelectorsValue) IsUnknown() bool {
+func (v PolicyRemediationTargetsValue) IsUnknown() bool {
 	return v.state == attr.ValueStateUnknown
 }
 
-func (v ResourceSelectorSelectorsValue) String() string {
-	return "ResourceSelectorSelectorsValue"
+func (v PolicyRemediationTargetsValue) String() string {
+	return "PolicyRemediationTargetsValue"
 }
 
-func (v ResourceSelectorSelectorsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+func (v PolicyRemediationTargetsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	var inVal basetypes.SetValue
-	switch {
-	case v.In.IsUnknown():
-		inVal = types.SetUnknown(types.StringType)
-	case v.In.IsNull():
-		inVal = types.SetNull(types.StringType)
-	default:
-		var d diag.Diagnostics
-		inVal, d = types.SetValue(types.StringType, v.In.Elements())
-		diags.Append(d...)
-	}
-
-	if diags.HasError() {
-		return types.ObjectUnknown(map[string]attr.Type{
-			"in": basetypes.SetType{
-				ElemType: types.StringType,
-			},
-			"kind": basetypes.StringType{},
-			"not_in": basetypes.SetType{
-				ElemType: types.StringType,
-			},
-		}), diags
-	}
-
-	var notInVal basetypes.SetValue
-	switch {
-	case v.NotIn.IsUnknown():
-		notInVal = types.SetUnknown(types.StringType)
-	case v.NotIn.IsNull():
-		notInVal = types.SetNull(types.StringType)
-	default:
-		var d diag.Diagnostics
-		notInVal, d = types.SetValue(types.StringType, v.NotIn.Elements())
-		diags.Append(d...)
-	}
-
-	if diags.HasError() {
-		return types.ObjectUnknown(map[string]attr.Type{
-			"in": basetypes.SetType{
-				ElemType: types.StringType,
-			},
-			"kind": basetypes.StringType{},
-			"not_in": basetypes.SetType{
-				ElemType: types.StringType,
-			},
-		}), diags
-	}
-
 	attributeTypes := map[string]attr.Type{
-		"in": basetypes.SetType{
-			ElemType: types.StringType,
-		},
-		"kind": basetypes.StringType{},
-		"not_in": basetypes.SetType{
-			ElemType: types.StringType,
-		},
+		"effect":                         basetypes.StringType{},
+		"management_group_id":            basetypes.StringType{},
+		"policy_assignment_id":           basetypes.StringType{},
+		"policy_assignment_name":         basetypes.StringType{},
+		"policy_definition_id":           basetypes.StringType{},
+		"policy_definition_reference_id": basetypes.StringType{},
+		"scope":                          basetypes.StringType{},
 	}
 
 	if v.IsNull() {
@@ -6074,16 +11634,20 @@ func (v ResourceSelectorSelectorsValue) ToObjectValue(ctx context.Context) (base
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"in":     inVal,
-			"kind":   v.Kind,
-			"not_in": notInVal,
+			"effect":                         v.Effect,
+			"management_group_id":            v.ManagementGroupId,
+			"policy_assignment_id":           v.PolicyAssignmentId,
+			"policy_assignment_name":         v.PolicyAssignmentName,
+			"policy_definition_id":           v.PolicyDefinitionId,
+			"policy_definition_reference_id": v.PolicyDefinitionReferenceId,
+			"scope":                          v.Scope,
 		})
 
 	return objVal, diags
 }
 
-func (v ResourceSelectorSelectorsValue) Equal(o attr.Value) bool {
-	other, ok := o.(ResourceSelectorSelectorsValue)
+func (v PolicyRemediationTargetsValue) Equal(o attr.Value) bool {
+	other, ok := o.(PolicyRemediationTargetsValue)
 
 	if !ok {
 		return false
@@ -6097,38 +11661,54 @@ func (v ResourceSelectorSelectorsValue) Equal(o attr.Value) bool {
 		return true
 	}
 
-	if !v.In.Equal(other.In) {
+	if !v.Effect.Equal(other.Effect) {
 		return false
 	}
 
-	if !v.Kind.Equal(other.Kind) {
+	if !v.ManagementGroupId.Equal(other.ManagementGroupId) {
 		return false
 	}
 
-	if !v.NotIn.Equal(other.NotIn) {
+	if !v.PolicyAssignmentId.Equal(other.PolicyAssignmentId) {
+		return false
+	}
+
+	if !v.PolicyAssignmentName.Equal(other.PolicyAssignmentName) {
+		return false
+	}
+
+	if !v.PolicyDefinitionId.Equal(other.PolicyDefinitionId) {
+		return false
+	}
+
+	if !v.PolicyDefinitionReferenceId.Equal(other.PolicyDefinitionReferenceId) {
+		return false
+	}
+
+	if !v.Scope.Equal(other.Scope) {
 		return false
 	}
 
 	return true
 }
 
-func (v ResourceSelectorSelectorsValue) Type(ctx context.Context) attr.Type {
-	return ResourceSelectorSelectorsType{
+func (v PolicyRemediationTargetsValue) Type(ctx context.Context) attr.Type {
+	return PolicyRemediationTargetsType{
 		basetypes.ObjectType{
 			AttrTypes: v.AttributeTypes(ctx),
 		},
 	}
 }
 
-func (v ResourceSelectorSelectorsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+func (v PolicyRemediationTargetsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"in": basetypes.SetType{
-			ElemType: types.StringType,
-		},
-		"kind": basetypes.StringType{},
-		"not_in": basetypes.SetType{
-			ElemType: types.StringType,
-		},
+		"effect":                         basetypes.StringType{},
+		"management_group_id":            basetypes.StringType{},
+		"policy_assignment_id":           basetypes.StringType{},
+		"policy_assignment_name":         basetypes.StringType{},
+		"policy_definition_id":           basetypes.StringType{},
+		"policy_definition_reference_id": basetypes.StringType{},
+		"scope":                          basetypes.StringType{},
 	}
 }
 
@@ -6157,6 +11737,24 @@ func (t PolicyRoleAssignmentsType) ValueFromObject(ctx context.Context, in baset
 
 	attributes := in.Attributes()
 
+	identityIdAttribute, ok := attributes["identity_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`identity_id is missing from object`)
+
+		return nil, diags
+	}
+
+	identityIdVal, ok := identityIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`identity_id expected to be basetypes.StringValue, was: %T`, identityIdAttribute))
+	}
+
 	managementGroupIdAttribute, ok := attributes["management_group_id"]
 
 	if !ok {
@@ -6234,6 +11832,7 @@ func (t PolicyRoleAssignmentsType) ValueFromObject(ctx context.Context, in baset
 	}
 
 	return PolicyRoleAssignmentsValue{
+		IdentityId:           identityIdVal,
 		ManagementGroupId:    managementGroupIdVal,
 		PolicyAssignmentName: policyAssignmentNameVal,
 		RoleDefinitionId:     roleDefinitionIdVal,
@@ -6305,6 +11904,24 @@ func NewPolicyRoleAssignmentsValue(attributeTypes map[string]attr.Type, attribut
 		return NewPolicyRoleAssignmentsValueUnknown(), diags
 	}
 
+	identityIdAttribute, ok := attributes["identity_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`identity_id is missing from object`)
+
+		return NewPolicyRoleAssignmentsValueUnknown(), diags
+	}
+
+	identityIdVal, ok := identityIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`identity_id expected to be basetypes.StringValue, was: %T`, identityIdAttribute))
+	}
+
 	managementGroupIdAttribute, ok := attributes["management_group_id"]
 
 	if !ok {
@@ -6382,6 +11999,7 @@ func NewPolicyRoleAssignmentsValue(attributeTypes map[string]attr.Type, attribut
 	}
 
 	return PolicyRoleAssignmentsValue{
+		IdentityId:           identityIdVal,
 		ManagementGroupId:    managementGroupIdVal,
 		PolicyAssignmentName: policyAssignmentNameVal,
 		RoleDefinitionId:     roleDefinitionIdVal,
@@ -6458,6 +12076,7 @@ func (t PolicyRoleAssignmentsType) ValueType(ctx context.Context) attr.Value {
 var _ basetypes.ObjectValuable = PolicyRoleAssignmentsValue{}
 
 type PolicyRoleAssignmentsValue struct {
+	IdentityId           basetypes.StringValue `tfsdk:"identity_id"`
 	ManagementGroupId    basetypes.StringValue `tfsdk:"management_group_id"`
 	PolicyAssignmentName basetypes.StringValue `tfsdk:"policy_assignment_name"`
 	RoleDefinitionId     basetypes.StringValue `tfsdk:"role_definition_id"`
@@ -6466,11 +12085,12 @@ type PolicyRoleAssignmentsValue struct {
 }
 
 func (v PolicyRoleAssignmentsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 4)
+	attrTypes := make(map[string]tftypes.Type, 5)
 
 	var val tftypes.Value
 	var err error
 
+	attrTypes["identity_id"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["management_group_id"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["policy_assignment_name"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["role_definition_id"] = basetypes.StringType{}.TerraformType(ctx)
@@ -6480,7 +12100,15 @@ func (v PolicyRoleAssignmentsValue) ToTerraformValue(ctx context.Context) (tftyp
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 4)
+		vals := make(map[string]tftypes.Value, 5)
+
+		val, err = v.IdentityId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["identity_id"] = val
 
 		val, err = v.ManagementGroupId.ToTerraformValue(ctx)
 
@@ -6544,6 +12172,7 @@ func (v PolicyRoleAssignmentsValue) ToObjectValue(ctx context.Context) (basetype
 	var diags diag.Diagnostics
 
 	attributeTypes := map[string]attr.Type{
+		"identity_id":            basetypes.StringType{},
 		"management_group_id":    basetypes.StringType{},
 		"policy_assignment_name": basetypes.StringType{},
 		"role_definition_id":     basetypes.StringType{},
@@ -6561,6 +12190,7 @@ func (v PolicyRoleAssignmentsValue) ToObjectValue(ctx context.Context) (basetype
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
+			"identity_id":            v.IdentityId,
 			"management_group_id":    v.ManagementGroupId,
 			"policy_assignment_name": v.PolicyAssignmentName,
 			"role_definition_id":     v.RoleDefinitionId,
@@ -6585,6 +12215,10 @@ func (v PolicyRoleAssignmentsValue) Equal(o attr.Value) bool {
 		return true
 	}
 
+	if !v.IdentityId.Equal(other.IdentityId) {
+		return false
+	}
+
 	if !v.ManagementGroupId.Equal(other.ManagementGroupId) {
 		return false
 	}
@@ -6614,6 +12248,7 @@ func (v PolicyRoleAssignmentsValue) Type(ctx context.Context) attr.Type {
 
 func (v PolicyRoleAssignmentsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
+		"identity_id":            basetypes.StringType{},
 		"management_group_id":    basetypes.StringType{},
 		"policy_assignment_name": basetypes.StringType{},
 		"role_definition_id":     basetypes.StringType{},
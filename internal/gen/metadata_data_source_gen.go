@@ -4,7 +4,13 @@ package gen
 
 import (
 	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 )
@@ -23,12 +29,1184 @@ func MetadataDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "A computed value representing the unique identifier for the architecture. Mandatory for acceptance testing.",
 				MarkdownDescription: "A computed value representing the unique identifier for the architecture. Mandatory for acceptance testing.",
 			},
+			"library_dependency_graph": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"dependencies": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The resolved library references of the dependencies of the library.",
+							MarkdownDescription: "The resolved library references of the dependencies of the library.",
+						},
+						"explicit": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the library reference is in the `library_references` provider configuration.",
+							MarkdownDescription: "Whether the library reference is in the `library_references` provider configuration.",
+						},
+						"library_reference": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The library reference.",
+							MarkdownDescription: "The library reference.",
+						},
+						"replaced_library_references": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The other refs of the same library that were requested and replaced by this library reference, according to `library_dependency_conflict_resolution`.",
+							MarkdownDescription: "The other refs of the same library that were requested and replaced by this library reference, according to `library_dependency_conflict_resolution`.",
+						},
+					},
+					CustomType: LibraryDependencyGraphType{
+						ObjectType: types.ObjectType{
+							AttrTypes: LibraryDependencyGraphValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "The resolved library dependency graph, in the order the libraries are loaded. Only populated when `library_fetch_dependencies` is enabled in the provider configuration.",
+				MarkdownDescription: "The resolved library dependency graph, in the order the libraries are loaded. Only populated when `library_fetch_dependencies` is enabled in the provider configuration.",
+			},
+			"library_overwrites": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"asset_type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The type of the asset, one of `archetype`, `architecture`, `policy_assignment`, `policy_default_value`, `policy_definition`, `policy_set_definition` or `role_definition`.",
+							MarkdownDescription: "The type of the asset, one of `archetype`, `architecture`, `policy_assignment`, `policy_default_value`, `policy_definition`, `policy_set_definition` or `role_definition`.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the asset. For role definitions this is the role name.",
+							MarkdownDescription: "The name of the asset. For role definitions this is the role name.",
+						},
+						"original_library_reference": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The library reference that supplied the asset before it was replaced.",
+							MarkdownDescription: "The library reference that supplied the asset before it was replaced.",
+						},
+						"overwriting_library_reference": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The library reference that replaced the asset.",
+							MarkdownDescription: "The library reference that replaced the asset.",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The version of the policy (set) definition. Empty for unversioned definitions and other asset types.",
+							MarkdownDescription: "The version of the policy (set) definition. Empty for unversioned definitions and other asset types.",
+						},
+					},
+					CustomType: LibraryOverwritesType{
+						ObjectType: types.ObjectType{
+							AttrTypes: LibraryOverwritesValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "A list of the library assets that were replaced by a later library reference, in the order the library references were processed. Only populated when `library_overwrite_enabled` is set in the provider configuration.",
+				MarkdownDescription: "A list of the library assets that were replaced by a later library reference, in the order the library references were processed. Only populated when `library_overwrite_enabled` is set in the provider configuration.",
+			},
 		},
 		MarkdownDescription: "The metadata data source provides metadata information from ALZ library.",
 	}
 }
 
 type MetadataModel struct {
-	AlzLibraryReferences types.List   `tfsdk:"alz_library_references"`
-	Id                   types.String `tfsdk:"id"`
+	AlzLibraryReferences   types.List   `tfsdk:"alz_library_references"`
+	Id                     types.String `tfsdk:"id"`
+	LibraryDependencyGraph types.List   `tfsdk:"library_dependency_graph"`
+	LibraryOverwrites      types.List   `tfsdk:"library_overwrites"`
+}
+
+var _ basetypes.ObjectTypable = LibraryDependencyGraphType{}
+
+type LibraryDependencyGraphType struct {
+	basetypes.ObjectType
+}
+
+func (t LibraryDependencyGraphType) Equal(o attr.Type) bool {
+	other, ok := o.(LibraryDependencyGraphType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t LibraryDependencyGraphType) String() string {
+	return "LibraryDependencyGraphType"
+}
+
+func (t LibraryDependencyGraphType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	dependenciesAttribute, ok := attributes["dependencies"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`dependencies is missing from object`)
+
+		return nil, diags
+	}
+
+	dependenciesVal, ok := dependenciesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`dependencies expected to be basetypes.ListValue, was: %T`, dependenciesAttribute))
+	}
+
+	explicitAttribute, ok := attributes["explicit"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`explicit is missing from object`)
+
+		return nil, diags
+	}
+
+	explicitVal, ok := explicitAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`explicit expected to be basetypes.BoolValue, was: %T`, explicitAttribute))
+	}
+
+	libraryReferenceAttribute, ok := attributes["library_reference"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`library_reference is missing from object`)
+
+		return nil, diags
+	}
+
+	libraryReferenceVal, ok := libraryReferenceAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`library_reference expected to be basetypes.StringValue, was: %T`, libraryReferenceAttribute))
+	}
+
+	replacedLibraryReferencesAttribute, ok := attributes["replaced_library_references"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`replaced_library_references is missing from object`)
+
+		return nil, diags
+	}
+
+	replacedLibraryReferencesVal, ok := replacedLibraryReferencesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`replaced_library_references expected to be basetypes.ListValue, was: %T`, replacedLibraryReferencesAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return LibraryDependencyGraphValue{
+		Dependencies:              dependenciesVal,
+		Explicit:                  explicitVal,
+		LibraryReference:          libraryReferenceVal,
+		ReplacedLibraryReferences: replacedLibraryReferencesVal,
+		state:                     attr.ValueStateKnown,
+	}, diags
+}
+
+func NewLibraryDependencyGraphValueNull() LibraryDependencyGraphValue {
+	return LibraryDependencyGraphValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewLibraryDependencyGraphValueUnknown() LibraryDependencyGraphValue {
+	return LibraryDependencyGraphValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewLibraryDependencyGraphValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (LibraryDependencyGraphValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing LibraryDependencyGraphValue Attribute Value",
+				"While creating a LibraryDependencyGraphValue value, a missing attribute value was detected. "+
+					"A LibraryDependencyGraphValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("LibraryDependencyGraphValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid LibraryDependencyGraphValue Attribute Type",
+				"While creating a LibraryDependencyGraphValue value, an invalid attribute value was detected. "+
+					"A LibraryDependencyGraphValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("LibraryDependencyGraphValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("LibraryDependencyGraphValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra LibraryDependencyGraphValue Attribute Value",
+				"While creating a LibraryDependencyGraphValue value, an extra attribute value was detected. "+
+					"A LibraryDependencyGraphValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra LibraryDependencyGraphValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewLibraryDependencyGraphValueUnknown(), diags
+	}
+
+	dependenciesAttribute, ok := attributes["dependencies"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`dependencies is missing from object`)
+
+		return NewLibraryDependencyGraphValueUnknown(), diags
+	}
+
+	dependenciesVal, ok := dependenciesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`dependencies expected to be basetypes.ListValue, was: %T`, dependenciesAttribute))
+	}
+
+	explicitAttribute, ok := attributes["explicit"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`explicit is missing from object`)
+
+		return NewLibraryDependencyGraphValueUnknown(), diags
+	}
+
+	explicitVal, ok := explicitAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`explicit expected to be basetypes.BoolValue, was: %T`, explicitAttribute))
+	}
+
+	libraryReferenceAttribute, ok := attributes["library_reference"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`library_reference is missing from object`)
+
+		return NewLibraryDependencyGraphValueUnknown(), diags
+	}
+
+	libraryReferenceVal, ok := libraryReferenceAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`library_reference expected to be basetypes.StringValue, was: %T`, libraryReferenceAttribute))
+	}
+
+	replacedLibraryReferencesAttribute, ok := attributes["replaced_library_references"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`replaced_library_references is missing from object`)
+
+		return NewLibraryDependencyGraphValueUnknown(), diags
+	}
+
+	replacedLibraryReferencesVal, ok := replacedLibraryReferencesAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`replaced_library_references expected to be basetypes.ListValue, was: %T`, replacedLibraryReferencesAttribute))
+	}
+
+	if diags.HasError() {
+		return NewLibraryDependencyGraphValueUnknown(), diags
+	}
+
+	return LibraryDependencyGraphValue{
+		Dependencies:              dependenciesVal,
+		Explicit:                  explicitVal,
+		LibraryReference:          libraryReferenceVal,
+		ReplacedLibraryReferences: replacedLibraryReferencesVal,
+		state:                     attr.ValueStateKnown,
+	}, diags
+}
+
+func NewLibraryDependencyGraphValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) LibraryDependencyGraphValue {
+	object, diags := NewLibraryDependencyGraphValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewLibraryDependencyGraphValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t LibraryDependencyGraphType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewLibraryDependencyGraphValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewLibraryDependencyGraphValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewLibraryDependencyGraphValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewLibraryDependencyGraphValueMust(LibraryDependencyGraphValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t LibraryDependencyGraphType) ValueType(ctx context.Context) attr.Value {
+	return LibraryDependencyGraphValue{}
+}
+
+var _ basetypes.ObjectValuable = LibraryDependencyGraphValue{}
+
+type LibraryDependencyGraphValue struct {
+	Dependencies              basetypes.ListValue   `tfsdk:"dependencies"`
+	Explicit                  basetypes.BoolValue   `tfsdk:"explicit"`
+	LibraryReference          basetypes.StringValue `tfsdk:"library_reference"`
+	ReplacedLibraryReferences basetypes.ListValue   `tfsdk:"replaced_library_references"`
+	state                     attr.ValueState
+}
+
+func (v LibraryDependencyGraphValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 4)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["dependencies"] = basetypes.ListType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+	attrTypes["explicit"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["library_reference"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["replaced_library_references"] = basetypes.ListType{
+		ElemType: types.StringType,
+	}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 4)
+
+		val, err = v.Dependencies.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["dependencies"] = val
+
+		val, err = v.Explicit.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["explicit"] = val
+
+		val, err = v.LibraryReference.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["library_reference"] = val
+
+		val, err = v.ReplacedLibraryReferences.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["replaced_library_references"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v LibraryDependencyGraphValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v LibraryDependencyGraphValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v LibraryDependencyGraphValue) String() string {
+	return "LibraryDependencyGraphValue"
+}
+
+func (v LibraryDependencyGraphValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var dependenciesVal basetypes.ListValue
+	switch {
+	case v.Dependencies.IsUnknown():
+		dependenciesVal = types.ListUnknown(types.StringType)
+	case v.Dependencies.IsNull():
+		dependenciesVal = types.ListNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		dependenciesVal, d = types.ListValue(types.StringType, v.Dependencies.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"dependencies": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"explicit":          basetypes.BoolType{},
+			"library_reference": basetypes.StringType{},
+			"replaced_library_references": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	var replacedLibraryReferencesVal basetypes.ListValue
+	switch {
+	case v.ReplacedLibraryReferences.IsUnknown():
+		replacedLibraryReferencesVal = types.ListUnknown(types.StringType)
+	case v.ReplacedLibraryReferences.IsNull():
+		replacedLibraryReferencesVal = types.ListNull(types.StringType)
+	default:
+		var d diag.Diagnostics
+		replacedLibraryReferencesVal, d = types.ListValue(types.StringType, v.ReplacedLibraryReferences.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"dependencies": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+			"explicit":          basetypes.BoolType{},
+			"library_reference": basetypes.StringType{},
+			"replaced_library_references": basetypes.ListType{
+				ElemType: types.StringType,
+			},
+		}), diags
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"dependencies": basetypes.ListType{
+			ElemType: types.StringType,
+		},
+		"explicit":          basetypes.BoolType{},
+		"library_reference": basetypes.StringType{},
+		"replaced_library_references": basetypes.ListType{
+			ElemType: types.StringType,
+		},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"dependencies":                dependenciesVal,
+			"explicit":                    v.Explicit,
+			"library_reference":           v.LibraryReference,
+			"replaced_library_references": replacedLibraryReferencesVal,
+		})
+
+	return objVal, diags
+}
+
+func (v LibraryDependencyGraphValue) Equal(o attr.Value) bool {
+	other, ok := o.(LibraryDependencyGraphValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Dependencies.Equal(other.Dependencies) {
+		return false
+	}
+
+	if !v.Explicit.Equal(other.Explicit) {
+		return false
+	}
+
+	if !v.LibraryReference.Equal(other.LibraryReference) {
+		return false
+	}
+
+	if !v.ReplacedLibraryReferences.Equal(other.ReplacedLibraryReferences) {
+		return false
+	}
+
+	return true
+}
+
+func (v LibraryDependencyGraphValue) Type(ctx context.Context) attr.Type {
+	return LibraryDependencyGraphType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v LibraryDependencyGraphValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"dependencies": basetypes.ListType{
+			ElemType: types.StringType,
+		},
+		"explicit":          basetypes.BoolType{},
+		"library_reference": basetypes.StringType{},
+		"replaced_library_references": basetypes.ListType{
+			ElemType: types.StringType,
+		},
+	}
+}
+
+var _ basetypes.ObjectTypable = LibraryOverwritesType{}
+
+type LibraryOverwritesType struct {
+	basetypes.ObjectType
+}
+
+func (t LibraryOverwritesType) Equal(o attr.Type) bool {
+	other, ok := o.(LibraryOverwritesType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t LibraryOverwritesType) String() string {
+	return "LibraryOverwritesType"
+}
+
+func (t LibraryOverwritesType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	assetTypeAttribute, ok := attributes["asset_type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`asset_type is missing from object`)
+
+		return nil, diags
+	}
+
+	assetTypeVal, ok := assetTypeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`asset_type expected to be basetypes.StringValue, was: %T`, assetTypeAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return nil, diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	originalLibraryReferenceAttribute, ok := attributes["original_library_reference"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`original_library_reference is missing from object`)
+
+		return nil, diags
+	}
+
+	originalLibraryReferenceVal, ok := originalLibraryReferenceAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`original_library_reference expected to be basetypes.StringValue, was: %T`, originalLibraryReferenceAttribute))
+	}
+
+	overwritingLibraryReferenceAttribute, ok := attributes["overwriting_library_reference"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`overwriting_library_reference is missing from object`)
+
+		return nil, diags
+	}
+
+	overwritingLibraryReferenceVal, ok := overwritingLibraryReferenceAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`overwriting_library_reference expected to be basetypes.StringValue, was: %T`, overwritingLibraryReferenceAttribute))
+	}
+
+	versionAttribute, ok := attributes["version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`version is missing from object`)
+
+		return nil, diags
+	}
+
+	versionVal, ok := versionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`version expected to be basetypes.StringValue, was: %T`, versionAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return LibraryOverwritesValue{
+		AssetType:                   assetTypeVal,
+		Name:                        nameVal,
+		OriginalLibraryReference:    originalLibraryReferenceVal,
+		OverwritingLibraryReference: overwritingLibraryReferenceVal,
+		Version:                     versionVal,
+		state:                       attr.ValueStateKnown,
+	}, diags
+}
+
+func NewLibraryOverwritesValueNull() LibraryOverwritesValue {
+	return LibraryOverwritesValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewLibraryOverwritesValueUnknown() LibraryOverwritesValue {
+	return LibraryOverwritesValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewLibraryOverwritesValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (LibraryOverwritesValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing LibraryOverwritesValue Attribute Value",
+				"While creating a LibraryOverwritesValue value, a missing attribute value was detected. "+
+					"A LibraryOverwritesValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("LibraryOverwritesValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid LibraryOverwritesValue Attribute Type",
+				"While creating a LibraryOverwritesValue value, an invalid attribute value was detected. "+
+					"A LibraryOverwritesValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("LibraryOverwritesValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("LibraryOverwritesValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra LibraryOverwritesValue Attribute Value",
+				"While creating a LibraryOverwritesValue value, an extra attribute value was detected. "+
+					"A LibraryOverwritesValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra LibraryOverwritesValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewLibraryOverwritesValueUnknown(), diags
+	}
+
+	assetTypeAttribute, ok := attributes["asset_type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`asset_type is missing from object`)
+
+		return NewLibraryOverwritesValueUnknown(), diags
+	}
+
+	assetTypeVal, ok := assetTypeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`asset_type expected to be basetypes.StringValue, was: %T`, assetTypeAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return NewLibraryOverwritesValueUnknown(), diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	originalLibraryReferenceAttribute, ok := attributes["original_library_reference"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`original_library_reference is missing from object`)
+
+		return NewLibraryOverwritesValueUnknown(), diags
+	}
+
+	originalLibraryReferenceVal, ok := originalLibraryReferenceAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`original_library_reference expected to be basetypes.StringValue, was: %T`, originalLibraryReferenceAttribute))
+	}
+
+	overwritingLibraryReferenceAttribute, ok := attributes["overwriting_library_reference"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`overwriting_library_reference is missing from object`)
+
+		return NewLibraryOverwritesValueUnknown(), diags
+	}
+
+	overwritingLibraryReferenceVal, ok := overwritingLibraryReferenceAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`overwriting_library_reference expected to be basetypes.StringValue, was: %T`, overwritingLibraryReferenceAttribute))
+	}
+
+	versionAttribute, ok := attributes["version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`version is missing from object`)
+
+		return NewLibraryOverwritesValueUnknown(), diags
+	}
+
+	versionVal, ok := versionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`version expected to be basetypes.StringValue, was: %T`, versionAttribute))
+	}
+
+	if diags.HasError() {
+		return NewLibraryOverwritesValueUnknown(), diags
+	}
+
+	return LibraryOverwritesValue{
+		AssetType:                   assetTypeVal,
+		Name:                        nameVal,
+		OriginalLibraryReference:    originalLibraryReferenceVal,
+		OverwritingLibraryReference: overwritingLibraryReferenceVal,
+		Version:                     versionVal,
+		state:                       attr.ValueStateKnown,
+	}, diags
+}
+
+func NewLibraryOverwritesValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) LibraryOverwritesValue {
+	object, diags := NewLibraryOverwritesValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewLibraryOverwritesValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t LibraryOverwritesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewLibraryOverwritesValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewLibraryOverwritesValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewLibraryOverwritesValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewLibraryOverwritesValueMust(LibraryOverwritesValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t LibraryOverwritesType) ValueType(ctx context.Context) attr.Value {
+	return LibraryOverwritesValue{}
+}
+
+var _ basetypes.ObjectValuable = LibraryOverwritesValue{}
+
+type LibraryOverwritesValue struct {
+	AssetType                   basetypes.StringValue `tfsdk:"asset_type"`
+	Name                        basetypes.StringValue `tfsdk:"name"`
+	OriginalLibraryReference    basetypes.StringValue `tfsdk:"original_library_reference"`
+	OverwritingLibraryReference basetypes.StringValue `tfsdk:"overwriting_library_reference"`
+	Version                     basetypes.StringValue `tfsdk:"version"`
+	state                       attr.ValueState
+}
+
+func (v LibraryOverwritesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 5)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["asset_type"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["original_library_reference"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["overwriting_library_reference"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["version"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 5)
+
+		val, err = v.AssetType.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["asset_type"] = val
+
+		val, err = v.Name.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["name"] = val
+
+		val, err = v.OriginalLibraryReference.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["original_library_reference"] = val
+
+		val, err = v.OverwritingLibraryReference.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["overwriting_library_reference"] = val
+
+		val, err = v.Version.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["version"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v LibraryOverwritesValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v LibraryOverwritesValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v LibraryOverwritesValue) String() string {
+	return "LibraryOverwritesValue"
+}
+
+func (v LibraryOverwritesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"asset_type":                    basetypes.StringType{},
+		"name":                          basetypes.StringType{},
+		"original_library_reference":    basetypes.StringType{},
+		"overwriting_library_reference": basetypes.StringType{},
+		"version":                       basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"asset_type":                    v.AssetType,
+			"name":                          v.Name,
+			"original_library_reference":    v.OriginalLibraryReference,
+			"overwriting_library_reference": v.OverwritingLibraryReference,
+			"version":                       v.Version,
+		})
+
+	return objVal, diags
+}
+
+func (v LibraryOverwritesValue) Equal(o attr.Value) bool {
+	other, ok := o.(LibraryOverwritesValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.AssetType.Equal(other.AssetType) {
+		return false
+	}
+
+	if !v.Name.Equal(other.Name) {
+		return false
+	}
+
+	if !v.OriginalLibraryReference.Equal(other.OriginalLibraryReference) {
+		return false
+	}
+
+	if !v.OverwritingLibraryReference.Equal(other.OverwritingLibraryReference) {
+		return false
+	}
+
+	if !v.Version.Equal(other.Version) {
+		return false
+	}
+
+	return true
+}
+
+func (v LibraryOverwritesValue) Type(ctx context.Context) attr.Type {
+	return LibraryOverwritesType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v LibraryOverwritesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"asset_type":                    basetypes.StringType{},
+		"name":                          basetypes.StringType{},
+		"original_library_reference":    basetypes.StringType{},
+		"overwriting_library_reference": basetypes.StringType{},
+		"version":                       basetypes.StringType{},
+	}
 }
@@ -0,0 +1,205 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"strings"
+	"testing/fstest"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/terraform-provider-alz/internal/gen"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// archetypeOverrideFilePrefix is prepended to the generated archetype override file names,
+// to avoid conflicts with files in the library.
+const archetypeOverrideFilePrefix = "terraform_provider_alz_"
+
+// libArchetypeOverride is the library file representation of an archetype override.
+type libArchetypeOverride struct {
+	Name                         string   `json:"name"`
+	BaseArchetype                string   `json:"base_archetype"`
+	PolicyAssignmentsToAdd       []string `json:"policy_assignments_to_add"`
+	PolicyAssignmentsToRemove    []string `json:"policy_assignments_to_remove"`
+	PolicyDefinitionsToAdd       []string `json:"policy_definitions_to_add"`
+	PolicyDefinitionsToRemove    []string `json:"policy_definitions_to_remove"`
+	PolicySetDefinitionsToAdd    []string `json:"policy_set_definitions_to_add"`
+	PolicySetDefinitionsToRemove []string `json:"policy_set_definitions_to_remove"`
+	RoleDefinitionsToAdd         []string `json:"role_definitions_to_add"`
+	RoleDefinitionsToRemove      []string `json:"role_definitions_to_remove"`
+}
+
+// archetypeOverridesToFS converts the supplied archetype overrides to a filesystem containing
+// one archetype override file per override.
+// If there are no archetype overrides, a nil filesystem is returned.
+func archetypeOverridesToFS(ctx context.Context, src types.List) (fs.FS, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if src.IsNull() || src.IsUnknown() || len(src.Elements()) == 0 {
+		return nil, diags
+	}
+
+	overrides := make([]gen.ArchetypeOverridesValue, 0, len(src.Elements()))
+	diags.Append(src.ElementsAs(ctx, &overrides, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	res := make(fstest.MapFS, len(overrides))
+	for _, ovr := range overrides {
+		lib := libArchetypeOverride{
+			Name:                         ovr.Name.ValueString(),
+			BaseArchetype:                ovr.BaseArchetype.ValueString(),
+			PolicyAssignmentsToAdd:       setToSortedStrings(ctx, ovr.PolicyAssignmentsToAdd, &diags),
+			PolicyAssignmentsToRemove:    setToSortedStrings(ctx, ovr.PolicyAssignmentsToRemove, &diags),
+			PolicyDefinitionsToAdd:       setToSortedStrings(ctx, ovr.PolicyDefinitionsToAdd, &diags),
+			PolicyDefinitionsToRemove:    setToSortedStrings(ctx, ovr.PolicyDefinitionsToRemove, &diags),
+			PolicySetDefinitionsToAdd:    setToSortedStrings(ctx, ovr.PolicySetDefinitionsToAdd, &diags),
+			PolicySetDefinitionsToRemove: setToSortedStrings(ctx, ovr.PolicySetDefinitionsToRemove, &diags),
+			RoleDefinitionsToAdd:         setToSortedStrings(ctx, ovr.RoleDefinitionsToAdd, &diags),
+			RoleDefinitionsToRemove:      setToSortedStrings(ctx, ovr.RoleDefinitionsToRemove, &diags),
+		}
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		fileName := fmt.Sprintf("%s%s.alz_archetype_override.json", archetypeOverrideFilePrefix, lib.Name)
+		if _, exists := res[fileName]; exists {
+			diags.AddError(
+				"Invalid archetype overrides",
+				fmt.Sprintf("Archetype override `%s` is defined more than once", lib.Name),
+			)
+			return nil, diags
+		}
+
+		data, err := json.Marshal(lib)
+		if err != nil {
+			diags.AddError(
+				"Invalid archetype overrides",
+				fmt.Sprintf("Failed to marshal archetype override `%s`: %s", lib.Name, err.Error()),
+			)
+			return nil, diags
+		}
+		res[fileName] = &fstest.MapFile{Data: data}
+	}
+	return res, diags
+}
+
+// setToSortedStrings converts a set of strings to a sorted slice.
+// Null and unknown sets return an empty slice.
+func setToSortedStrings(ctx context.Context, src types.Set, diags *diag.Diagnostics) []string {
+	res := make([]string, 0, len(src.Elements()))
+	if src.IsNull() || src.IsUnknown() {
+		return res
+	}
+	diags.Append(src.ElementsAs(ctx, &res, false)...)
+	slices.Sort(res)
+	return res
+}
+
+// addArchetypeOverridesToLibraryReferences adds the archetype override files to the last library reference,
+// so that they are processed with that library.
+// This means that the architectures in that library can reference the overrides.
+func addArchetypeOverridesToLibraryReferences(ctx context.Context, libRefs alzlib.LibraryReferences, overrides fs.FS) (alzlib.LibraryReferences, error) {
+	if overrides == nil || len(libRefs) == 0 {
+		return libRefs, nil
+	}
+
+	last := libRefs[len(libRefs)-1]
+	libFS, err := fetchLibrary(ctx, last)
+	if err != nil {
+		return nil, fmt.Errorf("fetching library %s: %w", last, err)
+	}
+
+	merged := overlayFS{base: libFS, overlay: overrides}
+	res := slices.Clone(libRefs)
+	switch ref := last.(type) {
+	case *alzlib.AlzLibraryReference:
+		res[len(res)-1] = alzlib.NewAlzLibraryReferenceFromFS(ref.Path(), ref.Ref(), merged)
+	case *alzlib.CustomLibraryReference:
+		res[len(res)-1] = alzlib.NewCustomLibraryReferenceFromFS(ref.String(), merged)
+	default:
+		return nil, fmt.Errorf("unsupported library reference type %T", last)
+	}
+	return res, nil
+}
+
+// overlayFS is a filesystem that serves files from overlay in preference to base.
+// Directory listings contain the entries from both filesystems.
+type overlayFS struct {
+	base    fs.FS
+	overlay fs.FS
+}
+
+var _ fs.ReadDirFS = overlayFS{}
+
+// Open opens the named file from the overlay, falling back to the base filesystem.
+// Directories that exist in the overlay list the entries from both filesystems.
+func (o overlayFS) Open(name string) (fs.File, error) {
+	f, err := o.overlay.Open(name)
+	if err != nil {
+		return o.base.Open(name)
+	}
+	st, err := f.Stat()
+	if err != nil || !st.IsDir() {
+		return f, err
+	}
+	entries, err := o.ReadDir(name)
+	if err != nil {
+		f.Close() // nolint: errcheck
+		return nil, err
+	}
+	return &overlayDir{File: f, entries: entries}, nil
+}
+
+// ReadDir returns the merged directory entries of both filesystems, sorted by name.
+func (o overlayFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	baseEntries, baseErr := fs.ReadDir(o.base, name)
+	overlayEntries, overlayErr := fs.ReadDir(o.overlay, name)
+	if baseErr != nil && overlayErr != nil {
+		return nil, errors.Join(baseErr, overlayErr)
+	}
+
+	res := slices.Clone(overlayEntries)
+	for _, e := range baseEntries {
+		if !slices.ContainsFunc(overlayEntries, func(oe fs.DirEntry) bool { return oe.Name() == e.Name() }) {
+			res = append(res, e)
+		}
+	}
+	slices.SortFunc(res, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	return res, nil
+}
+
+// overlayDir is a directory in an overlayFS, listing the merged entries.
+type overlayDir struct {
+	fs.File
+	entries []fs.DirEntry
+	offset  int
+}
+
+var _ fs.ReadDirFile = (*overlayDir)(nil)
+
+// ReadDir implements fs.ReadDirFile.
+func (d *overlayDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	rest := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return rest, nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	n = min(n, len(rest))
+	d.offset += n
+	return rest[:n], nil
+}
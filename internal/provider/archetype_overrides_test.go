@@ -0,0 +1,134 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/terraform-provider-alz/internal/gen"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newArchetypeOverridesList returns a list of archetype overrides, each with the given name and base archetype
+// and a single policy assignment to remove.
+func newArchetypeOverridesList(ctx context.Context, t *testing.T, names ...string) types.List {
+	t.Helper()
+	emptySet := types.SetNull(types.StringType)
+	vals := make([]attr.Value, len(names))
+	for i, name := range names {
+		v, diags := gen.NewArchetypeOverridesValue(
+			gen.NewArchetypeOverridesValueNull().AttributeTypes(ctx),
+			map[string]attr.Value{
+				"name":                             types.StringValue(name),
+				"base_archetype":                   types.StringValue("base"),
+				"policy_assignments_to_add":        emptySet,
+				"policy_assignments_to_remove":     types.SetValueMust(types.StringType, []attr.Value{types.StringValue("pa2"), types.StringValue("pa1")}),
+				"policy_definitions_to_add":        emptySet,
+				"policy_definitions_to_remove":     emptySet,
+				"policy_set_definitions_to_add":    emptySet,
+				"policy_set_definitions_to_remove": emptySet,
+				"role_definitions_to_add":          emptySet,
+				"role_definitions_to_remove":       emptySet,
+			},
+		)
+		require.False(t, diags.HasError(), diags)
+		vals[i] = v
+	}
+	return types.ListValueMust(gen.NewArchetypeOverridesValueNull().Type(ctx), vals)
+}
+
+func TestArchetypeOverridesToFS(t *testing.T) {
+	ctx := context.Background()
+
+	res, diags := archetypeOverridesToFS(ctx, types.ListNull(gen.NewArchetypeOverridesValueNull().Type(ctx)))
+	assert.False(t, diags.HasError())
+	assert.Nil(t, res)
+
+	res, diags = archetypeOverridesToFS(ctx, newArchetypeOverridesList(ctx, t, "derived"))
+	require.False(t, diags.HasError(), diags)
+	data, err := fs.ReadFile(res, "terraform_provider_alz_derived.alz_archetype_override.json")
+	require.NoError(t, err)
+
+	var lib libArchetypeOverride
+	require.NoError(t, json.Unmarshal(data, &lib))
+	assert.Equal(t, "derived", lib.Name)
+	assert.Equal(t, "base", lib.BaseArchetype)
+	assert.Equal(t, []string{"pa1", "pa2"}, lib.PolicyAssignmentsToRemove)
+	assert.Equal(t, []string{}, lib.PolicyAssignmentsToAdd)
+
+	_, diags = archetypeOverridesToFS(ctx, newArchetypeOverridesList(ctx, t, "derived", "derived"))
+	assert.True(t, diags.HasError())
+}
+
+func TestOverlayFS(t *testing.T) {
+	base := fstest.MapFS{
+		"a.json":     &fstest.MapFile{Data: []byte("base a")},
+		"b.json":     &fstest.MapFile{Data: []byte("base b")},
+		"sub/c.json": &fstest.MapFile{Data: []byte("base c")},
+	}
+	overlay := fstest.MapFS{
+		"b.json": &fstest.MapFile{Data: []byte("overlay b")},
+		"d.json": &fstest.MapFile{Data: []byte("overlay d")},
+	}
+	o := overlayFS{base: base, overlay: overlay}
+
+	assert.NoError(t, fstest.TestFS(o, "a.json", "b.json", "d.json", "sub/c.json"))
+
+	data, err := fs.ReadFile(o, "b.json")
+	require.NoError(t, err)
+	assert.Equal(t, "overlay b", string(data))
+
+	entries, err := fs.ReadDir(o, ".")
+	require.NoError(t, err)
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Name()
+	}
+	assert.Equal(t, []string{"a.json", "b.json", "d.json", "sub"}, names)
+}
+
+// TestAddArchetypeOverridesToLibraryReferences verifies that an architecture in the last library
+// can reference an archetype override supplied in the provider configuration.
+func TestAddArchetypeOverridesToLibraryReferences(t *testing.T) {
+	ctx := context.Background()
+	lib := fstest.MapFS{
+		"base.alz_archetype_definition.json": &fstest.MapFile{Data: []byte(`{"name": "base"}`)},
+		"test.alz_architecture_definition.json": &fstest.MapFile{Data: []byte(`{
+  "name": "test",
+  "management_groups": [
+    {"id": "test", "display_name": "Test", "archetypes": ["derived"], "parent_id": null, "exists": false}
+  ]
+}`)},
+	}
+	ovr := fstest.MapFS{
+		"terraform_provider_alz_derived.alz_archetype_override.json": &fstest.MapFile{Data: []byte(`{"name": "derived", "base_archetype": "base"}`)},
+	}
+
+	// Without the overrides the architecture cannot be processed.
+	assert.Error(t, alzlib.NewAlzLib(nil).Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("test", lib)))
+
+	libRefs, err := addArchetypeOverridesToLibraryReferences(ctx, alzlib.LibraryReferences{alzlib.NewCustomLibraryReferenceFromFS("test", lib)}, ovr)
+	require.NoError(t, err)
+	require.Len(t, libRefs, 1)
+	assert.Equal(t, "test", libRefs[0].String())
+
+	az := alzlib.NewAlzLib(nil)
+	require.NoError(t, az.Init(ctx, libRefs...))
+	assert.NotNil(t, az.Archetype("derived"))
+	assert.NotNil(t, az.Architecture("test"))
+
+	// Nil overrides leave the library references unchanged.
+	orig := alzlib.LibraryReferences{alzlib.NewCustomLibraryReferenceFromFS("test", lib)}
+	libRefs, err = addArchetypeOverridesToLibraryReferences(ctx, orig, nil)
+	require.NoError(t, err)
+	assert.Equal(t, orig, libRefs)
+}
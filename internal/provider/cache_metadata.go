@@ -0,0 +1,188 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+)
+
+const (
+	// cacheFileMetadataKey is the key of the metadata in the cache file JSON.
+	// The key is ignored by alzlib, so cache files with metadata can still be read by alzlibtool.
+	cacheFileMetadataKey = "metadata"
+
+	// cacheFileContentHashPrefix identifies the hash algorithm of the content hash.
+	cacheFileContentHashPrefix = "sha256:"
+
+	alzlibModulePath = "github.com/Azure/alzlib"
+)
+
+// errCacheFileExpired is returned when the cache file is older than the maximum age.
+var errCacheFileExpired = errors.New("cache file has expired")
+
+// cacheFileMetadata records where and when a cache file was created.
+type cacheFileMetadata struct {
+	// Cloud is the Azure Resource Manager endpoint of the cloud that the definitions were fetched from.
+	Cloud           string    `json:"cloud"`
+	CreatedAt       time.Time `json:"createdAt"`
+	ProviderVersion string    `json:"providerVersion"`
+	AlzlibVersion   string    `json:"alzlibVersion"`
+	// ContentHash is the hash of the cache file JSON without the metadata.
+	ContentHash string `json:"contentHash"`
+}
+
+// newCacheFileMetadata returns the metadata of a cache file created by this provider for the cloud.
+// The creation time and content hash are set when the cache file is saved.
+func newCacheFileMetadata(cloudConfig cloud.Configuration, providerVersion string) cacheFileMetadata {
+	return cacheFileMetadata{
+		Cloud:           cacheFileCloud(cloudConfig),
+		ProviderVersion: providerVersion,
+		AlzlibVersion:   alzlibVersion(),
+	}
+}
+
+// cacheFileCloud returns the normalized Azure Resource Manager endpoint of the cloud, which identifies it.
+func cacheFileCloud(cloudConfig cloud.Configuration) string {
+	return strings.TrimSuffix(strings.ToLower(cloudConfig.Services[cloud.ResourceManager].Endpoint), "/")
+}
+
+// alzlibVersion returns the version of alzlib that the provider is built with.
+func alzlibVersion() string {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	for _, dep := range bi.Deps {
+		if dep.Path != alzlibModulePath {
+			continue
+		}
+		if dep.Replace != nil && dep.Replace.Version != "" {
+			return dep.Replace.Version
+		}
+		return dep.Version
+	}
+	return "unknown"
+}
+
+// check checks the metadata of a cache file against the metadata of a cache file that would be created now.
+// It returns errCacheFileExpired if the cache file is older than maxAge at now, unless maxAge is zero.
+// A different cloud is an error, as the built-in definitions are different; different versions are warnings.
+func (m cacheFileMetadata) check(want cacheFileMetadata, maxAge time.Duration, now time.Time) ([]string, error) {
+	if m.Cloud != want.Cloud {
+		return nil, fmt.Errorf("cache file was created for cloud %q, but the provider is configured for cloud %q", m.Cloud, want.Cloud)
+	}
+	var warnings []string
+	if m.ProviderVersion != want.ProviderVersion {
+		warnings = append(warnings, fmt.Sprintf("cache file was created by provider version %q, but this is version %q", m.ProviderVersion, want.ProviderVersion))
+	}
+	if m.AlzlibVersion != want.AlzlibVersion {
+		warnings = append(warnings, fmt.Sprintf("cache file was created by alzlib version %q, but this is version %q", m.AlzlibVersion, want.AlzlibVersion))
+	}
+	if err := checkCacheFileAge(m.CreatedAt, now, maxAge); err != nil {
+		return warnings, err
+	}
+	return warnings, nil
+}
+
+// checkCacheFileAge returns errCacheFileExpired if the cache file created at createdAt is older than maxAge at now,
+// unless maxAge is zero.
+func checkCacheFileAge(createdAt, now time.Time, maxAge time.Duration) error {
+	if maxAge == 0 {
+		return nil
+	}
+	if age := now.Sub(createdAt); age > maxAge {
+		return fmt.Errorf("%w: created at %s, which is more than %s ago", errCacheFileExpired, createdAt.Format(time.RFC3339), maxAge)
+	}
+	return nil
+}
+
+// addCacheFileMetadata writes the gzipped cache file JSON to w, with the metadata added.
+// The content hash and, if not set, the creation time of the metadata are set.
+func addCacheFileMetadata(w io.Writer, cacheFile []byte, meta cacheFileMetadata) error {
+	content, err := decodeCacheFile(cacheFile)
+	if err != nil {
+		return err
+	}
+	delete(content, cacheFileMetadataKey)
+	if meta.ContentHash, err = cacheFileContentHash(content); err != nil {
+		return err
+	}
+	if meta.CreatedAt.IsZero() {
+		meta.CreatedAt = time.Now().UTC()
+	}
+	raw, err := json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("encoding cache file metadata: %w", err)
+	}
+	content[cacheFileMetadataKey] = raw
+
+	gw := gzip.NewWriter(w)
+	if err := json.NewEncoder(gw).Encode(content); err != nil {
+		return fmt.Errorf("encoding cache file: %w", err)
+	}
+	return gw.Close()
+}
+
+// readCacheFileMetadata returns the metadata of the gzipped cache file JSON, after verifying the content hash.
+// It returns nil if the cache file has no metadata, e.g. if it was created by alzlibtool.
+func readCacheFileMetadata(cacheFile []byte) (*cacheFileMetadata, error) {
+	content, err := decodeCacheFile(cacheFile)
+	if err != nil {
+		return nil, err
+	}
+	raw, ok := content[cacheFileMetadataKey]
+	if !ok {
+		return nil, nil
+	}
+	var meta cacheFileMetadata
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		return nil, fmt.Errorf("decoding cache file metadata: %w", err)
+	}
+	delete(content, cacheFileMetadataKey)
+	hash, err := cacheFileContentHash(content)
+	if err != nil {
+		return nil, err
+	}
+	if hash != meta.ContentHash {
+		return nil, fmt.Errorf("cache file content hash %s does not match the hash in the metadata %s, the cache file has been modified", hash, meta.ContentHash)
+	}
+	return &meta, nil
+}
+
+// decodeCacheFile decodes the top level keys of the gzipped cache file JSON.
+func decodeCacheFile(cacheFile []byte) (map[string]json.RawMessage, error) {
+	gr, err := gzip.NewReader(bytes.NewReader(cacheFile))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer gr.Close() // #nosec G307 -- read-only.
+	var content map[string]json.RawMessage
+	if err := json.NewDecoder(gr).Decode(&content); err != nil {
+		return nil, fmt.Errorf("decoding cache file: %w", err)
+	}
+	return content, nil
+}
+
+// cacheFileContentHash returns the hash of the cache file JSON.
+// Maps are encoded with sorted keys, so the hash does not depend on the order of the keys in the file.
+func cacheFileContentHash(content map[string]json.RawMessage) (string, error) {
+	data, err := json.Marshal(content)
+	if err != nil {
+		return "", fmt.Errorf("encoding cache file: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return cacheFileContentHashPrefix + hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,143 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/alzlib/cache"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheFileMetadataCheck(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	want := cacheFileMetadata{
+		Cloud:           "https://management.azure.com",
+		ProviderVersion: "1.0.0",
+		AlzlibVersion:   "v0.30.1",
+	}
+	testCases := []struct {
+		name             string
+		meta             cacheFileMetadata
+		maxAge           time.Duration
+		expectedWarnings []string
+		expectedErr      string
+		expired          bool
+	}{
+		{
+			name:   "Match",
+			meta:   cacheFileMetadata{Cloud: want.Cloud, CreatedAt: now.Add(-time.Hour), ProviderVersion: "1.0.0", AlzlibVersion: "v0.30.1"},
+			maxAge: 2 * time.Hour,
+		},
+		{
+			name:        "Different cloud",
+			meta:        cacheFileMetadata{Cloud: "https://management.usgovcloudapi.net", CreatedAt: now, ProviderVersion: "1.0.0", AlzlibVersion: "v0.30.1"},
+			expectedErr: `cache file was created for cloud "https://management.usgovcloudapi.net", but the provider is configured for cloud "https://management.azure.com"`,
+		},
+		{
+			name: "Different versions",
+			meta: cacheFileMetadata{Cloud: want.Cloud, CreatedAt: now, ProviderVersion: "0.9.0", AlzlibVersion: "v0.29.0"},
+			expectedWarnings: []string{
+				`cache file was created by provider version "0.9.0", but this is version "1.0.0"`,
+				`cache file was created by alzlib version "v0.29.0", but this is version "v0.30.1"`,
+			},
+		},
+		{
+			name:        "Expired",
+			meta:        cacheFileMetadata{Cloud: want.Cloud, CreatedAt: now.Add(-49 * time.Hour), ProviderVersion: "1.0.0", AlzlibVersion: "v0.30.1"},
+			maxAge:      48 * time.Hour,
+			expectedErr: "cache file has expired: created at 2025-05-29T23:00:00Z, which is more than 48h0m0s ago",
+			expired:     true,
+		},
+		{
+			name: "No maximum age",
+			meta: cacheFileMetadata{Cloud: want.Cloud, CreatedAt: now.Add(-1000 * time.Hour), ProviderVersion: "1.0.0", AlzlibVersion: "v0.30.1"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings, err := tc.meta.check(want, tc.maxAge, now)
+			assert.Equal(t, tc.expectedWarnings, warnings)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expired, errors.Is(err, errCacheFileExpired))
+		})
+	}
+}
+
+func TestLoadCacheFileMetadata(t *testing.T) {
+	ctx := t.Context()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "alzlib-cache.json.gz")
+	meta := newCacheFileMetadata(cloud.AzurePublic, "test")
+	require.NoError(t, saveCacheFile(ctx, alzlib.NewAlzLib(nil).ExportBuiltInCache(), path, meta))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	saved, err := readCacheFileMetadata(data)
+	require.NoError(t, err)
+	assert.Equal(t, "https://management.azure.com", saved.Cloud)
+	assert.Equal(t, "test", saved.ProviderVersion)
+	assert.NotEmpty(t, saved.AlzlibVersion)
+	assert.WithinDuration(t, time.Now(), saved.CreatedAt, time.Minute)
+	assert.Regexp(t, `^sha256:[0-9a-f]{64}$`, saved.ContentHash)
+
+	// The metadata does not stop alzlib reading the cache file.
+	_, err = cache.NewCache(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	_, _, err = loadCacheFile(ctx, alzlib.NewAlzLib(nil), path, newCacheFileMetadata(cloud.AzureGovernment, "test"), 0)
+	assert.ErrorContains(t, err, "but the provider is configured for cloud \"https://management.usgovcloudapi.net\"")
+
+	c, warnings, err := loadCacheFile(ctx, alzlib.NewAlzLib(nil), path, newCacheFileMetadata(cloud.AzurePublic, "1.0.0"), time.Hour)
+	require.NoError(t, err)
+	assert.NotNil(t, c)
+	assert.Equal(t, []string{`cache file was created by provider version "test", but this is version "1.0.0"`}, warnings)
+
+	// A cache file that has been modified is rejected.
+	content, err := decodeCacheFile(data)
+	require.NoError(t, err)
+	content["policyDefinitions"] = json.RawMessage(`{"injected": {}}`)
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	require.NoError(t, json.NewEncoder(gw).Encode(content))
+	require.NoError(t, gw.Close())
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+	_, _, err = loadCacheFile(ctx, alzlib.NewAlzLib(nil), path, meta, 0)
+	assert.ErrorContains(t, err, "the cache file has been modified")
+}
+
+func TestLoadCacheFileWithoutMetadata(t *testing.T) {
+	ctx := t.Context()
+	path := filepath.Join(t.TempDir(), "alzlib-cache.json.gz")
+	meta := newCacheFileMetadata(cloud.AzurePublic, "test")
+
+	// A cache file created by alzlibtool has no metadata, so only its age is checked.
+	var buf bytes.Buffer
+	require.NoError(t, alzlib.NewAlzLib(nil).ExportBuiltInCache().Save(&buf))
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+	c, warnings, err := loadCacheFile(ctx, alzlib.NewAlzLib(nil), path, newCacheFileMetadata(cloud.AzureChina, "test"), time.Hour)
+	require.NoError(t, err)
+	assert.NotNil(t, c)
+	assert.Empty(t, warnings)
+
+	old := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(path, old, old))
+	c, _, err = loadCacheFile(ctx, alzlib.NewAlzLib(nil), path, meta, time.Hour)
+	assert.ErrorIs(t, err, errCacheFileExpired)
+	assert.Nil(t, c)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/alzlib/assets"
+	"github.com/Azure/alzlib/cache"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// cacheFileSaver saves the built-in definitions to the cache file after each hierarchy has been built.
+// The provider has no hook at the end of a run, so the cache file is saved after each `alz_architecture` read,
+// and is only written when its content has changed. Without pruning, the definitions in the loaded cache file
+// are kept. With pruning, the cache file only has the definitions referenced by the hierarchies built in this run.
+type cacheFileSaver struct {
+	mu    sync.Mutex
+	alz   *alzlib.AlzLib
+	path  string
+	meta  cacheFileMetadata
+	prune bool
+	// loaded is the cache loaded from the cache file.
+	loaded *cache.Cache
+	// referenced is the union of the definitions referenced by the hierarchies built in this run.
+	referenced map[string]alzlib.BuiltInRequest
+	// savedHash is the content hash of the cache file as last saved or loaded.
+	savedHash string
+}
+
+// newCacheFileSaver returns a cacheFileSaver for the cache file at path.
+// The loaded cache, which may be nil, is the cache that was loaded from the file and is up to date,
+// so the file is not rewritten unless the content changes.
+func newCacheFileSaver(alz *alzlib.AlzLib, path string, meta cacheFileMetadata, prune bool, loaded *cache.Cache) (*cacheFileSaver, error) {
+	s := &cacheFileSaver{
+		alz:        alz,
+		path:       path,
+		meta:       meta,
+		prune:      prune,
+		loaded:     loaded,
+		referenced: make(map[string]alzlib.BuiltInRequest),
+	}
+	if loaded != nil {
+		hash, err := cacheContentHash(loaded)
+		if err != nil {
+			return nil, err
+		}
+		s.savedHash = hash
+	}
+	return s, nil
+}
+
+// save adds the referenced definitions to those referenced in this run and saves the cache file, if it has changed.
+func (s *cacheFileSaver) save(ctx context.Context, referenced []alzlib.BuiltInRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, req := range referenced {
+		s.referenced[req.String()] = req
+	}
+
+	// The referenced definitions are looked up in both the loaded cache and alzlib, as definitions that are
+	// in the loaded cache are not fetched again.
+	c, err := mergeBuiltInCaches(s.loaded, s.alz.ExportBuiltInCache())
+	if err != nil {
+		return err
+	}
+	if s.prune {
+		c = pruneBuiltInCache(c, s.referenced)
+	}
+
+	hash, err := cacheContentHash(c)
+	if err != nil {
+		return err
+	}
+	if hash == s.savedHash {
+		tflog.Debug(ctx, "Cache file is up to date, skipping save", map[string]interface{}{
+			"cache_file_name": s.path,
+		})
+		return nil
+	}
+	if err := saveCacheFile(ctx, c, s.path, s.meta); err != nil {
+		return err
+	}
+	s.savedHash = hash
+	return nil
+}
+
+// pruneBuiltInCache returns a cache with only the versions of the definitions in c that match the referenced
+// definitions. Referenced definitions that are not in c, e.g. those from the library, are ignored.
+func pruneBuiltInCache(c *cache.Cache, referenced map[string]alzlib.BuiltInRequest) *cache.Cache {
+	pds := make(map[string]*assets.PolicyDefinitionVersions)
+	psds := make(map[string]*assets.PolicySetDefinitionVersions)
+	for _, req := range referenced {
+		name := req.ResourceID.Name
+		switch strings.ToLower(req.ResourceID.ResourceType.Type) {
+		case alzlib.PolicyDefinitionsType:
+			pdvs := c.PolicyDefinitionVersionsByName(name)
+			if pdvs == nil {
+				continue
+			}
+			pd, err := pdvs.GetVersion(req.Version)
+			if err != nil {
+				continue
+			}
+			if _, ok := pds[name]; !ok {
+				pds[name] = assets.NewPolicyDefinitionVersions()
+			}
+			// The versions come from the same collection, so cannot conflict.
+			_ = pds[name].Add(pd, true)
+		case alzlib.PolicySetDefinitionsType:
+			psdvs := c.PolicySetDefinitionVersionsByName(name)
+			if psdvs == nil {
+				continue
+			}
+			psd, err := psdvs.GetVersion(req.Version)
+			if err != nil {
+				continue
+			}
+			if _, ok := psds[name]; !ok {
+				psds[name] = assets.NewPolicySetDefinitionVersions()
+			}
+			_ = psds[name].Add(psd, true)
+		}
+	}
+	return cache.NewCacheFromDefinitions(pds, psds)
+}
+
+// mergeBuiltInCaches returns a cache with the definitions in both caches.
+// Definitions in exported replace those with the same name and version in loaded, which may be nil.
+func mergeBuiltInCaches(loaded, exported *cache.Cache) (*cache.Cache, error) {
+	pds := make(map[string]*assets.PolicyDefinitionVersions)
+	psds := make(map[string]*assets.PolicySetDefinitionVersions)
+	for _, c := range []*cache.Cache{loaded, exported} {
+		if c == nil {
+			continue
+		}
+		for name, pdvs := range c.PolicyDefinitions() {
+			if _, ok := pds[name]; !ok {
+				pds[name] = assets.NewPolicyDefinitionVersions()
+			}
+			if err := pds[name].Upsert(pdvs, true); err != nil {
+				return nil, fmt.Errorf("merging policy definition %s into cache: %w", name, err)
+			}
+		}
+		for name, psdvs := range c.PolicySetDefinitions() {
+			if _, ok := psds[name]; !ok {
+				psds[name] = assets.NewPolicySetDefinitionVersions()
+			}
+			if err := psds[name].Upsert(psdvs, true); err != nil {
+				return nil, fmt.Errorf("merging policy set definition %s into cache: %w", name, err)
+			}
+		}
+	}
+	return cache.NewCacheFromDefinitions(pds, psds), nil
+}
+
+// cacheContentHash returns the content hash of the cache, as recorded in the cache file metadata.
+func cacheContentHash(c *cache.Cache) (string, error) {
+	var buf bytes.Buffer
+	if err := c.Save(&buf); err != nil {
+		return "", fmt.Errorf("saving cache: %w", err)
+	}
+	content, err := decodeCacheFile(buf.Bytes())
+	if err != nil {
+		return "", err
+	}
+	return cacheFileContentHash(content)
+}
@@ -0,0 +1,174 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCacheSaveTestAlzLib returns an AlzLib with the built-in definitions, as if they had been fetched from Azure.
+func newCacheSaveTestAlzLib(t *testing.T, defs map[string]string) *alzlib.AlzLib {
+	t.Helper()
+	lib := fstest.MapFS{}
+	for name, data := range defs {
+		lib[name] = &fstest.MapFile{Data: []byte(data)}
+	}
+	alz := alzlib.NewAlzLib(nil)
+	require.NoError(t, alz.Init(t.Context(), alzlib.NewCustomLibraryReferenceFromFS("builtin", lib)))
+	return alz
+}
+
+// newBuiltInRequest returns a request for the built-in definition of the resource type, e.g. policyDefinitions.
+func newBuiltInRequest(t *testing.T, resourceType, name string, version *string) alzlib.BuiltInRequest {
+	t.Helper()
+	resID, err := arm.ParseResourceID("/providers/Microsoft.Authorization/" + resourceType + "/" + name)
+	require.NoError(t, err)
+	return alzlib.BuiltInRequest{ResourceID: resID, Version: version}
+}
+
+// cacheSaveTestPolicyDefinition returns the JSON of a policy definition with the policy type and version.
+func cacheSaveTestPolicyDefinition(name, policyType, version string) string {
+	return fmt.Sprintf(`{"name": %[1]q, "type": "Microsoft.Authorization/policyDefinitions", "properties": {
+		"displayName": %[1]q, "description": %[1]q, "policyType": %[2]q, "mode": "All", "version": %[3]q, "metadata": {},
+		"parameters": {}, "policyRule": {"if": {"field": "type", "equals": "Microsoft.Storage/storageAccounts"}, "then": {"effect": "audit"}}
+	}}`, name, policyType, version)
+}
+
+var cacheSaveTestDefinitions = map[string]string{
+	"a1.alz_policy_definition.json": cacheSaveTestPolicyDefinition("a", "BuiltIn", "1.0.0"),
+	"a2.alz_policy_definition.json": cacheSaveTestPolicyDefinition("a", "BuiltIn", "2.0.0"),
+	"b.alz_policy_definition.json":  cacheSaveTestPolicyDefinition("b", "BuiltIn", "1.0.0"),
+	"c.alz_policy_definition.json":  cacheSaveTestPolicyDefinition("c", "Custom", "1.0.0"),
+	"s.alz_policy_set_definition.json": `{"name": "s", "type": "Microsoft.Authorization/policySetDefinitions", "properties": {
+		"displayName": "s", "description": "s", "policyType": "BuiltIn", "version": "1.0.0", "metadata": {}, "parameters": {},
+		"policyDefinitions": [
+			{"policyDefinitionId": "/providers/Microsoft.Authorization/policyDefinitions/b", "policyDefinitionReferenceId": "b", "definitionVersion": "1.*.*", "parameters": {}}
+		]
+	}}`,
+}
+
+func TestPruneBuiltInCache(t *testing.T) {
+	alz := newCacheSaveTestAlzLib(t, cacheSaveTestDefinitions)
+	referenced := make(map[string]alzlib.BuiltInRequest)
+	for _, req := range []alzlib.BuiltInRequest{
+		newBuiltInRequest(t, "policyDefinitions", "a", to.Ptr("2.*.*")),
+		newBuiltInRequest(t, "policyDefinitions", "c", nil),
+		newBuiltInRequest(t, "policyDefinitions", "missing", nil),
+		newBuiltInRequest(t, "policySetDefinitions", "s", nil),
+	} {
+		referenced[req.String()] = req
+	}
+
+	c := pruneBuiltInCache(alz.ExportBuiltInCache(), referenced)
+	// Only the referenced version of a is kept, the unreferenced b is removed,
+	// and the custom definition c is not a built-in definition.
+	assert.Equal(t, 1, c.PolicyDefinitionCount())
+	assert.Equal(t, []string{"2.0.0"}, versionStrings(c.PolicyDefinitionVersionsForName("a")))
+	assert.Nil(t, c.PolicyDefinitionVersionsByName("b"))
+	assert.Nil(t, c.PolicyDefinitionVersionsByName("c"))
+	assert.Equal(t, 1, c.PolicySetDefinitionCount())
+}
+
+func TestCacheFileSaver(t *testing.T) {
+	ctx := t.Context()
+	path := filepath.Join(t.TempDir(), "alzlib-cache.json.gz")
+	meta := newCacheFileMetadata(cloud.AzurePublic, "test")
+	alz := newCacheSaveTestAlzLib(t, cacheSaveTestDefinitions)
+
+	// With pruning, the union of the definitions referenced by each save is kept.
+	saver, err := newCacheFileSaver(alz, path, meta, true, nil)
+	require.NoError(t, err)
+	require.NoError(t, saver.save(ctx, []alzlib.BuiltInRequest{newBuiltInRequest(t, "policyDefinitions", "a", to.Ptr("1.*.*"))}))
+	require.NoError(t, saver.save(ctx, []alzlib.BuiltInRequest{newBuiltInRequest(t, "policyDefinitions", "b", nil)}))
+	loaded, _, err := loadCacheFile(ctx, alzlib.NewAlzLib(nil), path, meta, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, loaded.PolicyDefinitionCount())
+	assert.Equal(t, 0, loaded.PolicySetDefinitionCount())
+
+	// The cache file is not rewritten if it is up to date.
+	old := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(path, old, old))
+	saver, err = newCacheFileSaver(alz, path, meta, true, loaded)
+	require.NoError(t, err)
+	require.NoError(t, saver.save(ctx, []alzlib.BuiltInRequest{
+		newBuiltInRequest(t, "policyDefinitions", "a", to.Ptr("1.*.*")),
+		newBuiltInRequest(t, "policyDefinitions", "b", nil),
+	}))
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, old, fi.ModTime())
+
+	// Without pruning, the definitions in the loaded cache file are kept and the built-in definitions are added.
+	saver, err = newCacheFileSaver(newCacheSaveTestAlzLib(t, map[string]string{
+		"s.alz_policy_set_definition.json": cacheSaveTestDefinitions["s.alz_policy_set_definition.json"],
+	}), path, meta, false, loaded)
+	require.NoError(t, err)
+	require.NoError(t, saver.save(ctx, nil))
+	merged, _, err := loadCacheFile(ctx, alzlib.NewAlzLib(nil), path, meta, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, merged.PolicyDefinitionCount())
+	assert.Equal(t, 1, merged.PolicySetDefinitionCount())
+}
+
+func TestCacheFileSaverPrune(t *testing.T) {
+	ctx := t.Context()
+	path := filepath.Join(t.TempDir(), "alzlib-cache.json.gz")
+	meta := newCacheFileMetadata(cloud.AzurePublic, "test")
+
+	// Without pruning, all the built-in definitions are saved.
+	saver, err := newCacheFileSaver(newCacheSaveTestAlzLib(t, cacheSaveTestDefinitions), path, meta, false, nil)
+	require.NoError(t, err)
+	require.NoError(t, saver.save(ctx, nil))
+	loaded, _, err := loadCacheFile(ctx, alzlib.NewAlzLib(nil), path, meta, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.0.0", "2.0.0"}, versionStrings(loaded.PolicyDefinitionVersionsForName("a")))
+	assert.NotNil(t, loaded.PolicyDefinitionVersionsByName("b"))
+	assert.Equal(t, 1, loaded.PolicySetDefinitionCount())
+
+	// With pruning, the cache file shrinks to the referenced definitions,
+	// which are found in the loaded cache when they are not in alzlib.
+	saver, err = newCacheFileSaver(newCacheSaveTestAlzLib(t, nil), path, meta, true, loaded)
+	require.NoError(t, err)
+	require.NoError(t, saver.save(ctx, []alzlib.BuiltInRequest{newBuiltInRequest(t, "policyDefinitions", "a", to.Ptr("2.*.*"))}))
+	pruned, _, err := loadCacheFile(ctx, alzlib.NewAlzLib(nil), path, meta, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pruned.PolicyDefinitionCount())
+	assert.Equal(t, []string{"2.0.0"}, versionStrings(pruned.PolicyDefinitionVersionsForName("a")))
+	assert.Equal(t, 0, pruned.PolicySetDefinitionCount())
+
+	// Referenced definitions are found in both the loaded cache and alzlib.
+	saver, err = newCacheFileSaver(newCacheSaveTestAlzLib(t, map[string]string{
+		"b.alz_policy_definition.json": cacheSaveTestDefinitions["b.alz_policy_definition.json"],
+	}), path, meta, true, pruned)
+	require.NoError(t, err)
+	require.NoError(t, saver.save(ctx, []alzlib.BuiltInRequest{
+		newBuiltInRequest(t, "policyDefinitions", "a", to.Ptr("2.*.*")),
+		newBuiltInRequest(t, "policyDefinitions", "b", nil),
+	}))
+	saved, _, err := loadCacheFile(ctx, alzlib.NewAlzLib(nil), path, meta, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, saved.PolicyDefinitionCount())
+	assert.Equal(t, []string{"2.0.0"}, versionStrings(saved.PolicyDefinitionVersionsForName("a")))
+	assert.NotNil(t, saved.PolicyDefinitionVersionsByName("b"))
+}
+
+func versionStrings[T interface{ String() string }](versions []T) []string {
+	res := make([]string, len(versions))
+	for i, v := range versions {
+		res[i] = v.String()
+	}
+	return res
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/Azure/alzlib"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -22,14 +23,18 @@ func TestSaveAndLoadCacheFile(t *testing.T) {
 
 	alz := alzlib.NewAlzLib(nil)
 	ctx := context.Background()
+	meta := newCacheFileMetadata(cloud.AzurePublic, "test")
 
 	// Saving with no built-ins loaded should still produce a valid cache file.
-	require.NoError(t, saveCacheFile(ctx, alz, path))
+	require.NoError(t, saveCacheFile(ctx, alz.ExportBuiltInCache(), path, meta))
 	assert.FileExists(t, path)
 
 	// Loading the file we just wrote should succeed and inject a cache.
 	alz2 := alzlib.NewAlzLib(nil)
-	require.NoError(t, loadCacheFile(ctx, alz2, path))
+	c, warnings, err := loadCacheFile(ctx, alz2, path, meta, 0)
+	require.NoError(t, err)
+	assert.NotNil(t, c)
+	assert.Empty(t, warnings)
 }
 
 // TestLoadCacheFileMissingIsNoOp verifies that loading a non-existent cache file
@@ -40,7 +45,9 @@ func TestLoadCacheFileMissingIsNoOp(t *testing.T) {
 	path := filepath.Join(dir, "does-not-exist.json.gz")
 
 	alz := alzlib.NewAlzLib(nil)
-	require.NoError(t, loadCacheFile(context.Background(), alz, path))
+	c, _, err := loadCacheFile(context.Background(), alz, path, newCacheFileMetadata(cloud.AzurePublic, "test"), 0)
+	require.NoError(t, err)
+	assert.Nil(t, c)
 }
 
 // TestLoadCacheFileInvalid verifies that a non-gzip file produces an error.
@@ -51,7 +58,7 @@ func TestLoadCacheFileInvalid(t *testing.T) {
 	require.NoError(t, os.WriteFile(path, []byte("not-a-gzip-file"), 0o600))
 
 	alz := alzlib.NewAlzLib(nil)
-	err := loadCacheFile(context.Background(), alz, path)
+	_, _, err := loadCacheFile(context.Background(), alz, path, newCacheFileMetadata(cloud.AzurePublic, "test"), 0)
 	assert.Error(t, err)
 }
 
@@ -65,15 +72,17 @@ func TestSaveCacheFileOverwrite(t *testing.T) {
 
 	alz := alzlib.NewAlzLib(nil)
 	ctx := context.Background()
+	meta := newCacheFileMetadata(cloud.AzurePublic, "test")
 
-	require.NoError(t, saveCacheFile(ctx, alz, path))
+	require.NoError(t, saveCacheFile(ctx, alz.ExportBuiltInCache(), path, meta))
 	assert.FileExists(t, path)
 
 	// A second save against the same path must succeed (overwrite).
-	require.NoError(t, saveCacheFile(ctx, alz, path))
+	require.NoError(t, saveCacheFile(ctx, alz.ExportBuiltInCache(), path, meta))
 	assert.FileExists(t, path)
 
 	// And the file should still be loadable after overwrite.
 	alz2 := alzlib.NewAlzLib(nil)
-	require.NoError(t, loadCacheFile(ctx, alz2, path))
+	_, _, err := loadCacheFile(ctx, alz2, path, meta, 0)
+	require.NoError(t, err)
 }
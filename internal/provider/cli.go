@@ -0,0 +1,751 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"iter"
+	"maps"
+	"math"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/alzlib/assets"
+	"github.com/Azure/alzlib/cache"
+	"github.com/Azure/alzlib/deployment"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armpolicy"
+	"github.com/Azure/entrauth/aztfauth"
+	"github.com/Azure/terraform-provider-alz/internal/clients"
+	"github.com/Azure/terraform-provider-alz/internal/gen"
+	"github.com/Azure/terraform-provider-alz/internal/services"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	// cliRootManagementGroupID is the placeholder parent management group used to build architectures for the cache,
+	// which does not affect the definitions that are referenced.
+	cliRootManagementGroupID = "00000000-0000-0000-0000-000000000000"
+	cliLocation              = "northeurope"
+
+	cliRenderFormatJSON = "json"
+	cliRenderFormatARM  = "arm"
+)
+
+// errCLIUsage is returned when the command line is invalid, after the usage has been printed.
+var errCLIUsage = errors.New("invalid usage")
+
+// cliCommand is a subcommand of the provider binary, which lets CI prepare cache files and check libraries
+// without a Terraform run.
+type cliCommand struct {
+	name        string
+	description string
+	run         func(c *cli, ctx context.Context, args []string) error
+}
+
+var cliCommands = []cliCommand{
+	{name: "cache build", description: "Fetch the built-in definitions used by the architectures of the libraries and save them to a cache file", run: (*cli).cacheBuild},
+	{name: "cache inspect", description: "List the metadata and definitions in a cache file", run: (*cli).cacheInspect},
+	{name: "library validate", description: "Check that a local library directory and its dependencies can be loaded", run: (*cli).libraryValidate},
+	{name: "architecture render", description: "Write the hierarchy of an architecture as JSON or an ARM template", run: (*cli).architectureRender},
+}
+
+// cli holds the state of a command line invocation.
+type cli struct {
+	version string
+	stdout  io.Writer
+	stderr  io.Writer
+}
+
+// IsCommand returns true if args, without the program name, are a subcommand rather than the plugin server flags.
+func IsCommand(args []string) bool {
+	return len(args) > 0 && !strings.HasPrefix(args[0], "-")
+}
+
+// RunCommand runs the subcommand in args, without the program name, and returns the exit code.
+func RunCommand(ctx context.Context, version string, args []string, stdout, stderr io.Writer) int {
+	c := &cli{version: version, stdout: stdout, stderr: stderr}
+	for _, cmd := range cliCommands {
+		words := strings.Fields(cmd.name)
+		if len(args) < len(words) || !slices.Equal(args[:len(words)], words) {
+			continue
+		}
+		if err := cmd.run(c, ctx, args[len(words):]); err != nil {
+			if !errors.Is(err, errCLIUsage) {
+				fmt.Fprintf(stderr, "Error: %s\n", err)
+			}
+			return 1
+		}
+		return 0
+	}
+	c.usage()
+	return 2
+}
+
+// usage prints the subcommands.
+func (c *cli) usage() {
+	fmt.Fprintln(c.stderr, "Usage: terraform-provider-alz <command> [flags]")
+	fmt.Fprintln(c.stderr)
+	fmt.Fprintln(c.stderr, "Commands:")
+	for _, cmd := range cliCommands {
+		fmt.Fprintf(c.stderr, "  %-20s %s\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintln(c.stderr)
+	fmt.Fprintln(c.stderr, "Run `terraform-provider-alz <command> -help` for the flags of a command.")
+	fmt.Fprintln(c.stderr, "Without a command, the provider plugin server is started.")
+}
+
+// newFlagSet returns a flag set for the subcommand that prints its errors to stderr.
+func (c *cli) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	return fs
+}
+
+// parseFlags parses the flags of the subcommand, which takes no positional arguments.
+func (c *cli) parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return errCLIUsage
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(c.stderr, "unexpected argument %q\n", fs.Arg(0))
+		fs.Usage()
+		return errCLIUsage
+	}
+	return nil
+}
+
+// printDiags prints the diagnostics to stderr and returns an error if there are any errors.
+func (c *cli) printDiags(diags diag.Diagnostics) error {
+	for _, d := range diags.Warnings() {
+		fmt.Fprintf(c.stderr, "Warning: %s: %s\n", d.Summary(), d.Detail())
+	}
+	if errs := diags.Errors(); len(errs) > 0 {
+		msgs := make([]string, len(errs))
+		for i, d := range errs {
+			msgs[i] = fmt.Sprintf("%s: %s", d.Summary(), d.Detail())
+		}
+		return errors.New(strings.Join(msgs, "\n"))
+	}
+	return nil
+}
+
+// cliLibraryFlags are the flags that select the libraries, in the same way as the provider configuration.
+type cliLibraryFlags struct {
+	references         []libraryReferencesManifestEntry
+	manifestFile       string
+	lockFile           string
+	fetchDependencies  bool
+	conflictResolution string
+}
+
+// register adds the library flags to the flag set. The library reference flags can be repeated,
+// and the libraries are loaded in the order of the flags.
+func (f *cliLibraryFlags) register(fs *flag.FlagSet) {
+	fs.Func("library", "ALZ library reference as `path@ref`, e.g. platform/alz@2025.02.0 (repeatable)", func(s string) error {
+		path, ref, ok := strings.Cut(s, "@")
+		if !ok || path == "" || ref == "" {
+			return fmt.Errorf("library %q must be in the format path@ref", s)
+		}
+		f.references = append(f.references, libraryReferencesManifestEntry{Path: path, Ref: ref})
+		return nil
+	})
+	fs.Func("library-url", "custom library `url` (repeatable)", func(s string) error {
+		f.references = append(f.references, libraryReferencesManifestEntry{CustomURL: s})
+		return nil
+	})
+	fs.Func("library-local-path", "local library `directory` (repeatable)", func(s string) error {
+		f.references = append(f.references, libraryReferencesManifestEntry{LocalPath: s})
+		return nil
+	})
+	fs.StringVar(&f.manifestFile, "library-manifest-file", "", "library manifest `file`, in the same format as the library_manifest_file provider attribute")
+	fs.StringVar(&f.lockFile, "library-lock-file", "", "library lock `file` to check the libraries against, which is written if it does not exist, as for the library_lock_file_name provider attribute")
+	fs.BoolVar(&f.fetchDependencies, "library-fetch-dependencies", true, "fetch the library dependencies")
+	fs.StringVar(&f.conflictResolution, "library-dependency-conflict-resolution", libraryDependencyConflictResolutionAll,
+		"`strategy` to resolve conflicting library dependency refs: all, error, highest or explicit")
+}
+
+// model returns the provider configuration for the library flags.
+// Settings that are not set by flags, e.g. authentication, are read from the environment variables.
+func (f *cliLibraryFlags) model(ctx context.Context, fs *flag.FlagSet) (AlzModel, diag.Diagnostics) {
+	data, diags := nullAlzModel(ctx)
+	if diags.HasError() {
+		return data, diags
+	}
+
+	vals := make([]attr.Value, len(f.references))
+	for i, entry := range f.references {
+		v, d := entry.toLibraryReferencesValue(ctx)
+		diags.Append(d...)
+		vals[i] = v
+	}
+	if diags.HasError() {
+		return data, diags
+	}
+	data.LibraryReferences = types.ListValueMust(gen.NewLibraryReferencesValueNull().Type(ctx), vals)
+	if f.manifestFile != "" {
+		data.LibraryManifestFile = types.StringValue(f.manifestFile)
+	}
+	if f.lockFile != "" {
+		data.LibraryLockFileName = types.StringValue(f.lockFile)
+	}
+
+	// Flags that were set take precedence over the manifest file, as inline settings do in the provider configuration.
+	fs.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "library-fetch-dependencies":
+			data.LibraryFetchDependencies = types.BoolValue(f.fetchDependencies)
+		case "library-dependency-conflict-resolution":
+			data.LibraryDependencyConflictResolution = types.StringValue(f.conflictResolution)
+		}
+	})
+
+	diags.Append(applyLibraryReferencesManifest(ctx, &data)...)
+	if diags.HasError() {
+		return data, diags
+	}
+	if len(data.LibraryReferences.Elements()) == 0 {
+		diags.AddError("No library references", "at least one of -library, -library-url, -library-local-path or -library-manifest-file is required")
+	}
+	return data, diags
+}
+
+// nullAlzModel returns the provider configuration with every attribute null, as for an empty provider block.
+func nullAlzModel(ctx context.Context) (AlzModel, diag.Diagnostics) {
+	var data AlzModel
+	var schemaResp provider.SchemaResponse
+	(&AlzProvider{}).Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return data, schemaResp.Diagnostics
+	}
+
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(typ, nil)
+	}
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objType, attrs),
+	}
+	diags := config.Get(ctx, &data)
+	return data, diags
+}
+
+// newClient initializes an AlzLib from the provider configuration, in the same way as the provider, and returns
+// the client that the data sources would use.
+// When offline, no Azure credential is created, so built-in definitions can only come from the cache file.
+// Library credentials are read from the environment variables, as there is no `credentials` block.
+func (c *cli) newClient(ctx context.Context, data *AlzModel, cacheFileName string) (*clients.Client, cloud.Configuration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data.ConfigureFromEnv()
+	configureAzIdentityEnvironment(data)
+	data.SetOpinionatedDefaults()
+	configureDefaults(ctx, data)
+
+	authOptions, err := data.AuthOption(ctx, azcore.ClientOptions{
+		Retry: policy.RetryOptions{
+			MaxRetries: math.MaxInt16,
+		},
+	})
+	if err != nil {
+		diags.AddError("Failed to configure cloud environment", err.Error())
+		return nil, cloud.Configuration{}, diags
+	}
+
+	var cred azcore.TokenCredential
+	if !data.OfflineEnabled.ValueBool() {
+		cred, err = aztfauth.NewCredential(authOptions)
+		if err != nil {
+			diags.AddError("Failed to create Azure token credential", err.Error())
+			return nil, authOptions.Cloud, diags
+		}
+	}
+
+	alz, d := configureAlzLib(cred, *data, authOptions.Cloud, fmt.Sprintf("%s/%s", userAgentBase, c.version))
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, authOptions.Cloud, diags
+	}
+
+	libRefs, d := generateLibraryDefinitions(ctx, data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, authOptions.Cloud, diags
+	}
+
+	libraryCredentials, d := generateLibraryCredentials(ctx, data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, authOptions.Cloud, diags
+	}
+	libRefs, err = fetchLibrariesWithCredentials(ctx, libRefs, libraryCredentials)
+	if err != nil {
+		diags.AddError("Failed to fetch library", err.Error())
+		return nil, authOptions.Cloud, diags
+	}
+
+	verifications, d := generateLibraryVerifications(ctx, data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, authOptions.Cloud, diags
+	}
+
+	var dependencyGraph []clients.LibraryDependency
+	if data.LibraryFetchDependencies.ValueBool() {
+		libRefs, dependencyGraph, err = resolveLibraryDependencies(ctx, libRefs, data.LibraryDependencyConflictResolution.ValueString(),
+			newVerifyingLibraryFetchFunc(newLibraryFetchFunc(libraryCredentials), verifications))
+		if err != nil {
+			diags.AddError("Failed to fetch library dependencies", err.Error())
+			return nil, authOptions.Cloud, diags
+		}
+	}
+
+	if err := verifyLibraries(ctx, libRefs, verifications); err != nil {
+		diags.AddError("Failed to verify library signature", err.Error())
+		return nil, authOptions.Cloud, diags
+	}
+
+	if lockFileName := data.LibraryLockFileName.ValueString(); lockFileName != "" {
+		if err := checkLibraryLockFile(ctx, lockFileName, libRefs, dependencyGraph, data.LibraryLockFileUpdateEnabled.ValueBool()); err != nil {
+			diags.AddError("Failed to check library lock file", err.Error())
+			return nil, authOptions.Cloud, diags
+		}
+	}
+
+	var builtInCache alzlib.BuiltInCache
+	if cacheFileName != "" {
+		loaded, warnings, err := loadCacheFile(ctx, alz, cacheFileName, newCacheFileMetadata(authOptions.Cloud, c.version), 0)
+		for _, warning := range warnings {
+			diags.AddWarning("Cache file version mismatch", warning)
+		}
+		if err == nil && loaded == nil {
+			err = fmt.Errorf("cache file %q does not exist", cacheFileName)
+		}
+		if err != nil {
+			diags.AddError("Failed to load cache file", err.Error())
+			return nil, authOptions.Cloud, diags
+		}
+		builtInCache = loaded
+	}
+
+	if err := alz.Init(ctx, libRefs...); err != nil {
+		diags.AddError("Failed to initialize AlzLib", err.Error())
+		return nil, authOptions.Cloud, diags
+	}
+	client := clients.NewClient(
+		clients.WithAlzLib(alz),
+		clients.WithOffline(data.OfflineEnabled.ValueBool(), builtInCache),
+		nonComplianceMessageSubstitutionSettings(*data),
+	)
+	return client, authOptions.Cloud, diags
+}
+
+// cacheBuild fetches the built-in definitions used by the architectures and saves them to a cache file.
+func (c *cli) cacheBuild(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("cache build")
+	var libFlags cliLibraryFlags
+	libFlags.register(fs)
+	output := fs.String("output", "", "cache `file` to write, which must end in .gz (required)")
+	var architectures []string
+	fs.Func("architecture", "architecture `name` to fetch the definitions for (repeatable, defaults to all the architectures)", func(s string) error {
+		architectures = append(architectures, s)
+		return nil
+	})
+	if err := c.parseFlags(fs, args); err != nil {
+		return err
+	}
+	if !strings.HasSuffix(*output, ".gz") {
+		fmt.Fprintln(c.stderr, "-output is required and must end in .gz")
+		fs.Usage()
+		return errCLIUsage
+	}
+
+	data, diags := libFlags.model(ctx, fs)
+	if err := c.printDiags(diags); err != nil {
+		return err
+	}
+	client, cloudConfig, diags := c.newClient(ctx, &data, "")
+	if err := c.printDiags(diags); err != nil {
+		return err
+	}
+
+	alz := client.AlzLib
+	if len(architectures) == 0 {
+		architectures = alz.Architectures()
+		slices.Sort(architectures)
+	}
+	// Building the hierarchy fetches the built-in definitions that it references from Azure.
+	for _, arch := range architectures {
+		if err := deployment.NewHierarchy(alz).FromArchitecture(ctx, arch, cliRootManagementGroupID, cliLocation); err != nil {
+			return fmt.Errorf("building architecture %q: %w", arch, err)
+		}
+	}
+
+	builtInCache := alz.ExportBuiltInCache()
+	if err := saveCacheFile(ctx, builtInCache, *output, newCacheFileMetadata(cloudConfig, c.version)); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, "Saved %d policy definitions and %d policy set definitions for architectures %s to %s\n",
+		builtInCache.PolicyDefinitionCount(), builtInCache.PolicySetDefinitionCount(), strings.Join(architectures, ", "), *output)
+	return nil
+}
+
+// cacheInspect lists the metadata and definitions in a cache file.
+func (c *cli) cacheInspect(_ context.Context, args []string) error {
+	fs := c.newFlagSet("cache inspect")
+	file := fs.String("file", "", "cache `file` to inspect (required)")
+	if err := c.parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *file == "" {
+		fmt.Fprintln(c.stderr, "-file is required")
+		fs.Usage()
+		return errCLIUsage
+	}
+
+	data, err := os.ReadFile(*file) // #nosec G304 -- path is provided by the operator on the command line.
+	if err != nil {
+		return fmt.Errorf("opening cache file %q: %w", *file, err)
+	}
+	meta, err := readCacheFileMetadata(data)
+	if err != nil {
+		return fmt.Errorf("reading cache file %q: %w", *file, err)
+	}
+	builtInCache, err := cache.NewCache(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("reading cache file %q: %w", *file, err)
+	}
+
+	if meta == nil {
+		fmt.Fprintln(c.stdout, "Metadata: none")
+	} else {
+		fmt.Fprintln(c.stdout, "Metadata:")
+		fmt.Fprintf(c.stdout, "  Cloud:            %s\n", meta.Cloud)
+		fmt.Fprintf(c.stdout, "  Created at:       %s\n", meta.CreatedAt.Format(time.RFC3339))
+		fmt.Fprintf(c.stdout, "  Provider version: %s\n", meta.ProviderVersion)
+		fmt.Fprintf(c.stdout, "  Alzlib version:   %s\n", meta.AlzlibVersion)
+		fmt.Fprintf(c.stdout, "  Content hash:     %s\n", meta.ContentHash)
+	}
+	printVersions := func(title string, names []string, versions func(string) []string) {
+		fmt.Fprintf(c.stdout, "\n%s (%d):\n", title, len(names))
+		for _, name := range names {
+			fmt.Fprintf(c.stdout, "  %s %s\n", name, strings.Join(versions(name), ", "))
+		}
+	}
+	printVersions("Policy definitions", slices.Sorted(maps.Keys(builtInCache.PolicyDefinitions())), func(name string) []string {
+		return cliVersionStrings(builtInCache.PolicyDefinitionVersionsByName(name).AllVersions())
+	})
+	printVersions("Policy set definitions", slices.Sorted(maps.Keys(builtInCache.PolicySetDefinitions())), func(name string) []string {
+		return cliVersionStrings(builtInCache.PolicySetDefinitionVersionsByName(name).AllVersions())
+	})
+	return nil
+}
+
+// libraryValidate checks that a local library directory and its dependencies can be loaded.
+func (c *cli) libraryValidate(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("library validate")
+	path := fs.String("path", "", "local library `directory` to validate (required)")
+	fetchDependencies := fs.Bool("library-fetch-dependencies", true, "fetch the library dependencies in alz_library_metadata.json")
+	if err := c.parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *path == "" {
+		fmt.Fprintln(c.stderr, "-path is required")
+		fs.Usage()
+		return errCLIUsage
+	}
+
+	libFlags := cliLibraryFlags{
+		references:        []libraryReferencesManifestEntry{{LocalPath: *path}},
+		fetchDependencies: *fetchDependencies,
+	}
+	data, diags := libFlags.model(ctx, fs)
+	if err := c.printDiags(diags); err != nil {
+		return err
+	}
+	// Validating a library only reads it, so no Azure API calls are needed.
+	data.OfflineEnabled = types.BoolValue(true)
+	client, _, diags := c.newClient(ctx, &data, "")
+	if err := c.printDiags(diags); err != nil {
+		return err
+	}
+
+	alz := client.AlzLib
+	fmt.Fprintf(c.stdout, "Library %s is valid: %d architectures, %d archetypes, %d policy assignments, %d policy definitions, %d policy set definitions, %d role definitions\n",
+		*path, len(alz.Architectures()), len(alz.Archetypes()), len(alz.PolicyAssignments()), len(alz.PolicyDefinitions()),
+		len(alz.PolicySetDefinitions()), len(alz.RoleDefinitions()))
+	return nil
+}
+
+// cliHierarchy is the JSON written by `architecture render`.
+type cliHierarchy struct {
+	Architecture          string                            `json:"architecture"`
+	ManagementGroups      map[string]cliManagementGroup     `json:"management_groups"`
+	PolicyRoleAssignments []deployment.PolicyRoleAssignment `json:"policy_role_assignments"`
+}
+
+// cliManagementGroup is a management group in the JSON written by `architecture render`.
+type cliManagementGroup struct {
+	DisplayName          string                                 `json:"display_name"`
+	ParentID             string                                 `json:"parent_id"`
+	Exists               bool                                   `json:"exists"`
+	Level                int                                    `json:"level"`
+	Location             string                                 `json:"location"`
+	PolicyAssignments    map[string]*assets.PolicyAssignment    `json:"policy_assignments"`
+	PolicyExemptions     map[string]*armpolicy.Exemption        `json:"policy_exemptions,omitempty"`
+	PolicyDefinitions    map[string]*assets.PolicyDefinition    `json:"policy_definitions"`
+	PolicySetDefinitions map[string]*assets.PolicySetDefinition `json:"policy_set_definitions"`
+	RoleDefinitions      map[string]*assets.RoleDefinition      `json:"role_definitions"`
+}
+
+// architectureRender writes the hierarchy of an architecture as JSON, or as a management group scoped ARM template.
+// The hierarchy is built from the same arguments as the alz_architecture data source, in the same way.
+func (c *cli) architectureRender(ctx context.Context, args []string) error {
+	fs := c.newFlagSet("architecture render")
+	var libFlags cliLibraryFlags
+	libFlags.register(fs)
+	configFile := fs.String("config", "", "JSON `file` with the arguments of the alz_architecture data source, e.g. policy_default_values and default_identity")
+	name := fs.String("name", "", "architecture `name` (required, unless set in -config)")
+	rootMgID := fs.String("root-management-group-id", "", "`id` of the existing management group that the architecture is deployed under (required, unless set in -config)")
+	location := fs.String("location", "", "default `location` for resources created by policy assignments, and of the ARM deployments (required, unless set in -config)")
+	output := fs.String("output", "", "`file` to write the JSON to (defaults to stdout)")
+	format := fs.String("format", cliRenderFormatJSON, "output `format`: json, or arm for a management group scoped ARM deployment template")
+	cacheFileName := fs.String("cache-file", "", "cache `file` of built-in definitions")
+	offline := fs.Bool("offline", false, "forbid Azure API calls, so every built-in definition must be in -cache-file")
+	if err := c.parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *offline && *cacheFileName == "" {
+		fmt.Fprintln(c.stderr, "-offline requires -cache-file")
+		fs.Usage()
+		return errCLIUsage
+	}
+	if *format != cliRenderFormatJSON && *format != cliRenderFormatARM {
+		fmt.Fprintf(c.stderr, "-format must be %s or %s\n", cliRenderFormatJSON, cliRenderFormatARM)
+		fs.Usage()
+		return errCLIUsage
+	}
+
+	archData, diags := cliArchitectureModel(ctx, *configFile)
+	if err := c.printDiags(diags); err != nil {
+		return err
+	}
+	// Flags that were set take precedence over the config file.
+	if *name != "" {
+		archData.Name = types.StringValue(*name)
+	}
+	if *rootMgID != "" {
+		archData.RootManagementGroupId = types.StringValue(*rootMgID)
+	}
+	if *location != "" {
+		archData.Location = types.StringValue(*location)
+	}
+	if archData.Name.ValueString() == "" || archData.RootManagementGroupId.ValueString() == "" || archData.Location.ValueString() == "" {
+		fmt.Fprintln(c.stderr, "-name, -root-management-group-id and -location are required, unless they are set in -config")
+		fs.Usage()
+		return errCLIUsage
+	}
+	archName := archData.Name.ValueString()
+
+	data, diags := libFlags.model(ctx, fs)
+	if err := c.printDiags(diags); err != nil {
+		return err
+	}
+	data.OfflineEnabled = types.BoolValue(*offline)
+	client, _, diags := c.newClient(ctx, &data, *cacheFileName)
+	if err := c.printDiags(diags); err != nil {
+		return err
+	}
+
+	h, diags := services.BuildArchitectureHierarchy(ctx, client, archData)
+	if err := c.printDiags(diags); err != nil {
+		return fmt.Errorf("building architecture %q: %w", archName, err)
+	}
+	pras, err := c.policyRoleAssignments(ctx, archName, h)
+	if err != nil {
+		return err
+	}
+	var res any
+	switch *format {
+	case cliRenderFormatARM:
+		// The template is deployed as is, so the placeholder values of the library must have been replaced.
+		if unresolved := unresolvedPolicyDefaultValues(client.AlzLib, h); len(unresolved) > 0 {
+			return fmt.Errorf("architecture %q has policy default values that are not set in policy_default_values of -config, "+
+				"so the policy assignments would be deployed with the placeholder values of the library:\n%s",
+				archName, strings.Join(unresolved, "\n"))
+		}
+		template, err := armTemplateFromHierarchy(h, pras, archData.Location.ValueString())
+		if err != nil {
+			return fmt.Errorf("generating ARM template for architecture %q: %w", archName, err)
+		}
+		res = template
+	default:
+		res = newCLIHierarchy(archName, h, pras)
+	}
+
+	out, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding architecture %q: %w", archName, err)
+	}
+	out = append(out, '\n')
+	if *output == "" {
+		_, err = c.stdout.Write(out)
+		return err
+	}
+	return writeFileAtomic(*output, func(w io.Writer) error {
+		_, err := w.Write(out)
+		return err
+	})
+}
+
+// cliArchitectureModel returns the alz_architecture data source configuration in the JSON file, in which the
+// attributes have the same names and values as in Terraform, e.g. the policy_default_values are JSON encoded strings.
+// Attributes that are not in the file are null, as are all attributes if there is no file.
+func cliArchitectureModel(ctx context.Context, file string) (gen.ArchitectureModel, diag.Diagnostics) {
+	var data gen.ArchitectureModel
+	var schemaResp datasource.SchemaResponse
+	services.NewArchitectureDataSource().Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return data, schemaResp.Diagnostics
+	}
+
+	var diags diag.Diagnostics
+	raw := []byte("{}")
+	if file != "" {
+		var err error
+		if raw, err = os.ReadFile(file); err != nil { // #nosec G304 -- path is provided by the operator on the command line.
+			diags.AddError("Failed to read architecture config file", err.Error())
+			return data, diags
+		}
+	}
+	val, err := tftypes.ValueFromJSONWithOpts(raw, schemaResp.Schema.Type().TerraformType(ctx), tftypes.ValueFromJSONOpts{})
+	if err != nil {
+		diags.AddError("Invalid architecture config file", fmt.Sprintf("%s: %s", file, err))
+		return data, diags
+	}
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    val,
+	}
+	diags.Append(config.Get(ctx, &data)...)
+	return data, diags
+}
+
+// unresolvedPolicyDefaultValues returns the policy default values of the library that are used by the policy
+// assignments of the hierarchy, and still have the values of the library policy assignments, which are placeholders.
+func unresolvedPolicyDefaultValues(az *alzlib.AlzLib, h *services.ArchitectureHierarchy) []string {
+	var res []string
+	for _, defName := range az.PolicyDefaultValues() {
+		var assignments []string
+		for assignment, params := range az.PolicyDefaultValue(defName).PolicyAssignment2ParameterMap() {
+			libPa := az.PolicyAssignment(assignment)
+			if libPa == nil {
+				continue
+			}
+			for _, mgName := range h.ManagementGroupNames() {
+				pa, ok := h.ManagementGroup(mgName).PolicyAssignmentMap()[assignment]
+				if !ok {
+					continue
+				}
+				for param := range params.Iter() {
+					if libVal := policyAssignmentParameterValue(libPa, param); libVal != nil && reflect.DeepEqual(libVal, policyAssignmentParameterValue(pa, param)) {
+						assignments = append(assignments, fmt.Sprintf("%s at %s", assignment, mgName))
+						break
+					}
+				}
+			}
+		}
+		if len(assignments) > 0 {
+			slices.Sort(assignments)
+			res = append(res, fmt.Sprintf("%s (policy assignments %s)", defName, strings.Join(assignments, ", ")))
+		}
+	}
+	return res
+}
+
+// policyAssignmentParameterValue returns the value of the policy assignment parameter, or nil if it is not set.
+func policyAssignmentParameterValue(pa *assets.PolicyAssignment, name string) any {
+	if pa.Properties == nil || pa.Properties.Parameters[name] == nil {
+		return nil
+	}
+	return pa.Properties.Parameters[name].Value
+}
+
+// cliVersionStrings returns the sorted versions of the definitions, or "versionless" for a definition without one.
+func cliVersionStrings[T interface{ GetVersion() *string }](defs iter.Seq[T]) []string {
+	var res []string
+	for def := range defs {
+		if v := def.GetVersion(); v != nil {
+			res = append(res, *v)
+			continue
+		}
+		res = append(res, "versionless")
+	}
+	slices.Sort(res)
+	return res
+}
+
+// policyRoleAssignments returns the sorted policy role assignments of the hierarchy.
+// As in the alz_architecture data source, the policy role assignments that cannot be generated are reported as a
+// warning, and the others are returned.
+func (c *cli) policyRoleAssignments(ctx context.Context, name string, h *services.ArchitectureHierarchy) ([]deployment.PolicyRoleAssignment, error) {
+	pras, err := h.PolicyRoleAssignments(ctx)
+	if err != nil {
+		var praErr *deployment.PolicyRoleAssignmentErrors
+		if !errors.As(err, &praErr) {
+			return nil, fmt.Errorf("generating policy role assignments for architecture %q: %w", name, err)
+		}
+		fmt.Fprintf(c.stderr, "Warning: External role assignment creation required for Azure Policy assignments: %s\n", praErr.Error())
+	}
+	res := pras.ToSlice()
+	slices.SortFunc(res, func(a, b deployment.PolicyRoleAssignment) int {
+		return strings.Compare(a.Scope+a.AssignmentName+a.RoleDefinitionID, b.Scope+b.AssignmentName+b.RoleDefinitionID)
+	})
+	return res, nil
+}
+
+// newCLIHierarchy returns the JSON representation of the hierarchy of the architecture.
+func newCLIHierarchy(name string, h *services.ArchitectureHierarchy, pras []deployment.PolicyRoleAssignment) cliHierarchy {
+	res := cliHierarchy{
+		Architecture:          name,
+		ManagementGroups:      make(map[string]cliManagementGroup),
+		PolicyRoleAssignments: pras,
+	}
+	for _, mgName := range h.ManagementGroupNames() {
+		mg := h.ManagementGroup(mgName)
+		res.ManagementGroups[mgName] = cliManagementGroup{
+			DisplayName:          mg.DisplayName(),
+			ParentID:             mg.ParentID(),
+			Exists:               mg.Exists(),
+			Level:                mg.Level(),
+			Location:             h.ManagementGroupLocation(mg),
+			PolicyAssignments:    h.PolicyAssignmentMap(mg),
+			PolicyExemptions:     h.PolicyExemptions(mgName),
+			PolicyDefinitions:    mg.PolicyDefinitionsMap(),
+			PolicySetDefinitions: mg.PolicySetDefinitionsMap(),
+			RoleDefinitions:      mg.RoleDefinitionsMap(),
+		}
+	}
+	return res
+}
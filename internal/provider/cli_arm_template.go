@@ -0,0 +1,448 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Azure/alzlib/deployment"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armpolicy"
+	"github.com/Azure/terraform-provider-alz/internal/services"
+)
+
+const (
+	armManagementGroupTemplateSchema = "https://schema.management.azure.com/schemas/2019-08-01/managementGroupDeploymentTemplate.json#"
+	armSubscriptionTemplateSchema    = "https://schema.management.azure.com/schemas/2018-05-01/subscriptionDeploymentTemplate.json#"
+	armResourceGroupTemplateSchema   = "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#"
+
+	armDeploymentsAPIVersion      = "2024-03-01"
+	armManagementGroupsAPIVersion = "2023-04-01"
+	armPolicyAPIVersion           = "2023-04-01"
+	armPolicyExemptionAPIVersion  = "2022-07-01-preview"
+	armRoleAPIVersion             = "2022-04-01"
+
+	armManagementGroupType     = "Microsoft.Management/managementGroups"
+	armDeploymentType          = "Microsoft.Resources/deployments"
+	armPolicyDefinitionType    = "Microsoft.Authorization/policyDefinitions"
+	armPolicySetDefinitionType = "Microsoft.Authorization/policySetDefinitions"
+	armPolicyAssignmentType    = "Microsoft.Authorization/policyAssignments"
+	armPolicyExemptionType     = "Microsoft.Authorization/policyExemptions"
+	armRoleDefinitionType      = "Microsoft.Authorization/roleDefinitions"
+	armRoleAssignmentType      = "Microsoft.Authorization/roleAssignments"
+
+	// armDeploymentNameMaxLength is the maximum length of a deployment name.
+	armDeploymentNameMaxLength = 64
+)
+
+// armTemplate is an ARM deployment template.
+type armTemplate struct {
+	Schema         string        `json:"$schema"`
+	ContentVersion string        `json:"contentVersion"`
+	Resources      []armResource `json:"resources"`
+}
+
+// armResource is a resource in an ARM deployment template.
+type armResource struct {
+	Type           string   `json:"type"`
+	APIVersion     string   `json:"apiVersion"`
+	Name           string   `json:"name"`
+	Scope          string   `json:"scope,omitempty"`
+	SubscriptionID string   `json:"subscriptionId,omitempty"`
+	ResourceGroup  string   `json:"resourceGroup,omitempty"`
+	Location       string   `json:"location,omitempty"`
+	Identity       any      `json:"identity,omitempty"`
+	DependsOn      []string `json:"dependsOn,omitempty"`
+	Properties     any      `json:"properties"`
+}
+
+// armDeploymentProperties are the properties of a nested deployment.
+// The inner expression scope means that the nested template is evaluated at its own scope.
+type armDeploymentProperties struct {
+	Mode                        string                         `json:"mode"`
+	ExpressionEvaluationOptions armExpressionEvaluationOptions `json:"expressionEvaluationOptions"`
+	Template                    armTemplate                    `json:"template"`
+}
+
+type armExpressionEvaluationOptions struct {
+	Scope string `json:"scope"`
+}
+
+// newARMTemplate returns an empty template with the schema.
+func newARMTemplate(schema string) armTemplate {
+	return armTemplate{
+		Schema:         schema,
+		ContentVersion: "1.0.0.0",
+		Resources:      []armResource{},
+	}
+}
+
+// newARMDeployment returns a nested deployment of the template.
+func newARMDeployment(name string, template armTemplate, dependsOn []string) armResource {
+	return armResource{
+		Type:       armDeploymentType,
+		APIVersion: armDeploymentsAPIVersion,
+		Name:       name,
+		DependsOn:  dependsOn,
+		Properties: armDeploymentProperties{
+			Mode:                        "Incremental",
+			ExpressionEvaluationOptions: armExpressionEvaluationOptions{Scope: "inner"},
+			Template:                    template,
+		},
+	}
+}
+
+// armTemplateFromHierarchy returns a management group scoped ARM deployment template of the hierarchy, which is
+// deployed at the existing management group that the hierarchy is deployed under, e.g.
+// `az deployment mg create --management-group-id <root management group id>`.
+//
+// The template creates the management groups that do not exist, then deploys a nested deployment to each management
+// group with its policy definitions, policy set definitions, role definitions, policy assignments and policy exemptions,
+// and finally deploys nested deployments with the role assignments for the policy assignment identities to each scope.
+// Each management group deployment depends on its management group and the deployment of its parent, as the policy
+// assignments and policy set definitions can reference definitions at the parent management groups.
+// pras are the policy role assignments of the hierarchy. The deployments to management groups in the hierarchy are
+// in the location of the management group, and the other deployments are in the location.
+func armTemplateFromHierarchy(h *services.ArchitectureHierarchy, pras []deployment.PolicyRoleAssignment, location string) (armTemplate, error) {
+	res := newARMTemplate(armManagementGroupTemplateSchema)
+
+	// Management groups are processed in the order of their level, so that parents come before children.
+	mgs := make([]*deployment.HierarchyManagementGroup, 0)
+	for _, name := range h.ManagementGroupNames() {
+		mgs = append(mgs, h.ManagementGroup(name))
+	}
+	slices.SortFunc(mgs, func(a, b *deployment.HierarchyManagementGroup) int {
+		return cmp.Or(cmp.Compare(a.Level(), b.Level()), strings.Compare(a.Name(), b.Name()))
+	})
+
+	mgDeployments := make(map[string]string, len(mgs))
+	for _, mg := range mgs {
+		var mgDependsOn []string
+		if !mg.Exists() {
+			mgResource := armResource{
+				Type:       armManagementGroupType,
+				APIVersion: armManagementGroupsAPIVersion,
+				Name:       mg.Name(),
+				Scope:      "/",
+				Properties: map[string]any{
+					"displayName": mg.DisplayName(),
+					"details": map[string]any{
+						"parent": map[string]any{"id": armManagementGroupID(mg.ParentID())},
+					},
+				},
+			}
+			if parent := mg.Parent(); parent != nil && !mg.ParentIsExternal() && !parent.Exists() {
+				mgResource.DependsOn = []string{armTenantResourceID(armManagementGroupType, parent.Name())}
+			}
+			res.Resources = append(res.Resources, mgResource)
+			mgDependsOn = append(mgDependsOn, armTenantResourceID(armManagementGroupType, mg.Name()))
+		}
+		if parentDeployment, ok := mgDeployments[mg.ParentID()]; ok {
+			mgDependsOn = append(mgDependsOn, parentDeployment)
+		}
+
+		template, err := armManagementGroupTemplate(h, mg)
+		if err != nil {
+			return res, fmt.Errorf("management group %s: %w", mg.Name(), err)
+		}
+		name := armDeploymentName("alz-mg-", mg.Name())
+		mgDeployment := newARMDeployment(name, template, mgDependsOn)
+		mgDeployment.Scope = strings.TrimPrefix(armManagementGroupID(mg.Name()), "/providers/")
+		mgDeployment.Location = h.ManagementGroupLocation(mg)
+		res.Resources = append(res.Resources, mgDeployment)
+		mgDeployments[mg.Name()] = name
+	}
+
+	// The role assignments need the principal ids of the policy assignment identities, so they depend on every
+	// management group deployment.
+	raDependsOn := slices.Sorted(maps.Values(mgDeployments))
+	raDeployments, err := armRoleAssignmentDeployments(h, pras, location, raDependsOn)
+	if err != nil {
+		return res, err
+	}
+	res.Resources = append(res.Resources, raDeployments...)
+	return res, nil
+}
+
+// armManagementGroupTemplate returns the template of the assets at the management group.
+// The policy set definitions depend on the policy definitions, and the policy assignments depend on both,
+// as they can reference the definitions at the same management group. The policy exemptions depend on the policy
+// assignments at the same management group, the others are deployed by the deployments of the parents.
+func armManagementGroupTemplate(h *services.ArchitectureHierarchy, mg *deployment.HierarchyManagementGroup) (armTemplate, error) {
+	res := newARMTemplate(armManagementGroupTemplateSchema)
+
+	var pdIDs []string
+	pds := mg.PolicyDefinitionsMap()
+	for _, name := range slices.Sorted(maps.Keys(pds)) {
+		props, err := armProperties(pds[name].Definition)
+		if err != nil {
+			return res, fmt.Errorf("policy definition %s: %w", name, err)
+		}
+		res.Resources = append(res.Resources, armResource{
+			Type:       armPolicyDefinitionType,
+			APIVersion: armPolicyAPIVersion,
+			Name:       name,
+			Properties: props,
+		})
+		pdIDs = append(pdIDs, armManagementGroupExtensionResourceID(armPolicyDefinitionType, name))
+	}
+
+	definitionIDs := slices.Clone(pdIDs)
+	psds := mg.PolicySetDefinitionsMap()
+	for _, name := range slices.Sorted(maps.Keys(psds)) {
+		props, err := armProperties(psds[name].SetDefinition)
+		if err != nil {
+			return res, fmt.Errorf("policy set definition %s: %w", name, err)
+		}
+		res.Resources = append(res.Resources, armResource{
+			Type:       armPolicySetDefinitionType,
+			APIVersion: armPolicyAPIVersion,
+			Name:       name,
+			DependsOn:  pdIDs,
+			Properties: props,
+		})
+		definitionIDs = append(definitionIDs, armManagementGroupExtensionResourceID(armPolicySetDefinitionType, name))
+	}
+
+	rds := mg.RoleDefinitionsMap()
+	for _, name := range slices.Sorted(maps.Keys(rds)) {
+		rd := rds[name]
+		props, err := armProperties(rd.RoleDefinition)
+		if err != nil {
+			return res, fmt.Errorf("role definition %s: %w", name, err)
+		}
+		res.Resources = append(res.Resources, armResource{
+			Type:       armRoleDefinitionType,
+			APIVersion: armRoleAPIVersion,
+			Name:       *rd.Name,
+			Properties: props,
+		})
+	}
+
+	pas := h.PolicyAssignmentMap(mg)
+	for _, name := range slices.Sorted(maps.Keys(pas)) {
+		pa := pas[name]
+		props, err := armProperties(pa.Assignment)
+		if err != nil {
+			return res, fmt.Errorf("policy assignment %s: %w", name, err)
+		}
+		// The scope is read-only, and is the management group that the template is deployed to.
+		delete(props, "scope")
+		r := armResource{
+			Type:       armPolicyAssignmentType,
+			APIVersion: armPolicyAPIVersion,
+			Name:       name,
+			DependsOn:  definitionIDs,
+			Properties: props,
+		}
+		if pa.Location != nil {
+			r.Location = *pa.Location
+		}
+		if pa.Identity != nil {
+			r.Identity = pa.Identity
+		}
+		res.Resources = append(res.Resources, r)
+	}
+
+	pes := h.PolicyExemptions(mg.Name())
+	assignmentPrefix := armManagementGroupID(mg.Name()) + "/providers/" + armPolicyAssignmentType + "/"
+	for _, name := range slices.Sorted(maps.Keys(pes)) {
+		pe := pes[name]
+		props, err := armProperties(pe)
+		if err != nil {
+			return res, fmt.Errorf("policy exemption %s: %w", name, err)
+		}
+		r := armResource{
+			Type:       armPolicyExemptionType,
+			APIVersion: armPolicyExemptionAPIVersion,
+			Name:       name,
+			Properties: props,
+		}
+		if pe.Properties != nil && pe.Properties.PolicyAssignmentID != nil {
+			if paName, ok := strings.CutPrefix(*pe.Properties.PolicyAssignmentID, assignmentPrefix); ok {
+				r.DependsOn = []string{armManagementGroupExtensionResourceID(armPolicyAssignmentType, paName)}
+			}
+		}
+		res.Resources = append(res.Resources, r)
+	}
+	return res, nil
+}
+
+// armRoleAssignmentDeployments returns a nested deployment for each scope of the policy role assignments,
+// which are management groups, subscriptions, resource groups or resources.
+func armRoleAssignmentDeployments(h *services.ArchitectureHierarchy, pras []deployment.PolicyRoleAssignment, location string, dependsOn []string) ([]armResource, error) {
+	byScope := make(map[string][]deployment.PolicyRoleAssignment)
+	for _, pra := range pras {
+		byScope[pra.Scope] = append(byScope[pra.Scope], pra)
+	}
+
+	var res []armResource
+	for _, scope := range slices.Sorted(maps.Keys(byScope)) {
+		scopeID, err := arm.ParseResourceID(scope)
+		if err != nil {
+			return nil, fmt.Errorf("parsing role assignment scope %s: %w", scope, err)
+		}
+		name := armDeploymentName("alz-ra-", scope)
+
+		var d armResource
+		var resourceScope string
+		switch {
+		case strings.EqualFold(scopeID.ResourceType.String(), armManagementGroupType):
+			d = newARMDeployment(name, newARMTemplate(armManagementGroupTemplateSchema), dependsOn)
+			d.Scope = strings.TrimPrefix(armManagementGroupID(scopeID.Name), "/providers/")
+			d.Location = location
+			if mg := h.ManagementGroup(scopeID.Name); mg != nil {
+				d.Location = h.ManagementGroupLocation(mg)
+			}
+		case scopeID.ResourceGroupName == "":
+			d = newARMDeployment(name, newARMTemplate(armSubscriptionTemplateSchema), dependsOn)
+			d.SubscriptionID = scopeID.SubscriptionID
+			d.Location = location
+		default:
+			d = newARMDeployment(name, newARMTemplate(armResourceGroupTemplateSchema), dependsOn)
+			d.SubscriptionID = scopeID.SubscriptionID
+			d.ResourceGroup = scopeID.ResourceGroupName
+			// Role assignments on a resource are extension resources of it, in the resource group deployment.
+			if _, after, ok := strings.Cut(scope, "/providers/"); ok {
+				resourceScope = after
+			}
+		}
+
+		props := d.Properties.(armDeploymentProperties)
+		pras := byScope[scope]
+		slices.SortFunc(pras, func(a, b deployment.PolicyRoleAssignment) int {
+			return cmp.Or(strings.Compare(a.ManagementGroupID, b.ManagementGroupID), strings.Compare(a.AssignmentName, b.AssignmentName),
+				strings.Compare(a.RoleDefinitionID, b.RoleDefinitionID))
+		})
+		for _, pra := range pras {
+			principalID, err := armPolicyAssignmentPrincipalID(h, pra)
+			if err != nil {
+				return nil, err
+			}
+			assignmentID := armManagementGroupID(pra.ManagementGroupID) + "/providers/" + armPolicyAssignmentType + "/" + pra.AssignmentName
+			props.Template.Resources = append(props.Template.Resources, armResource{
+				Type:       armRoleAssignmentType,
+				APIVersion: armRoleAPIVersion,
+				// The name is a GUID, which is generated from the scope, policy assignment and role definition so
+				// that it is the same for every deployment.
+				Name:  fmt.Sprintf("[guid(%s, %s, %s)]", armStringLiteral(scope), armStringLiteral(assignmentID), armStringLiteral(pra.RoleDefinitionID)),
+				Scope: resourceScope,
+				Properties: map[string]any{
+					"roleDefinitionId": pra.RoleDefinitionID,
+					"principalId":      principalID,
+					"principalType":    "ServicePrincipal",
+				},
+			})
+		}
+		d.Properties = props
+		res = append(res, d)
+	}
+	return res, nil
+}
+
+// armPolicyAssignmentPrincipalID returns the expression for the principal id of the identity of the policy assignment
+// of the policy role assignment, which is only known once the policy assignment has been deployed.
+func armPolicyAssignmentPrincipalID(h *services.ArchitectureHierarchy, pra deployment.PolicyRoleAssignment) (string, error) {
+	mg := h.ManagementGroup(pra.ManagementGroupID)
+	if mg == nil {
+		return "", fmt.Errorf("management group %s of policy role assignment not found", pra.ManagementGroupID)
+	}
+	pa, ok := h.PolicyAssignmentMap(mg)[pra.AssignmentName]
+	if !ok {
+		return "", fmt.Errorf("policy assignment %s of policy role assignment not found in management group %s", pra.AssignmentName, pra.ManagementGroupID)
+	}
+	if pa.IdentityType() == armpolicy.ResourceIdentityTypeUserAssigned && pa.Identity != nil {
+		for id := range pa.Identity.UserAssignedIdentities {
+			return fmt.Sprintf("[reference(%s, '2023-01-31').principalId]", armStringLiteral(id)), nil
+		}
+	}
+	assignmentID := armManagementGroupID(pra.ManagementGroupID) + "/providers/" + armPolicyAssignmentType + "/" + pra.AssignmentName
+	return fmt.Sprintf("[reference(%s, '%s', 'full').identity.principalId]", armStringLiteral(assignmentID), armPolicyAPIVersion), nil
+}
+
+// armProperties returns the properties of the Azure resource model, with the strings that ARM would evaluate as
+// template expressions escaped, e.g. the expressions in policy rules.
+func armProperties(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encoding: %w", err)
+	}
+	var res struct {
+		Properties map[string]any `json:"properties"`
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf("decoding: %w", err)
+	}
+	if res.Properties == nil {
+		res.Properties = make(map[string]any)
+	}
+	return armEscape(res.Properties).(map[string]any), nil
+}
+
+// armEscape escapes the strings in the decoded JSON that start with `[` and end with `]`, which ARM would otherwise
+// evaluate as template expressions, by adding a `[`.
+func armEscape(v any) any {
+	switch v := v.(type) {
+	case string:
+		if strings.HasPrefix(v, "[") && strings.HasSuffix(v, "]") {
+			return "[" + v
+		}
+		return v
+	case map[string]any:
+		for k, val := range v {
+			v[k] = armEscape(val)
+		}
+		return v
+	case []any:
+		for i, val := range v {
+			v[i] = armEscape(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// armStringLiteral returns the string as a literal in a template expression.
+func armStringLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// armManagementGroupID returns the resource id of the management group.
+func armManagementGroupID(name string) string {
+	return "/providers/" + armManagementGroupType + "/" + name
+}
+
+// armTenantResourceID returns the expression for the resource id of the tenant resource, for dependsOn.
+func armTenantResourceID(resourceType, name string) string {
+	return fmt.Sprintf("[tenantResourceId(%s, %s)]", armStringLiteral(resourceType), armStringLiteral(name))
+}
+
+// armManagementGroupExtensionResourceID returns the expression for the resource id of the extension resource at
+// the management group of the template, for dependsOn.
+func armManagementGroupExtensionResourceID(resourceType, name string) string {
+	return fmt.Sprintf("[extensionResourceId(managementGroup().id, %s, %s)]", armStringLiteral(resourceType), armStringLiteral(name))
+}
+
+// armDeploymentName returns the name of a nested deployment for the key, which is shortened with a hash if it is
+// too long for a deployment name.
+func armDeploymentName(prefix, key string) string {
+	if name := prefix + key; len(name) <= armDeploymentNameMaxLength && !strings.Contains(key, "/") {
+		return name
+	}
+	sum := sha256.Sum256([]byte(key))
+	hash := hex.EncodeToString(sum[:])[:16]
+	key = strings.ReplaceAll(key, "/", "-")
+	if maxKeyLength := armDeploymentNameMaxLength - len(prefix) - len(hash) - 1; len(key) > maxKeyLength {
+		key = key[len(key)-maxKeyLength:]
+	}
+	return prefix + key + "-" + hash
+}
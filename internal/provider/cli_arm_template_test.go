@@ -0,0 +1,230 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCLIARMTestLibrary returns the CLI test library with a child management group that has a custom
+// deployIfNotExists policy, so that the policy assignment needs a role assignment.
+// The workspace parameter of the policy assignment is a placeholder, which is set by the workspace policy default value.
+func newCLIARMTestLibrary(t *testing.T) string {
+	t.Helper()
+	dir := newCLITestLibrary(t)
+	files := map[string]string{
+		"test.alz_architecture_definition.json": `{"name": "test", "management_groups": [
+			{"id": "root", "display_name": "Root", "archetypes": ["root"], "exists": false, "parent_id": null},
+			{"id": "landing", "display_name": "Landing", "archetypes": ["landing"], "exists": false, "parent_id": "root"}
+		]}`,
+		"landing.alz_archetype_definition.json": `{"name": "landing", "policy_assignments": ["deploy-dine"], "policy_definitions": ["dine"], "policy_set_definitions": [], "role_definitions": []}`,
+		"dine.alz_policy_definition.json": `{"name": "dine", "type": "Microsoft.Authorization/policyDefinitions", "properties": {
+			"displayName": "dine", "description": "dine", "policyType": "Custom", "mode": "All", "metadata": {}, "parameters": {"workspaceId": {"type": "String"}},
+			"policyRule": {"if": {"field": "type", "equals": "Microsoft.Storage/storageAccounts"}, "then": {"effect": "deployIfNotExists", "details": {
+				"type": "Microsoft.Insights/diagnosticSettings", "existenceCondition": {"field": "name", "equals": "[parameters('name')]"},
+				"roleDefinitionIds": ["/providers/Microsoft.Authorization/roleDefinitions/749f88d5-cbae-40b8-bcfc-e573ddc772fa"],
+				"deployment": {"properties": {"mode": "incremental", "template": {}}}
+			}}}
+		}}`,
+		"deploy-dine.alz_policy_assignment.json": `{"type": "Microsoft.Authorization/policyAssignments", "name": "deploy-dine", "location": "${default_location}",
+			"identity": {"type": "SystemAssigned"},
+			"properties": {"description": "deploy-dine", "displayName": "deploy-dine",
+			"policyDefinitionId": "/providers/Microsoft.Management/managementGroups/placeholder/providers/Microsoft.Authorization/policyDefinitions/dine",
+			"parameters": {"workspaceId": {"value": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/placeholder/providers/Microsoft.OperationalInsights/workspaces/placeholder"}},
+			"scope": "/providers/Microsoft.Management/managementGroups/placeholder", "notScopes": []}}`,
+		"alz_policy_default_values.json": `{"defaults": [{"default_name": "workspace", "description": "workspace",
+			"policy_assignments": [{"policy_assignment_name": "deploy-dine", "parameter_names": ["workspaceId"]}]}]}`,
+	}
+	for name, data := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600))
+	}
+	return dir
+}
+
+func TestCLIArchitectureRenderARM(t *testing.T) {
+	ctx := t.Context()
+	dir := newCLIARMTestLibrary(t)
+	cacheFile := filepath.Join(t.TempDir(), "alzlib-cache.json.gz")
+	alz := newCacheSaveTestAlzLib(t, cacheSaveTestDefinitions)
+	require.NoError(t, saveCacheFile(ctx, alz.ExportBuiltInCache(), cacheFile, newCacheFileMetadata(cloud.AzurePublic, "test")))
+
+	args := []string{"architecture", "render", "-format", "arm",
+		"-library-local-path", dir, "-name", "test", "-root-management-group-id", "tenant", "-location", "westeurope",
+		"-cache-file", cacheFile, "-offline"}
+
+	// The template is not rendered with the placeholder value of the workspace policy default value.
+	code, _, stderr := runCLITestCommand(t, args...)
+	require.Equal(t, 1, code)
+	assert.Contains(t, stderr, "workspace (policy assignments deploy-dine at landing)")
+
+	// The config file has the same arguments as the alz_architecture data source.
+	configFile := filepath.Join(t.TempDir(), "architecture.json")
+	require.NoError(t, os.WriteFile(configFile, []byte(`{
+		"policy_default_values": {"workspace": "{\"value\": \"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.OperationalInsights/workspaces/law\"}"},
+		"management_group_locations": {"landing": "eastus"},
+		"policy_exemptions": [{"name": "exempt-dine", "management_group_id": "landing", "policy_assignment_name": "deploy-dine", "exemption_category": "Waiver"}]
+	}`), 0o600))
+	code, stdout, stderr := runCLITestCommand(t, append(args, "-config", configFile)...)
+	require.Equal(t, 0, code, stderr)
+
+	var template struct {
+		Schema    string `json:"$schema"`
+		Resources []struct {
+			Type       string          `json:"type"`
+			Name       string          `json:"name"`
+			Scope      string          `json:"scope"`
+			Location   string          `json:"location"`
+			DependsOn  []string        `json:"dependsOn"`
+			Properties json.RawMessage `json:"properties"`
+		} `json:"resources"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &template))
+	assert.Equal(t, armManagementGroupTemplateSchema, template.Schema)
+
+	names := make([]string, len(template.Resources))
+	for i, r := range template.Resources {
+		names[i] = r.Type + "/" + r.Name
+	}
+	// The management groups and their deployments come before the role assignments, parents before children.
+	require.Equal(t, []string{
+		"Microsoft.Management/managementGroups/root",
+		"Microsoft.Resources/deployments/alz-mg-root",
+		"Microsoft.Management/managementGroups/landing",
+		"Microsoft.Resources/deployments/alz-mg-landing",
+		"Microsoft.Resources/deployments/" + armDeploymentName("alz-ra-", "/providers/Microsoft.Management/managementGroups/landing"),
+	}, names)
+
+	root, rootDeployment, landing, landingDeployment, raDeployment := template.Resources[0], template.Resources[1],
+		template.Resources[2], template.Resources[3], template.Resources[4]
+	assert.Equal(t, "/", root.Scope)
+	assert.JSONEq(t, `{"displayName": "Root", "details": {"parent": {"id": "/providers/Microsoft.Management/managementGroups/tenant"}}}`, string(root.Properties))
+	assert.Empty(t, root.DependsOn)
+	assert.Equal(t, []string{"[tenantResourceId('Microsoft.Management/managementGroups', 'root')]"}, landing.DependsOn)
+
+	assert.Equal(t, "Microsoft.Management/managementGroups/root", rootDeployment.Scope)
+	assert.Equal(t, "westeurope", rootDeployment.Location)
+	assert.Equal(t, []string{"[tenantResourceId('Microsoft.Management/managementGroups', 'root')]"}, rootDeployment.DependsOn)
+	assert.Equal(t, []string{"[tenantResourceId('Microsoft.Management/managementGroups', 'landing')]", "alz-mg-root"}, landingDeployment.DependsOn)
+	// The deployments to a management group are in its location, with the management_group_locations overrides applied.
+	assert.Equal(t, "eastus", landingDeployment.Location)
+	assert.Equal(t, "eastus", raDeployment.Location)
+	assert.Equal(t, []string{"alz-mg-landing", "alz-mg-root"}, raDeployment.DependsOn)
+	assert.Equal(t, "Microsoft.Management/managementGroups/landing", raDeployment.Scope)
+
+	var landingProps armDeploymentProperties
+	require.NoError(t, json.Unmarshal(landingDeployment.Properties, &landingProps))
+	assert.Equal(t, "inner", landingProps.ExpressionEvaluationOptions.Scope)
+	require.Len(t, landingProps.Template.Resources, 3)
+	pd, pa, pe := landingProps.Template.Resources[0], landingProps.Template.Resources[1], landingProps.Template.Resources[2]
+	assert.Equal(t, armPolicyDefinitionType, pd.Type)
+	// Template expressions in the policy rule are escaped.
+	pdJSON, err := json.Marshal(pd.Properties)
+	require.NoError(t, err)
+	assert.Contains(t, string(pdJSON), `"[[parameters('name')]"`)
+
+	assert.Equal(t, armPolicyAssignmentType, pa.Type)
+	assert.Equal(t, "eastus", pa.Location)
+	assert.Equal(t, []string{"[extensionResourceId(managementGroup().id, 'Microsoft.Authorization/policyDefinitions', 'dine')]"}, pa.DependsOn)
+	paProps := pa.Properties.(map[string]any)
+	assert.NotContains(t, paProps, "scope")
+	assert.Equal(t, "/providers/Microsoft.Management/managementGroups/landing/providers/Microsoft.Authorization/policyDefinitions/dine", paProps["policyDefinitionId"])
+	assert.Equal(t, map[string]any{"workspaceId": map[string]any{"value": "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.OperationalInsights/workspaces/law"}}, paProps["parameters"])
+
+	assert.Equal(t, armPolicyExemptionType, pe.Type)
+	assert.Equal(t, "exempt-dine", pe.Name)
+	assert.Equal(t, []string{"[extensionResourceId(managementGroup().id, 'Microsoft.Authorization/policyAssignments', 'deploy-dine')]"}, pe.DependsOn)
+	assert.Equal(t, "/providers/Microsoft.Management/managementGroups/landing/providers/Microsoft.Authorization/policyAssignments/deploy-dine",
+		pe.Properties.(map[string]any)["policyAssignmentId"])
+
+	var raProps armDeploymentProperties
+	require.NoError(t, json.Unmarshal(raDeployment.Properties, &raProps))
+	require.Len(t, raProps.Template.Resources, 1)
+	ra := raProps.Template.Resources[0]
+	assert.Equal(t, armRoleAssignmentType, ra.Type)
+	assert.True(t, strings.HasPrefix(ra.Name, "[guid("))
+	assert.Equal(t, "[reference('/providers/Microsoft.Management/managementGroups/landing/providers/Microsoft.Authorization/policyAssignments/deploy-dine', '2023-04-01', 'full').identity.principalId]",
+		ra.Properties.(map[string]any)["principalId"])
+
+	// The JSON output has the same management group locations.
+	code, stdout, stderr = runCLITestCommand(t, append(args, "-config", configFile, "-format", cliRenderFormatJSON)...)
+	require.Equal(t, 0, code, stderr)
+	var hierarchy struct {
+		ManagementGroups map[string]struct {
+			Location string `json:"location"`
+		} `json:"management_groups"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &hierarchy))
+	assert.Equal(t, "westeurope", hierarchy.ManagementGroups["root"].Location)
+	assert.Equal(t, "eastus", hierarchy.ManagementGroups["landing"].Location)
+}
+
+func TestARMEscape(t *testing.T) {
+	v := armEscape(map[string]any{
+		"expression": "[parameters('a')]",
+		"escaped":    "[[not an expression]",
+		"list":       []any{"[field('type')]", "plain", 1.0},
+		"partial":    "[not closed",
+	})
+	assert.Equal(t, map[string]any{
+		"expression": "[[parameters('a')]",
+		"escaped":    "[[[not an expression]",
+		"list":       []any{"[[field('type')]", "plain", 1.0},
+		"partial":    "[not closed",
+	}, v)
+}
+
+func TestARMDeploymentName(t *testing.T) {
+	assert.Equal(t, "alz-mg-root", armDeploymentName("alz-mg-", "root"))
+	long := armDeploymentName("alz-mg-", strings.Repeat("a", 90))
+	assert.Len(t, long, armDeploymentNameMaxLength)
+	assert.NotEqual(t, long, armDeploymentName("alz-mg-", strings.Repeat("a", 91)))
+	scope := armDeploymentName("alz-ra-", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg")
+	assert.LessOrEqual(t, len(scope), armDeploymentNameMaxLength)
+	assert.NotContains(t, scope, "/")
+	assert.True(t, strings.HasPrefix(scope, "alz-ra-"))
+}
+
+func TestARMStringLiteral(t *testing.T) {
+	assert.Equal(t, "'it''s'", armStringLiteral("it's"))
+}
+
+func TestCLIArchitectureRenderPolicyRoleAssignmentErrors(t *testing.T) {
+	ctx := t.Context()
+	dir := newCLIARMTestLibrary(t)
+	// The scope parameter has assignPermissions, but no value, so its role assignment cannot be generated.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dine.alz_policy_definition.json"), []byte(`{"name": "dine", "type": "Microsoft.Authorization/policyDefinitions", "properties": {
+		"displayName": "dine", "description": "dine", "policyType": "Custom", "mode": "All", "metadata": {},
+		"parameters": {"workspaceId": {"type": "String"}, "scopeId": {"type": "String", "metadata": {"assignPermissions": true}}},
+		"policyRule": {"if": {"field": "type", "equals": "Microsoft.Storage/storageAccounts"}, "then": {"effect": "deployIfNotExists", "details": {
+			"type": "Microsoft.Insights/diagnosticSettings",
+			"roleDefinitionIds": ["/providers/Microsoft.Authorization/roleDefinitions/749f88d5-cbae-40b8-bcfc-e573ddc772fa"],
+			"deployment": {"properties": {"mode": "incremental", "template": {}}}
+		}}}
+	}}`), 0o600))
+	cacheFile := filepath.Join(t.TempDir(), "alzlib-cache.json.gz")
+	alz := newCacheSaveTestAlzLib(t, cacheSaveTestDefinitions)
+	require.NoError(t, saveCacheFile(ctx, alz.ExportBuiltInCache(), cacheFile, newCacheFileMetadata(cloud.AzurePublic, "test")))
+	configFile := filepath.Join(t.TempDir(), "architecture.json")
+	require.NoError(t, os.WriteFile(configFile, []byte(`{
+		"policy_default_values": {"workspace": "{\"value\": \"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.OperationalInsights/workspaces/law\"}"}
+	}`), 0o600))
+	args := []string{"architecture", "render", "-library-local-path", dir, "-config", configFile,
+		"-name", "test", "-root-management-group-id", "tenant", "-location", "westeurope", "-cache-file", cacheFile, "-offline"}
+
+	// As in the alz_architecture data source, the error is a warning and the other role assignments are rendered.
+	for _, format := range []string{cliRenderFormatJSON, cliRenderFormatARM} {
+		code, stdout, stderr := runCLITestCommand(t, append(args, "-format", format)...)
+		require.Equal(t, 0, code, stderr)
+		assert.Contains(t, stderr, "Warning: External role assignment creation required for Azure Policy assignments")
+		assert.Contains(t, stdout, "749f88d5-cbae-40b8-bcfc-e573ddc772fa")
+	}
+}
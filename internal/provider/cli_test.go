@@ -0,0 +1,208 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCLITestLibrary writes a library to a temporary directory with an architecture that assigns the built-in
+// policy definition a.
+func newCLITestLibrary(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"test.alz_architecture_definition.json": `{"name": "test", "management_groups": [
+			{"id": "root", "display_name": "Root", "archetypes": ["root"], "exists": false, "parent_id": null}
+		]}`,
+		"root.alz_archetype_definition.json": `{"name": "root", "policy_assignments": ["audit-a"], "policy_definitions": [], "policy_set_definitions": [], "role_definitions": []}`,
+		"audit-a.alz_policy_assignment.json": `{"type": "Microsoft.Authorization/policyAssignments", "name": "audit-a", "location": "${default_location}",
+			"properties": {"description": "audit-a", "displayName": "audit-a", "definitionVersion": "1.*.*",
+			"policyDefinitionId": "/providers/Microsoft.Authorization/policyDefinitions/a", "parameters": {},
+			"scope": "/providers/Microsoft.Management/managementGroups/PLACEHOLDER", "notScopes": []}}`,
+	}
+	for name, data := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600))
+	}
+	return dir
+}
+
+// runCLITestCommand runs the command and returns the exit code, stdout and stderr.
+func runCLITestCommand(t *testing.T, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := RunCommand(t.Context(), "test", args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestIsCommand(t *testing.T) {
+	assert.False(t, IsCommand(nil))
+	assert.False(t, IsCommand([]string{"-debug"}))
+	assert.True(t, IsCommand([]string{"cache", "build"}))
+}
+
+func TestRunCommandUsage(t *testing.T) {
+	code, _, stderr := runCLITestCommand(t, "cache")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "cache inspect")
+
+	code, _, stderr = runCLITestCommand(t, "cache", "inspect")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "-file is required")
+
+	code, _, stderr = runCLITestCommand(t, "cache", "build", "-output", "cache.json")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "-output is required and must end in .gz")
+
+	code, _, stderr = runCLITestCommand(t, "architecture", "render", "-name", "test", "-root-management-group-id", "root", "-location", "northeurope", "-offline")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "-offline requires -cache-file")
+
+	code, _, stderr = runCLITestCommand(t, "architecture", "render", "-name", "test", "-location", "northeurope")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "-name, -root-management-group-id and -location are required, unless they are set in -config")
+}
+
+func TestCLICacheInspect(t *testing.T) {
+	ctx := t.Context()
+	path := filepath.Join(t.TempDir(), "alzlib-cache.json.gz")
+	alz := newCacheSaveTestAlzLib(t, cacheSaveTestDefinitions)
+	require.NoError(t, saveCacheFile(ctx, alz.ExportBuiltInCache(), path, newCacheFileMetadata(cloud.AzurePublic, "test")))
+
+	code, stdout, stderr := runCLITestCommand(t, "cache", "inspect", "-file", path)
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "  Cloud:            https://management.azure.com\n")
+	assert.Contains(t, stdout, "  Provider version: test\n")
+	assert.Contains(t, stdout, "Policy definitions (2):\n  a 1.0.0, 2.0.0\n  b 1.0.0\n")
+	assert.Contains(t, stdout, "Policy set definitions (1):\n  s 1.0.0\n")
+
+	code, _, stderr = runCLITestCommand(t, "cache", "inspect", "-file", filepath.Join(t.TempDir(), "missing.json.gz"))
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "Error: opening cache file")
+}
+
+func TestCLILibraryValidate(t *testing.T) {
+	dir := newCLITestLibrary(t)
+	code, stdout, stderr := runCLITestCommand(t, "library", "validate", "-path", dir)
+	require.Equal(t, 0, code, stderr)
+	// alzlib adds the empty archetype.
+	assert.Contains(t, stdout, "is valid: 1 architectures, 2 archetypes, 1 policy assignments")
+
+	// An archetype that references a missing policy assignment is invalid.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "root.alz_archetype_definition.json"),
+		[]byte(`{"name": "root", "policy_assignments": ["missing"], "policy_definitions": [], "policy_set_definitions": [], "role_definitions": []}`), 0o600))
+	code, _, stderr = runCLITestCommand(t, "library", "validate", "-path", dir)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "Failed to initialize AlzLib")
+}
+
+func TestCLIArchitectureRender(t *testing.T) {
+	ctx := t.Context()
+	dir := newCLITestLibrary(t)
+	cacheFile := filepath.Join(t.TempDir(), "alzlib-cache.json.gz")
+	alz := newCacheSaveTestAlzLib(t, cacheSaveTestDefinitions)
+	require.NoError(t, saveCacheFile(ctx, alz.ExportBuiltInCache(), cacheFile, newCacheFileMetadata(cloud.AzurePublic, "test")))
+	output := filepath.Join(t.TempDir(), "test.json")
+
+	// In offline mode the built-in definitions come from the cache file.
+	code, _, stderr := runCLITestCommand(t, "architecture", "render",
+		"-library-local-path", dir, "-name", "test", "-root-management-group-id", "tenant", "-location", "westeurope",
+		"-cache-file", cacheFile, "-offline", "-output", output)
+	require.Equal(t, 0, code, stderr)
+
+	data, err := os.ReadFile(output)
+	require.NoError(t, err)
+	var res struct {
+		Architecture     string `json:"architecture"`
+		ManagementGroups map[string]struct {
+			DisplayName       string `json:"display_name"`
+			ParentID          string `json:"parent_id"`
+			Location          string `json:"location"`
+			PolicyAssignments map[string]struct {
+				Properties struct {
+					PolicyDefinitionID string `json:"policyDefinitionId"`
+				} `json:"properties"`
+			} `json:"policy_assignments"`
+		} `json:"management_groups"`
+	}
+	require.NoError(t, json.Unmarshal(data, &res))
+	assert.Equal(t, "test", res.Architecture)
+	require.Contains(t, res.ManagementGroups, "root")
+	root := res.ManagementGroups["root"]
+	assert.Equal(t, "Root", root.DisplayName)
+	assert.Equal(t, "tenant", root.ParentID)
+	assert.Equal(t, "westeurope", root.Location)
+	require.Contains(t, root.PolicyAssignments, "audit-a")
+	assert.Equal(t, "/providers/Microsoft.Authorization/policyDefinitions/a", root.PolicyAssignments["audit-a"].Properties.PolicyDefinitionID)
+
+	// The arguments can be set in the config file, in the same way as for the alz_architecture data source.
+	configFile := filepath.Join(t.TempDir(), "architecture.json")
+	require.NoError(t, os.WriteFile(configFile, []byte(`{"name": "test", "root_management_group_id": "tenant", "location": "eastus"}`), 0o600))
+	code, stdout, stderr := runCLITestCommand(t, "architecture", "render",
+		"-library-local-path", dir, "-config", configFile, "-cache-file", cacheFile, "-offline")
+	require.Equal(t, 0, code, stderr)
+	require.NoError(t, json.Unmarshal([]byte(stdout), &res))
+	assert.Equal(t, "eastus", res.ManagementGroups["root"].Location)
+
+	require.NoError(t, os.WriteFile(configFile, []byte(`{"name": "test", "unknown": true}`), 0o600))
+	code, _, stderr = runCLITestCommand(t, "architecture", "render",
+		"-library-local-path", dir, "-config", configFile, "-cache-file", cacheFile, "-offline")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "Invalid architecture config file")
+
+	// Without the cache file, the built-in definition cannot be fetched in offline mode.
+	code, _, stderr = runCLITestCommand(t, "architecture", "render",
+		"-library-local-path", dir, "-name", "test", "-root-management-group-id", "tenant", "-location", "westeurope",
+		"-cache-file", filepath.Join(t.TempDir(), "missing.json.gz"), "-offline")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "does not exist")
+}
+
+func TestCLILibraryChecks(t *testing.T) {
+	ctx := t.Context()
+	dir := newCLITestLibrary(t)
+	cacheFile := filepath.Join(t.TempDir(), "alzlib-cache.json.gz")
+	alz := newCacheSaveTestAlzLib(t, cacheSaveTestDefinitions)
+	require.NoError(t, saveCacheFile(ctx, alz.ExportBuiltInCache(), cacheFile, newCacheFileMetadata(cloud.AzurePublic, "test")))
+	args := []string{"architecture", "render", "-name", "test", "-root-management-group-id", "tenant", "-location", "westeurope",
+		"-cache-file", cacheFile, "-offline"}
+
+	// The library signature is verified, as in the provider.
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	manifest, err := json.Marshal(map[string]any{"library_references": []map[string]string{
+		{"local_path": dir, "public_key": string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))},
+	}})
+	require.NoError(t, err)
+	manifestFile := filepath.Join(t.TempDir(), "manifest.json")
+	require.NoError(t, os.WriteFile(manifestFile, manifest, 0o600))
+	code, _, stderr := runCLITestCommand(t, append(args, "-library-manifest-file", manifestFile)...)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "verifying library")
+
+	// The lock file is written if it does not exist, then the library is checked against it.
+	lockFile := filepath.Join(t.TempDir(), "alz_library_lock.json")
+	code, _, stderr = runCLITestCommand(t, append(args, "-library-local-path", dir, "-library-lock-file", lockFile)...)
+	require.Equal(t, 0, code, stderr)
+	assert.FileExists(t, lockFile)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "root.alz_archetype_definition.json"),
+		[]byte(`{"name": "root", "policy_assignments": [], "policy_definitions": [], "policy_set_definitions": [], "role_definitions": []}`), 0o600))
+	code, _, stderr = runCLITestCommand(t, append(args, "-library-local-path", dir, "-library-lock-file", lockFile)...)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "Failed to check library lock file")
+}
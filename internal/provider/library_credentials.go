@@ -0,0 +1,384 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/terraform-provider-alz/internal/gen"
+	"github.com/bgentry/go-netrc/netrc"
+	"github.com/hashicorp/go-getter/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The environment variables that library reference credentials are read from, if not specified.
+const (
+	libraryTokenEnvVar       = "ALZ_PROVIDER_LIBRARY_TOKEN"
+	libraryHTTPHeadersEnvVar = "ALZ_PROVIDER_LIBRARY_HTTP_HEADERS"
+	librarySSHKeyPathEnvVar  = "ALZ_PROVIDER_LIBRARY_SSH_KEY_PATH"
+	libraryNetrcFileEnvVar   = "ALZ_PROVIDER_LIBRARY_NETRC_FILE"
+)
+
+// libraryTokenUsername is the username sent with the token for HTTPS git clones.
+// GitHub, GitLab and Azure DevOps all accept a token as the password with any username.
+const libraryTokenUsername = "x-access-token"
+
+// libraryForcedGetterRegex matches the forced getter prefix of a go-getter source, e.g. `git::`.
+var libraryForcedGetterRegex = regexp.MustCompile(`^([A-Za-z0-9]+)::(.+)$`)
+
+// libraryCredentials are the credentials used to fetch a custom library reference.
+type libraryCredentials struct {
+	Token       string
+	HTTPHeaders http.Header
+	SSHKeyPath  string
+	NetrcFile   string
+}
+
+// generateLibraryCredentials returns the credentials of the custom library references that have them,
+// keyed by URL. Values that are not specified, or all values if there is no `credentials` block,
+// are read from environment variables.
+func generateLibraryCredentials(ctx context.Context, data *AlzModel) (map[string]libraryCredentials, diag.Diagnostics) {
+	alzLibRefs := make([]gen.LibraryReferencesValue, len(data.LibraryReferences.Elements()))
+	diags := data.LibraryReferences.ElementsAs(ctx, &alzLibRefs, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	res := make(map[string]libraryCredentials)
+	for _, libRef := range alzLibRefs {
+		if libRef.CustomUrl.IsNull() || libRef.Credentials.IsUnknown() {
+			continue
+		}
+		// Without a credentials block, all the values are read from the environment variables.
+		credentials := gen.NewCredentialsValueNull()
+		if !libRef.Credentials.IsNull() {
+			val, d := gen.CredentialsType{}.ValueFromObject(ctx, libRef.Credentials)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			var ok bool
+			credentials, ok = val.(gen.CredentialsValue)
+			if !ok {
+				diags.AddError("Invalid library reference credentials", fmt.Sprintf("unexpected type %T", val))
+				return nil, diags
+			}
+		}
+
+		creds := libraryCredentials{
+			Token:      stringValueOrEnv(credentials.Token, libraryTokenEnvVar),
+			SSHKeyPath: stringValueOrEnv(credentials.SshKeyPath, librarySSHKeyPathEnvVar),
+			NetrcFile:  stringValueOrEnv(credentials.NetrcFile, libraryNetrcFileEnvVar),
+		}
+		if credentials.HttpHeaders.IsNull() {
+			headers, err := parseLibraryHTTPHeaders(getFirstSetEnvVar(libraryHTTPHeadersEnvVar))
+			if err != nil {
+				diags.AddError(fmt.Sprintf("Invalid `%s` environment variable", libraryHTTPHeadersEnvVar), err.Error())
+				return nil, diags
+			}
+			creds.HTTPHeaders = headers
+		} else {
+			headers := make(map[string]string, len(credentials.HttpHeaders.Elements()))
+			diags.Append(credentials.HttpHeaders.ElementsAs(ctx, &headers, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			creds.HTTPHeaders = make(http.Header, len(headers))
+			for k, v := range headers {
+				creds.HTTPHeaders.Set(k, v)
+			}
+		}
+		if creds.Token == "" && creds.SSHKeyPath == "" && creds.NetrcFile == "" && len(creds.HTTPHeaders) == 0 {
+			continue
+		}
+		res[libRef.CustomUrl.ValueString()] = creds
+	}
+	return res, diags
+}
+
+// stringValueOrEnv returns the value, or the value of the environment variable if it is null.
+func stringValueOrEnv(v types.String, envVar string) string {
+	if v.IsNull() {
+		return getFirstSetEnvVar(envVar)
+	}
+	return v.ValueString()
+}
+
+// parseLibraryHTTPHeaders parses HTTP headers in `Name: value` format, one per line.
+func parseLibraryHTTPHeaders(s string) (http.Header, error) {
+	res := make(http.Header)
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("header %q is not in `Name: value` format", name)
+		}
+		res.Set(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+	return res, nil
+}
+
+// fetchLibrariesWithCredentials fetches the custom library references that have credentials, replacing them with
+// references to the fetched filesystem so that alzlib does not fetch them again without the credentials.
+func fetchLibrariesWithCredentials(ctx context.Context, libRefs alzlib.LibraryReferences, credentials map[string]libraryCredentials) (alzlib.LibraryReferences, error) {
+	res := make(alzlib.LibraryReferences, len(libRefs))
+	for i, ref := range libRefs {
+		res[i] = ref
+		creds, ok := credentials[ref.String()]
+		if !ok || ref.FS() != nil {
+			continue
+		}
+		lib, err := fetchLibraryWithCredentials(ctx, ref.String(), creds)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = alzlib.NewCustomLibraryReferenceFromFS(ref.String(), lib)
+	}
+	return res, nil
+}
+
+// newLibraryFetchFunc returns a fetch function that uses the credentials of custom library references, keyed by URL.
+// This allows library dependencies to be fetched with the credentials of the matching library reference.
+func newLibraryFetchFunc(credentials map[string]libraryCredentials) libraryFetchFunc {
+	return func(ctx context.Context, ref alzlib.LibraryReference) (fs.FS, error) {
+		creds, ok := credentials[ref.String()]
+		if _, custom := ref.(*alzlib.CustomLibraryReference); !ok || !custom || ref.FS() != nil {
+			return fetchLibrary(ctx, ref)
+		}
+		return fetchLibraryWithCredentials(ctx, ref.String(), creds)
+	}
+}
+
+// fetchLibraryWithCredentials fetches the custom library URL with go-getter into the same destination directory
+// as alzlib would, using the credentials.
+// Secrets are added to the source and the HTTP headers when fetching, so they are not part of the library reference,
+// and are removed from the git remote afterwards, so they are not left on disk.
+func fetchLibraryWithCredentials(ctx context.Context, customURL string, creds libraryCredentials) (fs.FS, error) {
+	src, secrets, err := libraryGetterSource(customURL, creds)
+	if err != nil {
+		return nil, fmt.Errorf("adding credentials to library %s: %w", customURL, err)
+	}
+	header, headerSecrets, err := libraryHTTPHeader(customURL, creds)
+	if err != nil {
+		return nil, fmt.Errorf("adding credentials to library %s: %w", customURL, err)
+	}
+	secrets = append(secrets, headerSecrets...)
+
+	// Use the default getters, with the headers added to the HTTP getter.
+	getters := make([]getter.Getter, len(getter.Getters))
+	for i, g := range getter.Getters {
+		if hg, ok := g.(*getter.HttpGetter); ok {
+			hg := *hg
+			hg.Header = header
+			g = &hg
+		}
+		getters[i] = g
+	}
+	client := getter.Client{
+		Getters:         getters,
+		DisableSymlinks: true,
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("getting working directory: %w", err)
+	}
+	dst := filepath.Join(alzLibDir(), strconv.Itoa(int(alzlib.Instance.Load())), libraryFetchDir(customURL))
+	if err := os.RemoveAll(dst); err != nil {
+		return nil, fmt.Errorf("cleaning destination directory %s: %w", dst, err)
+	}
+	if _, err := client.Get(ctx, &getter.Request{Src: src, Dst: dst, Pwd: wd}); err != nil {
+		return nil, fmt.Errorf("fetching library %s: %s", customURL, redactLibraryCredentials(err.Error(), secrets))
+	}
+	if err := removeLibraryGitRemoteCredentials(ctx, dst); err != nil {
+		// Do not use a library that has the credentials on disk.
+		_ = os.RemoveAll(dst)
+		return nil, fmt.Errorf("removing credentials from library %s: %s", customURL, redactLibraryCredentials(err.Error(), secrets))
+	}
+	return os.DirFS(dst), nil
+}
+
+// libraryGetterSource returns the go-getter source for the custom library URL with the credentials added,
+// and the secrets that were added.
+// The SSH key is added as the `sshkey` query parameter of the git getter, which go-getter writes to a temporary file.
+// For HTTPS git clones without user information, the token, or the login for the host in the netrc file, is added as
+// the user information, as git can only be given credentials through the URL or the environment of the process.
+// This includes sources that go-getter detects as git, e.g. `github.com/org/repo`, which are returned as detected.
+// It is removed from the git remote of the clone by removeLibraryGitRemoteCredentials.
+func libraryGetterSource(customURL string, creds libraryCredentials) (string, []string, error) {
+	var secrets []string
+	forced, src := libraryForcedGetter(customURL)
+	if forced == "" {
+		if detected, ok := libraryDetectGitSource(src); ok {
+			forced, src = "git::", detected
+		}
+	}
+
+	if u, err := url.Parse(src); err == nil && forced == "git::" && (u.Scheme == "http" || u.Scheme == "https") && u.User == nil {
+		switch {
+		case creds.Token != "":
+			u.User = url.UserPassword(libraryTokenUsername, creds.Token)
+			secrets = append(secrets, creds.Token)
+		case creds.NetrcFile != "":
+			m, err := libraryNetrcMachine(creds.NetrcFile, u.Hostname())
+			if err != nil {
+				return "", nil, err
+			}
+			if m != nil {
+				u.User = url.UserPassword(m.Login, m.Password)
+				secrets = append(secrets, m.Password)
+			}
+		}
+		src = u.String()
+	}
+
+	if creds.SSHKeyPath != "" {
+		key, err := os.ReadFile(creds.SSHKeyPath) // #nosec G304 -- path is provided by the operator via provider config.
+		if err != nil {
+			return "", nil, fmt.Errorf("reading SSH key: %w", err)
+		}
+		sep := "?"
+		if strings.Contains(src, "?") {
+			sep = "&"
+		}
+		sshKey := base64.StdEncoding.EncodeToString(key)
+		src += sep + "sshkey=" + url.QueryEscape(sshKey)
+		secrets = append(secrets, sshKey)
+	}
+	return forced + src, secrets, nil
+}
+
+// libraryHTTPHeader returns the headers for HTTP downloads of the custom library URL, and the secrets in them.
+// The token is sent as a bearer token, or, without a token, the login for the host in the netrc file is sent
+// using basic authentication. The configured HTTP headers take precedence.
+func libraryHTTPHeader(customURL string, creds libraryCredentials) (http.Header, []string, error) {
+	var secrets []string
+	header := make(http.Header)
+	forced, src := libraryForcedGetter(customURL)
+	switch {
+	case creds.Token != "":
+		header.Set("Authorization", "Bearer "+creds.Token)
+		secrets = append(secrets, creds.Token)
+	case creds.NetrcFile != "" && forced == "":
+		u, err := url.Parse(src)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.User != nil {
+			break
+		}
+		m, err := libraryNetrcMachine(creds.NetrcFile, u.Hostname())
+		if err != nil {
+			return nil, nil, err
+		}
+		if m != nil {
+			basic := base64.StdEncoding.EncodeToString([]byte(m.Login + ":" + m.Password))
+			header.Set("Authorization", "Basic "+basic)
+			secrets = append(secrets, m.Password, basic)
+		}
+	}
+	for k, v := range creds.HTTPHeaders {
+		header[k] = v
+		secrets = append(secrets, v...)
+	}
+	return header, secrets, nil
+}
+
+// libraryForcedGetter splits the custom library URL into the forced getter prefix, e.g. `git::`, and the source.
+func libraryForcedGetter(customURL string) (string, string) {
+	if m := libraryForcedGetterRegex.FindStringSubmatch(customURL); m != nil {
+		return m[1] + "::", m[2]
+	}
+	return "", customURL
+}
+
+// libraryDetectGitSource returns the source as detected by the go-getter git getter, e.g. `https://github.com/org/repo.git`
+// for `github.com/org/repo`, if the source without a forced getter is fetched with git.
+func libraryDetectGitSource(src string) (string, bool) {
+	for _, g := range getter.Getters {
+		gg, ok := g.(*getter.GitGetter)
+		if !ok {
+			continue
+		}
+		// The subdirectory is added back after detection, as go-getter does when fetching.
+		base, subdir := getter.SourceDirSubdir(src)
+		req := &getter.Request{Src: base}
+		if ok, err := gg.Detect(req); err != nil || !ok {
+			return "", false
+		}
+		if subdir == "" {
+			return req.Src, true
+		}
+		path, query, hasQuery := strings.Cut(req.Src, "?")
+		res := path + "//" + subdir
+		if hasQuery {
+			res += "?" + query
+		}
+		return res, true
+	}
+	return "", false
+}
+
+// libraryNetrcMachine returns the entry for the host in the netrc file, or nil if there is none.
+func libraryNetrcMachine(netrcFile, host string) (*netrc.Machine, error) {
+	n, err := netrc.ParseFile(netrcFile)
+	if err != nil {
+		return nil, fmt.Errorf("parsing netrc file: %w", err)
+	}
+	return n.FindMachine(host), nil
+}
+
+// removeLibraryGitRemoteCredentials removes the password from the origin remote URL of the git clone in dir,
+// which go-getter keeps when the library is at the root of the repository.
+// git does not write the credentials of the clone URL anywhere else in the repository.
+func removeLibraryGitRemoteCredentials(ctx context.Context, dir string) error {
+	if _, err := os.Stat(filepath.Join(dir, ".git")); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	out, err := exec.CommandContext(ctx, "git", "-C", dir, "remote", "get-url", "origin").Output()
+	if err != nil {
+		return fmt.Errorf("getting git remote: %w", err)
+	}
+	// scp-like addresses, e.g. git@github.com:contoso/lib.git, are not URLs and have no password.
+	u, err := url.Parse(strings.TrimSpace(string(out)))
+	if err != nil || u.User == nil {
+		return nil
+	}
+	if _, ok := u.User.Password(); !ok {
+		return nil
+	}
+	u.User = nil
+	if out, err := exec.CommandContext(ctx, "git", "-C", dir, "remote", "set-url", "origin", u.String()).CombinedOutput(); err != nil {
+		return fmt.Errorf("setting git remote: %w: %s", err, out)
+	}
+	return nil
+}
+
+// redactLibraryCredentials replaces the secrets in s, which may have been included in go-getter errors.
+// The URL escaped forms of the secrets are also replaced, as the secrets may have been added to the source URL.
+func redactLibraryCredentials(s string, secrets []string) string {
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		for _, v := range []string{secret, url.QueryEscape(secret), url.PathEscape(secret)} {
+			s = strings.ReplaceAll(s, v, "REDACTED")
+		}
+	}
+	return s
+}
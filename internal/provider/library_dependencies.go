@@ -0,0 +1,355 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/terraform-provider-alz/internal/clients"
+)
+
+// The strategies for resolving library dependencies that reference different refs of the same library.
+// The default, `all`, loads every requested ref, as alzlib.LibraryReferences.FetchWithDependencies() does.
+const (
+	libraryDependencyConflictResolutionAll      = "all"
+	libraryDependencyConflictResolutionError    = "error"
+	libraryDependencyConflictResolutionHighest  = "highest"
+	libraryDependencyConflictResolutionExplicit = "explicit"
+)
+
+// libraryMetadataFileName is the name of the library metadata file, which contains the library dependencies.
+const libraryMetadataFileName = "alz_library_metadata.json"
+
+// libraryDependencyResolutionMaxIterations limits the number of times the dependency graph is walked,
+// in case the chosen refs never settle.
+const libraryDependencyResolutionMaxIterations = 100
+
+// libraryFetchFunc fetches a library reference and returns its filesystem.
+type libraryFetchFunc func(ctx context.Context, ref alzlib.LibraryReference) (fs.FS, error)
+
+// fetchLibrary fetches the library reference into the same destination directory as alzlib.Init() would.
+func fetchLibrary(ctx context.Context, ref alzlib.LibraryReference) (fs.FS, error) {
+	if ref.FS() != nil {
+		return ref.FS(), nil
+	}
+	return ref.Fetch(ctx, libraryFetchDir(ref.String()))
+}
+
+// libraryFetchDir returns the directory, relative to the alzlib instance directory, that alzlib.Init() fetches
+// the library reference into.
+func libraryFetchDir(ref string) string {
+	return fmt.Sprintf("%x", sha256.Sum224([]byte(ref)))
+}
+
+// libraryRequest is a request for a library reference, either from the provider configuration or as a dependency.
+type libraryRequest struct {
+	ref         alzlib.LibraryReference
+	requestedBy string
+}
+
+// libraryDependencyResolver fetches library references and their dependencies,
+// choosing a single ref for each library according to the strategy.
+type libraryDependencyResolver struct {
+	strategy string
+	fetch    libraryFetchFunc
+	explicit map[string]bool
+	// deps are the dependencies of the fetched library references, keyed by library reference.
+	deps map[string]alzlib.LibraryReferences
+	// fetched are the fetched library references, keyed by library reference.
+	fetched map[string]alzlib.LibraryReference
+	// chosen are the refs used for the libraries with conflicting requests, keyed by library.
+	chosen map[string]alzlib.LibraryReference
+}
+
+// resolveLibraryDependencies fetches the library references and their dependencies, in the same order as
+// alzlib.LibraryReferences.FetchWithDependencies(), i.e. dependencies before the libraries that require them.
+// When different refs of the same library are requested, the strategy chooses the ref that is used.
+// The dependency graph of the result is returned for reporting.
+func resolveLibraryDependencies(ctx context.Context, libRefs alzlib.LibraryReferences, strategy string, fetch libraryFetchFunc) (alzlib.LibraryReferences, []clients.LibraryDependency, error) {
+	r := &libraryDependencyResolver{
+		strategy: strategy,
+		fetch:    fetch,
+		explicit: make(map[string]bool, len(libRefs)),
+		deps:     make(map[string]alzlib.LibraryReferences),
+		fetched:  make(map[string]alzlib.LibraryReference),
+		chosen:   make(map[string]alzlib.LibraryReference),
+	}
+	for _, ref := range libRefs {
+		r.explicit[ref.String()] = true
+	}
+
+	// Walk the graph until the chosen refs no longer change.
+	// Choosing a ref can add or remove requests, as the dependencies of the replaced ref are no longer walked.
+	var requests map[string][]libraryRequest
+	for i := 0; ; i++ {
+		if i == libraryDependencyResolutionMaxIterations {
+			return nil, nil, errors.New("library dependency resolution did not settle, check for circular dependencies between library refs")
+		}
+		var err error
+		if requests, err = r.walk(ctx, libRefs); err != nil {
+			return nil, nil, err
+		}
+		changed, err := r.choose(requests)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !changed {
+			break
+		}
+	}
+
+	// Order the result with dependencies first, as alzlib does.
+	var res alzlib.LibraryReferences
+	var graph []clients.LibraryDependency
+	processed := make(map[string]bool)
+	var visit func(ref alzlib.LibraryReference)
+	visit = func(ref alzlib.LibraryReference) {
+		ref = r.resolve(ref)
+		if processed[ref.String()] {
+			return
+		}
+		processed[ref.String()] = true
+		deps := make([]string, 0, len(r.deps[ref.String()]))
+		for _, dep := range r.deps[ref.String()] {
+			visit(dep)
+			deps = append(deps, r.resolve(dep).String())
+		}
+		var replaced []string
+		if _, ok := r.chosen[libraryIdentity(ref)]; ok {
+			for _, req := range requests[libraryIdentity(ref)] {
+				if s := req.ref.String(); s != ref.String() && !slices.Contains(replaced, s) {
+					replaced = append(replaced, s)
+				}
+			}
+		}
+		res = append(res, r.fetched[ref.String()])
+		graph = append(graph, clients.LibraryDependency{
+			LibraryReference:          ref.String(),
+			Explicit:                  r.explicit[ref.String()],
+			Dependencies:              deps,
+			ReplacedLibraryReferences: replaced,
+		})
+	}
+	for _, ref := range libRefs {
+		visit(ref)
+	}
+	return res, graph, nil
+}
+
+// walk fetches the library references and their dependencies, using the chosen refs.
+// It returns the requests for each library, in the order they were made.
+func (r *libraryDependencyResolver) walk(ctx context.Context, libRefs alzlib.LibraryReferences) (map[string][]libraryRequest, error) {
+	requests := make(map[string][]libraryRequest)
+	visited := make(map[string]bool)
+	var visit func(ref alzlib.LibraryReference, requestedBy string) error
+	visit = func(ref alzlib.LibraryReference, requestedBy string) error {
+		id := libraryIdentity(ref)
+		requests[id] = append(requests[id], libraryRequest{ref: ref, requestedBy: requestedBy})
+		ref = r.resolve(ref)
+		if visited[ref.String()] {
+			return nil
+		}
+		visited[ref.String()] = true
+		deps, err := r.dependencies(ctx, ref)
+		if err != nil {
+			return err
+		}
+		for _, dep := range deps {
+			if err := visit(dep, ref.String()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, ref := range libRefs {
+		if err := visit(ref, "library_references"); err != nil {
+			return nil, err
+		}
+	}
+	return requests, nil
+}
+
+// dependencies fetches the library reference, if it has not already been fetched, and returns its dependencies.
+func (r *libraryDependencyResolver) dependencies(ctx context.Context, ref alzlib.LibraryReference) (alzlib.LibraryReferences, error) {
+	if deps, ok := r.deps[ref.String()]; ok {
+		return deps, nil
+	}
+	lib, err := r.fetch(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("fetching library %s: %w", ref, err)
+	}
+	deps, err := libraryMetadataDependencies(lib)
+	if err != nil {
+		return nil, fmt.Errorf("reading metadata of library %s: %w", ref, err)
+	}
+	// The fetch function may not store the filesystem on the reference, e.g. when fetching with credentials,
+	// so keep a reference to the filesystem to prevent alzlib.Init() fetching it again.
+	if _, ok := ref.(*alzlib.CustomLibraryReference); ok && ref.FS() == nil {
+		ref = alzlib.NewCustomLibraryReferenceFromFS(ref.String(), lib)
+	}
+	r.deps[ref.String()] = deps
+	r.fetched[ref.String()] = ref
+	return deps, nil
+}
+
+// choose chooses the ref for each library with requests for more than one ref, according to the strategy.
+// It returns true if any chosen ref has changed.
+func (r *libraryDependencyResolver) choose(requests map[string][]libraryRequest) (bool, error) {
+	if r.strategy == libraryDependencyConflictResolutionAll {
+		return false, nil
+	}
+	ids := make([]string, 0, len(requests))
+	for id := range requests {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	changed := false
+	for _, id := range ids {
+		var refs alzlib.LibraryReferences
+		for _, req := range requests[id] {
+			if !slices.ContainsFunc(refs, func(ref alzlib.LibraryReference) bool { return ref.String() == req.ref.String() }) {
+				refs = append(refs, req.ref)
+			}
+		}
+		if len(refs) < 2 {
+			continue
+		}
+
+		var winner alzlib.LibraryReference
+		switch r.strategy {
+		case libraryDependencyConflictResolutionHighest:
+			winner = slices.MaxFunc(refs, func(a, b alzlib.LibraryReference) int {
+				return compareLibraryRefs(libraryRef(a), libraryRef(b))
+			})
+		case libraryDependencyConflictResolutionExplicit:
+			explicit := slices.DeleteFunc(slices.Clone(refs), func(ref alzlib.LibraryReference) bool { return !r.explicit[ref.String()] })
+			if len(explicit) != 1 {
+				return false, fmt.Errorf(
+					"library %s is requested at more than one ref and %s, so the `explicit` conflict resolution cannot choose one: %s",
+					id, explicitCountDescription(len(explicit)), libraryRequestsDescription(requests[id]),
+				)
+			}
+			winner = explicit[0]
+		default:
+			return false, fmt.Errorf(
+				"library %s is requested at more than one ref: %s. Set `library_dependency_conflict_resolution` to choose a ref",
+				id, libraryRequestsDescription(requests[id]),
+			)
+		}
+
+		if prev, ok := r.chosen[id]; !ok || prev.String() != winner.String() {
+			r.chosen[id] = winner
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+// resolve returns the chosen ref for the library of the library reference, or the library reference itself.
+func (r *libraryDependencyResolver) resolve(ref alzlib.LibraryReference) alzlib.LibraryReference {
+	if chosen, ok := r.chosen[libraryIdentity(ref)]; ok {
+		return chosen
+	}
+	return ref
+}
+
+// explicitCountDescription describes the number of explicit refs for a conflict error.
+func explicitCountDescription(n int) string {
+	if n == 0 {
+		return "none of them are in `library_references`"
+	}
+	return "more than one of them is in `library_references`"
+}
+
+// libraryRequestsDescription describes the requests for a library for a conflict error.
+func libraryRequestsDescription(requests []libraryRequest) string {
+	res := make([]string, len(requests))
+	for i, req := range requests {
+		res[i] = fmt.Sprintf("%s (requested by %s)", req.ref, req.requestedBy)
+	}
+	return strings.Join(res, ", ")
+}
+
+// libraryIdentity returns the identity of the library of the library reference, which is the same for all refs of
+// the library.
+// ALZ library references are identified by their path, custom library references by their URL.
+func libraryIdentity(ref alzlib.LibraryReference) string {
+	if alzRef, ok := ref.(*alzlib.AlzLibraryReference); ok {
+		return alzRef.Path()
+	}
+	return ref.String()
+}
+
+// libraryRef returns the ref of an ALZ library reference, or an empty string for custom library references.
+func libraryRef(ref alzlib.LibraryReference) string {
+	if alzRef, ok := ref.(*alzlib.AlzLibraryReference); ok {
+		return alzRef.Ref()
+	}
+	return ""
+}
+
+// compareLibraryRefs compares two library refs, e.g. `2024.07.5` and `2025.01.0`.
+// The refs are split into dot or dash separated segments, which are compared numerically if both are numbers,
+// otherwise lexically. A leading `v` is ignored.
+func compareLibraryRefs(a, b string) int {
+	isSep := func(r rune) bool { return r == '.' || r == '-' }
+	as := strings.FieldsFunc(strings.TrimPrefix(a, "v"), isSep)
+	bs := strings.FieldsFunc(strings.TrimPrefix(b, "v"), isSep)
+	for i := range min(len(as), len(bs)) {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		if aErr == nil && bErr == nil {
+			if c := cmp.Compare(an, bn); c != 0 {
+				return c
+			}
+			continue
+		}
+		if c := strings.Compare(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(as), len(bs))
+}
+
+// libraryMetadataDependencies returns the dependencies from the library metadata file.
+// Libraries without a metadata file have no dependencies.
+func libraryMetadataDependencies(lib fs.FS) (alzlib.LibraryReferences, error) {
+	data, err := fs.ReadFile(lib, libraryMetadataFileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var meta struct {
+		Dependencies []struct {
+			Path      string `json:"path"`
+			Ref       string `json:"ref"`
+			CustomURL string `json:"custom_url"`
+		} `json:"dependencies"`
+	}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("unmarshaling %s: %w", libraryMetadataFileName, err)
+	}
+	res := make(alzlib.LibraryReferences, len(meta.Dependencies))
+	for i, dep := range meta.Dependencies {
+		if dep.CustomURL != "" {
+			res[i] = alzlib.NewCustomLibraryReference(dep.CustomURL)
+			continue
+		}
+		res[i] = alzlib.NewAlzLibraryReference(dep.Path, dep.Ref)
+	}
+	return res, nil
+}
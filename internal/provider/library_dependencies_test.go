@@ -0,0 +1,183 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/terraform-provider-alz/internal/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLibraryDependenciesTestFetch returns a fetch function that serves libraries with the supplied dependencies,
+// keyed by library reference. Dependencies are in `path@ref` format.
+func newLibraryDependenciesTestFetch(t *testing.T, libs map[string][]string) libraryFetchFunc {
+	t.Helper()
+	fss := make(map[string]fs.FS, len(libs))
+	for ref, deps := range libs {
+		type dependency struct {
+			Path string `json:"path"`
+			Ref  string `json:"ref"`
+		}
+		meta := struct {
+			Dependencies []dependency `json:"dependencies"`
+		}{}
+		for _, dep := range deps {
+			path, ref, _ := strings.Cut(dep, "@")
+			meta.Dependencies = append(meta.Dependencies, dependency{Path: path, Ref: ref})
+		}
+		data, err := json.Marshal(meta)
+		require.NoError(t, err)
+		fss[ref] = fstest.MapFS{libraryMetadataFileName: {Data: data}}
+	}
+	return func(_ context.Context, ref alzlib.LibraryReference) (fs.FS, error) {
+		lib, ok := fss[ref.String()]
+		if !ok {
+			return nil, fmt.Errorf("library %s not found", ref)
+		}
+		return lib, nil
+	}
+}
+
+// libraryDependenciesTestLibs are the libraries used by the dependency resolution tests.
+// slz and amba depend on different refs of alz, and the older alz ref has a dependency of its own.
+var libraryDependenciesTestLibs = map[string][]string{
+	"platform/slz@2025.01.0":  {"platform/alz@2025.01.0"},
+	"platform/amba@2025.02.0": {"platform/alz@2024.07.5"},
+	"platform/alz@2025.01.0":  nil,
+	"platform/alz@2024.11.0":  nil,
+	"platform/alz@2024.07.5":  {"platform/old@1.0.0"},
+	"platform/old@1.0.0":      nil,
+}
+
+// libraryReferenceStrings returns the library references in `path@ref` format.
+func libraryReferenceStrings(refs alzlib.LibraryReferences) []string {
+	res := make([]string, len(refs))
+	for i, ref := range refs {
+		res[i] = ref.String()
+	}
+	return res
+}
+
+func TestResolveLibraryDependencies(t *testing.T) {
+	fetch := newLibraryDependenciesTestFetch(t, libraryDependenciesTestLibs)
+	slz := alzlib.NewAlzLibraryReference("platform/slz", "2025.01.0")
+	amba := alzlib.NewAlzLibraryReference("platform/amba", "2025.02.0")
+	alz := alzlib.NewAlzLibraryReference("platform/alz", "2024.11.0")
+
+	t.Run("No conflicts", func(t *testing.T) {
+		res, graph, err := resolveLibraryDependencies(t.Context(), alzlib.LibraryReferences{amba}, libraryDependencyConflictResolutionError, fetch)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"platform/old@1.0.0", "platform/alz@2024.07.5", "platform/amba@2025.02.0"}, libraryReferenceStrings(res))
+		assert.Equal(t, []clients.LibraryDependency{
+			{LibraryReference: "platform/old@1.0.0", Dependencies: []string{}},
+			{LibraryReference: "platform/alz@2024.07.5", Dependencies: []string{"platform/old@1.0.0"}},
+			{LibraryReference: "platform/amba@2025.02.0", Explicit: true, Dependencies: []string{"platform/alz@2024.07.5"}},
+		}, graph)
+	})
+
+	t.Run("All", func(t *testing.T) {
+		// Every requested ref is loaded, as alzlib.LibraryReferences.FetchWithDependencies() does.
+		res, graph, err := resolveLibraryDependencies(t.Context(), alzlib.LibraryReferences{slz, amba}, libraryDependencyConflictResolutionAll, fetch)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"platform/alz@2025.01.0", "platform/slz@2025.01.0", "platform/old@1.0.0", "platform/alz@2024.07.5", "platform/amba@2025.02.0",
+		}, libraryReferenceStrings(res))
+		for _, dep := range graph {
+			assert.Empty(t, dep.ReplacedLibraryReferences, dep.LibraryReference)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		_, _, err := resolveLibraryDependencies(t.Context(), alzlib.LibraryReferences{slz, amba}, libraryDependencyConflictResolutionError, fetch)
+		assert.ErrorContains(t, err, "library platform/alz is requested at more than one ref: "+
+			"platform/alz@2025.01.0 (requested by platform/slz@2025.01.0), platform/alz@2024.07.5 (requested by platform/amba@2025.02.0)")
+	})
+
+	t.Run("Highest", func(t *testing.T) {
+		res, graph, err := resolveLibraryDependencies(t.Context(), alzlib.LibraryReferences{slz, amba}, libraryDependencyConflictResolutionHighest, fetch)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"platform/alz@2025.01.0", "platform/slz@2025.01.0", "platform/amba@2025.02.0"}, libraryReferenceStrings(res))
+		assert.Equal(t, []clients.LibraryDependency{
+			{LibraryReference: "platform/alz@2025.01.0", Dependencies: []string{}, ReplacedLibraryReferences: []string{"platform/alz@2024.07.5"}},
+			{LibraryReference: "platform/slz@2025.01.0", Explicit: true, Dependencies: []string{"platform/alz@2025.01.0"}},
+			{LibraryReference: "platform/amba@2025.02.0", Explicit: true, Dependencies: []string{"platform/alz@2025.01.0"}},
+		}, graph)
+	})
+
+	t.Run("Explicit", func(t *testing.T) {
+		res, graph, err := resolveLibraryDependencies(t.Context(), alzlib.LibraryReferences{slz, amba, alz}, libraryDependencyConflictResolutionExplicit, fetch)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"platform/alz@2024.11.0", "platform/slz@2025.01.0", "platform/amba@2025.02.0"}, libraryReferenceStrings(res))
+		assert.Equal(t, []string{"platform/alz@2025.01.0", "platform/alz@2024.07.5"}, graph[0].ReplacedLibraryReferences)
+		assert.True(t, graph[0].Explicit)
+	})
+
+	t.Run("Explicit without top-level ref", func(t *testing.T) {
+		_, _, err := resolveLibraryDependencies(t.Context(), alzlib.LibraryReferences{slz, amba}, libraryDependencyConflictResolutionExplicit, fetch)
+		assert.ErrorContains(t, err, "library platform/alz is requested at more than one ref and none of them are in `library_references`")
+	})
+
+	t.Run("Explicit with more than one top-level ref", func(t *testing.T) {
+		alzNew := alzlib.NewAlzLibraryReference("platform/alz", "2025.01.0")
+		_, _, err := resolveLibraryDependencies(t.Context(), alzlib.LibraryReferences{alz, alzNew}, libraryDependencyConflictResolutionExplicit, fetch)
+		assert.ErrorContains(t, err, "more than one of them is in `library_references`")
+	})
+
+	t.Run("Fetch error", func(t *testing.T) {
+		missing := alzlib.NewAlzLibraryReference("platform/missing", "1.0.0")
+		_, _, err := resolveLibraryDependencies(t.Context(), alzlib.LibraryReferences{missing}, libraryDependencyConflictResolutionError, fetch)
+		assert.ErrorContains(t, err, "fetching library platform/missing@1.0.0")
+	})
+}
+
+func TestCompareLibraryRefs(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"2024.07.5", "2024.07.5", 0},
+		{"2024.07.5", "2024.10.0", -1},
+		{"2025.01.0", "2024.10.1", 1},
+		{"2024.07.10", "2024.07.9", 1},
+		{"v1.2.0", "1.10.0", -1},
+		{"2024.07.5", "2024.07.5.1", -1},
+		{"2024.07.5-beta", "2024.07.5-alpha", 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.a+"_"+tc.b, func(t *testing.T) {
+			assert.Equal(t, tc.expected, compareLibraryRefs(tc.a, tc.b))
+		})
+	}
+}
+
+func TestLibraryMetadataDependencies(t *testing.T) {
+	deps, err := libraryMetadataDependencies(fstest.MapFS{
+		libraryMetadataFileName: {Data: []byte(`{
+			"name": "test",
+			"dependencies": [
+				{"path": "platform/alz", "ref": "2025.01.0"},
+				{"custom_url": "github.com/example/lib"}
+			]
+		}`)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"platform/alz@2025.01.0", "github.com/example/lib"}, libraryReferenceStrings(deps))
+	assert.IsType(t, &alzlib.CustomLibraryReference{}, deps[1])
+
+	deps, err = libraryMetadataDependencies(fstest.MapFS{})
+	require.NoError(t, err)
+	assert.Empty(t, deps)
+
+	_, err = libraryMetadataDependencies(fstest.MapFS{libraryMetadataFileName: {Data: []byte(`{`)}})
+	assert.ErrorContains(t, err, "unmarshaling alz_library_metadata.json")
+}
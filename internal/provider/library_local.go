@@ -0,0 +1,74 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/terraform-provider-alz/internal/gen"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// localLibraryReference returns a library reference to the library directory at localPath.
+// Relative paths are resolved from the working directory, which Terraform sets to the configuration directory.
+// The reference is named localPath as supplied, so that the references in the lock file and in `alz_metadata` are
+// the same on every machine.
+func localLibraryReference(localPath string) (alzlib.LibraryReference, error) {
+	dir, err := localLibraryDir(localPath)
+	if err != nil {
+		return nil, err
+	}
+	return alzlib.NewCustomLibraryReferenceFromFS(localPath, os.DirFS(dir)), nil
+}
+
+// localLibraryDir returns the absolute path of the library directory at localPath.
+func localLibraryDir(localPath string) (string, error) {
+	dir, err := filepath.Abs(localPath)
+	if err != nil {
+		return "", fmt.Errorf("resolving library local path %q: %w", localPath, err)
+	}
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return "", fmt.Errorf("reading library local path %q: %w", localPath, err)
+	}
+	if !fi.IsDir() {
+		return "", fmt.Errorf("library local path %q is not a directory", localPath)
+	}
+	return dir, nil
+}
+
+// localLibrariesFingerprint returns a fingerprint of the content of the local path library references.
+// It is empty if there are none, and changes if the content of any of them changes.
+func localLibrariesFingerprint(ctx context.Context, data *AlzModel) (string, diag.Diagnostics) {
+	alzLibRefs := make([]gen.LibraryReferencesValue, len(data.LibraryReferences.Elements()))
+	diags := data.LibraryReferences.ElementsAs(ctx, &alzLibRefs, false)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	var sb strings.Builder
+	for _, libRef := range alzLibRefs {
+		if libRef.LocalPath.IsNull() || libRef.LocalPath.IsUnknown() {
+			continue
+		}
+		localPath := libRef.LocalPath.ValueString()
+		dir, err := localLibraryDir(localPath)
+		if err != nil {
+			diags.AddError("Invalid library local path", err.Error())
+			return "", diags
+		}
+		hash, err := libraryContentHash(os.DirFS(dir))
+		if err != nil {
+			diags.AddError("Failed to fingerprint local library", fmt.Sprintf("hashing library %s: %s", localPath, err))
+			return "", diags
+		}
+		fmt.Fprintf(&sb, "%s  %s\n", hash, localPath)
+	}
+	return sb.String(), diags
+}
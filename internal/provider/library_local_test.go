@@ -0,0 +1,88 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalLibraryReference(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.alz_policy_assignment.json"), []byte(`{"name": "a"}`), 0o600))
+	t.Chdir(dir)
+
+	ref, err := localLibraryReference(".")
+	require.NoError(t, err)
+	assert.Equal(t, ".", ref.String())
+	_, err = fs.Stat(ref.FS(), "a.alz_policy_assignment.json")
+	assert.NoError(t, err)
+
+	_, err = localLibraryReference("missing")
+	assert.ErrorContains(t, err, `reading library local path "missing"`)
+
+	_, err = localLibraryReference("a.alz_policy_assignment.json")
+	assert.ErrorContains(t, err, `library local path "a.alz_policy_assignment.json" is not a directory`)
+}
+
+func TestLocalLibrariesFingerprint(t *testing.T) {
+	ctx := t.Context()
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "lib1"), 0o700))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "lib2"), 0o700))
+	file := filepath.Join(dir, "lib2", "a.alz_policy_assignment.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"name": "a"}`), 0o600))
+	t.Chdir(dir)
+
+	var data AlzModel
+	data.LibraryReferences = newLibraryReferencesList(ctx, t)
+	fingerprint, diags := localLibrariesFingerprint(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+	assert.Empty(t, fingerprint)
+
+	data.LibraryReferences = newLibraryReferencesList(ctx, t,
+		libraryReferencesManifestEntry{LocalPath: "lib1"},
+		libraryReferencesManifestEntry{LocalPath: "lib2"},
+	)
+	fingerprint, diags = localLibrariesFingerprint(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+	assert.Regexp(t, `^h1:\S+  lib1\nh1:\S+  lib2\n$`, fingerprint)
+
+	unchanged, diags := localLibrariesFingerprint(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, fingerprint, unchanged)
+
+	require.NoError(t, os.WriteFile(file, []byte(`{"name": "changed"}`), 0o600))
+	changed, diags := localLibrariesFingerprint(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+	assert.NotEqual(t, fingerprint, changed)
+
+	data.LibraryReferences = newLibraryReferencesList(ctx, t, libraryReferencesManifestEntry{LocalPath: "missing"})
+	_, diags = localLibrariesFingerprint(ctx, &data)
+	assert.True(t, diags.HasError())
+}
+
+func TestGenerateLibraryDefinitionsLocalPath(t *testing.T) {
+	ctx := t.Context()
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "lib"), 0o700))
+	t.Chdir(dir)
+
+	var data AlzModel
+	data.LibraryReferences = newLibraryReferencesList(ctx, t, libraryReferencesManifestEntry{LocalPath: "lib"})
+	libRefs, diags := generateLibraryDefinitions(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, libRefs, 1)
+	assert.Equal(t, "lib", libRefs[0].String())
+	assert.NotNil(t, libRefs[0].FS())
+
+	data.LibraryReferences = newLibraryReferencesList(ctx, t, libraryReferencesManifestEntry{LocalPath: "missing"})
+	_, diags = generateLibraryDefinitions(ctx, &data)
+	assert.True(t, diags.HasError())
+}
@@ -0,0 +1,202 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/terraform-provider-alz/internal/clients"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// libraryLockFileVersion is the version of the lock file format.
+const libraryLockFileVersion = 1
+
+// libraryLockFile records the resolved library references and the content hash of each library.
+type libraryLockFile struct {
+	Version   int                `json:"version"`
+	Libraries []libraryLockEntry `json:"libraries"`
+}
+
+// libraryLockEntry is a library reference in the lock file.
+type libraryLockEntry struct {
+	LibraryReference string   `json:"library_reference"`
+	Hash             string   `json:"hash"`
+	Dependencies     []string `json:"dependencies"`
+}
+
+// checkLibraryLockFile checks the library references against the lock file at path.
+// If the lock file does not exist, or update is true, the lock file is (re)written instead.
+// The library references are fetched if they have not been already.
+// The graph is the resolved dependency graph, which is nil if dependencies were not fetched.
+func checkLibraryLockFile(ctx context.Context, path string, libRefs alzlib.LibraryReferences, graph []clients.LibraryDependency, update bool) error {
+	current, err := newLibraryLockFile(ctx, libRefs, graph)
+	if err != nil {
+		return err
+	}
+
+	locked, err := loadLibraryLockFile(path)
+	if err != nil {
+		return err
+	}
+	if locked == nil || update {
+		if err := saveLibraryLockFile(path, current); err != nil {
+			return err
+		}
+		tflog.Debug(ctx, "Saved library lock file", map[string]interface{}{
+			"library_lock_file_name": path,
+		})
+		return nil
+	}
+
+	if err := locked.verify(current); err != nil {
+		return fmt.Errorf(
+			"the libraries do not match lock file %q, set `library_lock_file_update_enabled` to update the lock file if the changes are expected:\n%w",
+			path, err,
+		)
+	}
+	return nil
+}
+
+// newLibraryLockFile returns the lock file for the library references, fetching them if required.
+func newLibraryLockFile(ctx context.Context, libRefs alzlib.LibraryReferences, graph []clients.LibraryDependency) (*libraryLockFile, error) {
+	deps := make(map[string][]string, len(graph))
+	for _, dep := range graph {
+		deps[dep.LibraryReference] = dep.Dependencies
+	}
+
+	res := &libraryLockFile{
+		Version:   libraryLockFileVersion,
+		Libraries: make([]libraryLockEntry, len(libRefs)),
+	}
+	for i, ref := range libRefs {
+		lib, err := fetchLibrary(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("fetching library %s: %w", ref, err)
+		}
+		hash, err := libraryContentHash(lib)
+		if err != nil {
+			return nil, fmt.Errorf("hashing library %s: %w", ref, err)
+		}
+		entryDeps := deps[ref.String()]
+		if entryDeps == nil {
+			entryDeps = []string{}
+		}
+		res.Libraries[i] = libraryLockEntry{
+			LibraryReference: ref.String(),
+			Hash:             hash,
+			Dependencies:     entryDeps,
+		}
+	}
+	return res, nil
+}
+
+// verify returns an error describing each difference between the lock file and the current libraries.
+func (l *libraryLockFile) verify(current *libraryLockFile) error {
+	if l.Version != current.Version {
+		return fmt.Errorf("lock file version %d is not supported, expected version %d", l.Version, current.Version)
+	}
+
+	var errs []error
+	for _, entry := range current.Libraries {
+		idx := slices.IndexFunc(l.Libraries, func(e libraryLockEntry) bool { return e.LibraryReference == entry.LibraryReference })
+		if idx == -1 {
+			errs = append(errs, fmt.Errorf("library %s is not in the lock file", entry.LibraryReference))
+			continue
+		}
+		locked := l.Libraries[idx]
+		if locked.Hash != entry.Hash {
+			errs = append(errs, fmt.Errorf(
+				"the content of library %s has changed, the lock file has hash %s and the fetched library has hash %s",
+				entry.LibraryReference, locked.Hash, entry.Hash,
+			))
+		}
+		if !slices.Equal(locked.Dependencies, entry.Dependencies) {
+			errs = append(errs, fmt.Errorf(
+				"the dependencies of library %s have changed, the lock file has [%s] and the resolved dependencies are [%s]",
+				entry.LibraryReference, strings.Join(locked.Dependencies, ", "), strings.Join(entry.Dependencies, ", "),
+			))
+		}
+	}
+	for _, locked := range l.Libraries {
+		if !slices.ContainsFunc(current.Libraries, func(e libraryLockEntry) bool { return e.LibraryReference == locked.LibraryReference }) {
+			errs = append(errs, fmt.Errorf("library %s is in the lock file but is no longer used", locked.LibraryReference))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// libraryVCSDirs are the version control metadata directories, which are kept in git clones of libraries
+// and change on every fetch.
+var libraryVCSDirs = []string{".git", ".hg", ".svn"}
+
+// isLibraryIgnoredDir returns true if the directory of a library filesystem is not part of the library content:
+// the alzlib fetch directory, which is skipped by the alzlib processor, and version control metadata directories.
+func isLibraryIgnoredDir(name string) bool {
+	return name == filepath.Base(alzLibDir()) || slices.Contains(libraryVCSDirs, name)
+}
+
+// libraryContentHash returns a `h1:` prefixed SHA-256 hash of the paths and content of the files in the library
+// filesystem. The directories that are not part of the library content are skipped.
+func libraryContentHash(lib fs.FS) (string, error) {
+	h := sha256.New()
+	err := fs.WalkDir(lib, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != "." && isLibraryIgnoredDir(d.Name()) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		data, err := fs.ReadFile(lib, path)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "%x  %s\n", sha256.Sum256(data), path)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return "h1:" + base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// loadLibraryLockFile loads the lock file at path. If the file does not exist, nil is returned.
+func loadLibraryLockFile(path string) (*libraryLockFile, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is provided by the operator via provider config.
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading lock file %q: %w", path, err)
+	}
+	var res libraryLockFile
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf("unmarshaling lock file %q: %w", path, err)
+	}
+	return &res, nil
+}
+
+// saveLibraryLockFile writes the lock file to path.
+func saveLibraryLockFile(path string, lock *libraryLockFile) error {
+	return writeFileAtomic(path, func(w io.Writer) error {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(lock)
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/terraform-provider-alz/internal/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibraryContentHash(t *testing.T) {
+	lib := fstest.MapFS{
+		"a.alz_policy_assignment.json":        {Data: []byte(`{"name": "a"}`)},
+		"sub/b.alz_policy_definition.json":    {Data: []byte(`{"name": "b"}`)},
+		".alzlib/cached/c.alz_archetype.json": {Data: []byte(`{"name": "c"}`)},
+	}
+	hash, err := libraryContentHash(lib)
+	require.NoError(t, err)
+	assert.Regexp(t, `^h1:[A-Za-z0-9+/]{43}=$`, hash)
+
+	// The alzlib fetch directory is not hashed.
+	lib[".alzlib/cached/c.alz_archetype.json"] = &fstest.MapFile{Data: []byte(`{"name": "changed"}`)}
+	unchanged, err := libraryContentHash(lib)
+	require.NoError(t, err)
+	assert.Equal(t, hash, unchanged)
+
+	// The git metadata of a clone changes on every fetch, and is not hashed.
+	lib[".git/index"] = &fstest.MapFile{Data: []byte("index")}
+	lib[".git/logs/HEAD"] = &fstest.MapFile{Data: []byte("clone")}
+	lib["sub/.hg/store"] = &fstest.MapFile{Data: []byte("store")}
+	unchanged, err = libraryContentHash(lib)
+	require.NoError(t, err)
+	assert.Equal(t, hash, unchanged)
+
+	// Files whose names only contain the name of an ignored directory are hashed.
+	lib["foo.alzlib.json"] = &fstest.MapFile{Data: []byte(`{}`)}
+	withFile, err := libraryContentHash(lib)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, withFile)
+	delete(lib, "foo.alzlib.json")
+
+	lib["sub/b.alz_policy_definition.json"] = &fstest.MapFile{Data: []byte(`{"name": "changed"}`)}
+	changed, err := libraryContentHash(lib)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+
+	// Renaming a file changes the hash.
+	lib["sub/renamed.alz_policy_definition.json"] = lib["sub/b.alz_policy_definition.json"]
+	delete(lib, "sub/b.alz_policy_definition.json")
+	renamed, err := libraryContentHash(lib)
+	require.NoError(t, err)
+	assert.NotEqual(t, changed, renamed)
+}
+
+func TestLibraryLockFileVerify(t *testing.T) {
+	locked := &libraryLockFile{
+		Version: libraryLockFileVersion,
+		Libraries: []libraryLockEntry{
+			{LibraryReference: "platform/alz@2025.01.0", Hash: "h1:alz", Dependencies: []string{}},
+			{LibraryReference: "platform/slz@2025.01.0", Hash: "h1:slz", Dependencies: []string{"platform/alz@2025.01.0"}},
+			{LibraryReference: "removed", Hash: "h1:removed", Dependencies: []string{}},
+		},
+	}
+
+	assert.NoError(t, locked.verify(locked))
+
+	current := &libraryLockFile{
+		Version: libraryLockFileVersion,
+		Libraries: []libraryLockEntry{
+			{LibraryReference: "platform/alz@2025.01.0", Hash: "h1:changed", Dependencies: []string{}},
+			{LibraryReference: "platform/slz@2025.01.0", Hash: "h1:slz", Dependencies: []string{}},
+			{LibraryReference: "added", Hash: "h1:added", Dependencies: []string{}},
+		},
+	}
+	err := locked.verify(current)
+	require.Error(t, err)
+	assert.Equal(t, "the content of library platform/alz@2025.01.0 has changed, the lock file has hash h1:alz and the fetched library has hash h1:changed\n"+
+		"the dependencies of library platform/slz@2025.01.0 have changed, the lock file has [platform/alz@2025.01.0] and the resolved dependencies are []\n"+
+		"library added is not in the lock file\n"+
+		"library removed is in the lock file but is no longer used", err.Error())
+
+	err = (&libraryLockFile{Version: 2}).verify(current)
+	assert.ErrorContains(t, err, "lock file version 2 is not supported, expected version 1")
+}
+
+func TestCheckLibraryLockFile(t *testing.T) {
+	ctx := t.Context()
+	path := filepath.Join(t.TempDir(), "alz.lock.json")
+	lib := fstest.MapFS{
+		"a.alz_policy_assignment.json": {Data: []byte(`{"name": "a"}`)},
+	}
+	libRefs := alzlib.LibraryReferences{
+		alzlib.NewCustomLibraryReferenceFromFS("dependency", fstest.MapFS{}),
+		alzlib.NewCustomLibraryReferenceFromFS("test", lib),
+	}
+	graph := []clients.LibraryDependency{
+		{LibraryReference: "dependency", Dependencies: []string{}},
+		{LibraryReference: "test", Explicit: true, Dependencies: []string{"dependency"}},
+	}
+
+	// The lock file is created when it does not exist.
+	require.NoError(t, checkLibraryLockFile(ctx, path, libRefs, graph, false))
+	locked, err := loadLibraryLockFile(path)
+	require.NoError(t, err)
+	require.Len(t, locked.Libraries, 2)
+	assert.Equal(t, "test", locked.Libraries[1].LibraryReference)
+	assert.Equal(t, []string{"dependency"}, locked.Libraries[1].Dependencies)
+
+	// Unchanged libraries match the lock file.
+	require.NoError(t, checkLibraryLockFile(ctx, path, libRefs, graph, false))
+
+	// Changed libraries do not match the lock file, which is left unchanged.
+	lib["a.alz_policy_assignment.json"] = &fstest.MapFile{Data: []byte(`{"name": "changed"}`)}
+	err = checkLibraryLockFile(ctx, path, libRefs, graph, false)
+	assert.ErrorContains(t, err, "the content of library test has changed")
+	assert.ErrorContains(t, err, "set `library_lock_file_update_enabled` to update the lock file")
+	unchanged, err := loadLibraryLockFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, locked, unchanged)
+
+	// Updating the lock file accepts the changes.
+	require.NoError(t, checkLibraryLockFile(ctx, path, libRefs, graph, true))
+	require.NoError(t, checkLibraryLockFile(ctx, path, libRefs, graph, false))
+
+	// Without a dependency graph, the libraries have no dependencies.
+	err = checkLibraryLockFile(ctx, path, libRefs, nil, false)
+	assert.ErrorContains(t, err, "the dependencies of library test have changed")
+}
+
+func TestLoadLibraryLockFile(t *testing.T) {
+	dir := t.TempDir()
+
+	locked, err := loadLibraryLockFile(filepath.Join(dir, "does-not-exist.json"))
+	require.NoError(t, err)
+	assert.Nil(t, locked)
+
+	path := filepath.Join(dir, "invalid.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{`), 0o600))
+	_, err = loadLibraryLockFile(path)
+	assert.ErrorContains(t, err, "unmarshaling lock file")
+}
@@ -0,0 +1,164 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/terraform-provider-alz/internal/clients"
+	"gopkg.in/yaml.v3"
+)
+
+// libraryAssetFileRegex matches the library files that contain a single named asset.
+// The first submatch is the file type, which is mapped to the asset type by libraryAssetTypes.
+// Archetype overrides are not included, as alzlib never allows them to be overwritten.
+var libraryAssetFileRegex = regexp.MustCompile(`^.+\.(alz_architecture_definition|alz_archetype_definition|alz_policy_assignment|alz_policy_definition|alz_policy_set_definition|alz_role_definition)\.(?:json|yaml|yml)$`)
+
+// libraryPolicyDefaultValuesFileRegex matches the library file that contains the policy default values.
+var libraryPolicyDefaultValuesFileRegex = regexp.MustCompile(`^alz_policy_default_values\.(?:json|yaml|yml)$`)
+
+// libraryAssetTypes maps the library file types to the asset types reported in the overwrites.
+var libraryAssetTypes = map[string]string{
+	"alz_architecture_definition": "architecture",
+	"alz_archetype_definition":    "archetype",
+	"alz_policy_assignment":       "policy_assignment",
+	"alz_policy_definition":       "policy_definition",
+	"alz_policy_set_definition":   "policy_set_definition",
+	"alz_role_definition":         "role_definition",
+}
+
+// libraryAsset identifies an asset in a library, in the same way as alzlib does when adding it.
+type libraryAsset struct {
+	AssetType string
+	Name      string
+	Version   string
+}
+
+// libraryAssetFile is the subset of the library files that is needed to identify the assets.
+type libraryAssetFile struct {
+	Name       string `json:"name" yaml:"name"`
+	Properties struct {
+		RoleName string `json:"roleName" yaml:"roleName"`
+		Version  string `json:"version" yaml:"version"`
+	} `json:"properties" yaml:"properties"`
+	Defaults []struct {
+		DefaultName string `json:"default_name" yaml:"default_name"`
+	} `json:"defaults" yaml:"defaults"`
+}
+
+// libraryOverwrites returns the library assets that are supplied by more than one of the library references.
+// The library references must have been fetched, e.g. by alzlib.Init().
+// Each entry records the library reference that last supplied the asset and the one that replaced it,
+// so an asset supplied by three library references is reported twice.
+// The entries are in library reference order, then sorted by asset type, name and version.
+func libraryOverwrites(libRefs alzlib.LibraryReferences) ([]clients.LibraryOverwrite, error) {
+	var res []clients.LibraryOverwrite
+	suppliers := make(map[libraryAsset]string)
+	for _, ref := range libRefs {
+		if ref.FS() == nil {
+			return nil, fmt.Errorf("library %s has not been fetched", ref)
+		}
+		libAssets, err := libraryAssets(ref.FS())
+		if err != nil {
+			return nil, fmt.Errorf("reading library %s: %w", ref, err)
+		}
+		for _, asset := range libAssets {
+			if original, exists := suppliers[asset]; exists {
+				res = append(res, clients.LibraryOverwrite{
+					AssetType:                   asset.AssetType,
+					Name:                        asset.Name,
+					Version:                     asset.Version,
+					OriginalLibraryReference:    original,
+					OverwritingLibraryReference: ref.String(),
+				})
+			}
+			suppliers[asset] = ref.String()
+		}
+	}
+	return res, nil
+}
+
+// libraryAssets returns the assets in the library filesystem, sorted by asset type, name and version.
+// alzlib does not record which library supplied an asset, and its processor is an internal package that cannot be
+// imported, so the files are selected and decoded in the same way as the processor, including skipping the alzlib
+// fetch directory and using the same YAML package. TestLibraryAssetsMatchAlzlib checks the result against alzlib.
+func libraryAssets(lib fs.FS) ([]libraryAsset, error) {
+	alzLibDirBase := filepath.Base(alzLibDir())
+	var res []libraryAsset
+	err := fs.WalkDir(lib, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.Contains(path, alzLibDirBase) {
+			return nil
+		}
+		name := strings.ToLower(d.Name())
+		var fileType string
+		switch {
+		case libraryPolicyDefaultValuesFileRegex.MatchString(name):
+		case libraryAssetFileRegex.MatchString(name):
+			fileType = libraryAssetFileRegex.FindStringSubmatch(name)[1]
+		default:
+			return nil
+		}
+
+		data, err := fs.ReadFile(lib, path)
+		if err != nil {
+			return err
+		}
+		var file libraryAssetFile
+		if strings.ToLower(filepath.Ext(name)) == ".json" {
+			err = json.Unmarshal(data, &file)
+		} else {
+			err = yaml.Unmarshal(data, &file)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshaling %s: %w", path, err)
+		}
+
+		switch fileType {
+		case "":
+			for _, def := range file.Defaults {
+				res = append(res, libraryAsset{AssetType: "policy_default_value", Name: def.DefaultName})
+			}
+		case "alz_role_definition":
+			// alzlib uses the role name as the key, as the name is a GUID.
+			res = append(res, libraryAsset{AssetType: libraryAssetTypes[fileType], Name: file.Properties.RoleName})
+		case "alz_policy_definition", "alz_policy_set_definition":
+			res = append(res, libraryAsset{AssetType: libraryAssetTypes[fileType], Name: file.Name, Version: file.Properties.Version})
+		default:
+			res = append(res, libraryAsset{AssetType: libraryAssetTypes[fileType], Name: file.Name})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(res, func(a, b libraryAsset) int {
+		if c := strings.Compare(a.AssetType, b.AssetType); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Version, b.Version)
+	})
+	return res, nil
+}
+
+// alzLibDir returns the alzlib fetch directory, which is set by the `ALZLIB_DIR` environment variable.
+func alzLibDir() string {
+	if dir := os.Getenv("ALZLIB_DIR"); dir != "" {
+		return dir
+	}
+	return ".alzlib"
+}
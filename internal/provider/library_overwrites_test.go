@@ -0,0 +1,135 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/terraform-provider-alz/internal/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLibraryOverwritesTestRefs returns three library references that supply some of the same assets.
+func newLibraryOverwritesTestRefs() alzlib.LibraryReferences {
+	first := fstest.MapFS{
+		"pa.alz_policy_assignment.json":         {Data: []byte(`{"name": "pa", "properties": {"displayName": "first"}}`)},
+		"other.alz_policy_assignment.json":      {Data: []byte(`{"name": "other"}`)},
+		"pd.alz_policy_definition.json":         {Data: []byte(`{"name": "pd", "properties": {"version": "1.0.0"}}`)},
+		"rd.alz_role_definition.json":           {Data: []byte(`{"name": "00000000-0000-0000-0000-000000000000", "properties": {"roleName": "role"}}`)},
+		"alz_policy_default_values.json":        {Data: []byte(`{"defaults": [{"default_name": "log_analytics"}, {"default_name": "location"}]}`)},
+		"arch.alz_architecture_definition.json": {Data: []byte(`{"name": "arch"}`)},
+		"README.md":                             {Data: []byte(`not an asset`)},
+	}
+	second := fstest.MapFS{
+		"sub/pa.alz_policy_assignment.yaml":            {Data: []byte("name: pa\nproperties:\n  displayName: second\n")},
+		"pd.alz_policy_definition.json":                {Data: []byte(`{"name": "pd", "properties": {"version": "2.0.0"}}`)},
+		"renamed.alz_role_definition.json":             {Data: []byte(`{"name": "11111111-1111-1111-1111-111111111111", "properties": {"roleName": "role"}}`)},
+		"ALZ_POLICY_DEFAULT_VALUES.YML":                {Data: []byte("defaults:\n  - default_name: location\n")},
+		".alzlib/cached/pa.alz_policy_assignment.json": {Data: []byte(`{"name": "other"}`)},
+	}
+	third := fstest.MapFS{
+		"pa.alz_policy_assignment.json":         {Data: []byte(`{"name": "pa"}`)},
+		"pd.alz_policy_definition.json":         {Data: []byte(`{"name": "pd", "properties": {"version": "1.0.0"}}`)},
+		"arch.alz_architecture_definition.json": {Data: []byte(`{"name": "arch"}`)},
+	}
+	return alzlib.LibraryReferences{
+		alzlib.NewCustomLibraryReferenceFromFS("first", first),
+		alzlib.NewCustomLibraryReferenceFromFS("second", second),
+		alzlib.NewCustomLibraryReferenceFromFS("third", third),
+	}
+}
+
+func TestLibraryOverwrites(t *testing.T) {
+	res, err := libraryOverwrites(newLibraryOverwritesTestRefs())
+	require.NoError(t, err)
+	assert.Equal(t, []clients.LibraryOverwrite{
+		{AssetType: "policy_assignment", Name: "pa", OriginalLibraryReference: "first", OverwritingLibraryReference: "second"},
+		{AssetType: "policy_default_value", Name: "location", OriginalLibraryReference: "first", OverwritingLibraryReference: "second"},
+		{AssetType: "role_definition", Name: "role", OriginalLibraryReference: "first", OverwritingLibraryReference: "second"},
+		{AssetType: "architecture", Name: "arch", OriginalLibraryReference: "first", OverwritingLibraryReference: "third"},
+		{AssetType: "policy_assignment", Name: "pa", OriginalLibraryReference: "second", OverwritingLibraryReference: "third"},
+		{AssetType: "policy_definition", Name: "pd", Version: "1.0.0", OriginalLibraryReference: "first", OverwritingLibraryReference: "third"},
+	}, res)
+}
+
+func TestLibraryOverwritesNoOverwrites(t *testing.T) {
+	refs := newLibraryOverwritesTestRefs()
+	res, err := libraryOverwrites(refs[:1])
+	require.NoError(t, err)
+	assert.Empty(t, res)
+}
+
+func TestLibraryOverwritesNotFetched(t *testing.T) {
+	_, err := libraryOverwrites(alzlib.LibraryReferences{alzlib.NewCustomLibraryReference("notfetched")})
+	assert.ErrorContains(t, err, "library notfetched has not been fetched")
+}
+
+func TestLibraryOverwritesInvalidFile(t *testing.T) {
+	refs := alzlib.LibraryReferences{
+		alzlib.NewCustomLibraryReferenceFromFS("invalid", fstest.MapFS{
+			"pa.alz_policy_assignment.json": {Data: []byte(`{`)},
+		}),
+	}
+	_, err := libraryOverwrites(refs)
+	assert.ErrorContains(t, err, "unmarshaling pa.alz_policy_assignment.json")
+}
+
+// TestLibraryAssetsMatchAlzlib checks that libraryAssets enumerates the same assets as alzlib loads from a library
+// with every asset type, so that a change to the alzlib file rules fails here rather than silently.
+func TestLibraryAssetsMatchAlzlib(t *testing.T) {
+	lib := fstest.MapFS{
+		"test.alz_architecture_definition.json": {Data: []byte(`{"name": "test", "management_groups": [
+			{"id": "root", "display_name": "Root", "archetypes": ["root"], "exists": false, "parent_id": null}
+		]}`)},
+		"root.alz_archetype_definition.yaml": {Data: []byte("name: root\npolicy_assignments: [pa]\npolicy_definitions: [pd]\n" +
+			"policy_set_definitions: [psd]\nrole_definitions: [role]\n")},
+		"sub/pa.alz_policy_assignment.json": {Data: []byte(`{"type": "Microsoft.Authorization/policyAssignments", "name": "pa", "location": "${default_location}",
+			"properties": {"displayName": "pa", "description": "pa", "parameters": {}, "notScopes": [],
+			"policyDefinitionId": "/providers/Microsoft.Management/managementGroups/placeholder/providers/Microsoft.Authorization/policyDefinitions/pd",
+			"scope": "/providers/Microsoft.Management/managementGroups/placeholder"}}`)},
+		"pd.alz_policy_definition.json": {Data: []byte(cacheSaveTestPolicyDefinition("pd", "Custom", "1.0.0"))},
+		"psd.alz_policy_set_definition.json": {Data: []byte(`{"name": "psd", "type": "Microsoft.Authorization/policySetDefinitions", "properties": {
+			"displayName": "psd", "description": "psd", "policyType": "Custom", "version": "1.0.0", "metadata": {}, "parameters": {},
+			"policyDefinitions": [{"policyDefinitionId": "/providers/Microsoft.Management/managementGroups/placeholder/providers/Microsoft.Authorization/policyDefinitions/pd",
+				"policyDefinitionReferenceId": "pd", "parameters": {}}]
+		}}`)},
+		"ROLE.ALZ_ROLE_DEFINITION.JSON": {Data: []byte(`{"name": "00000000-0000-0000-0000-000000000000", "type": "Microsoft.Authorization/roleDefinitions",
+			"properties": {"roleName": "role", "description": "role", "type": "CustomRole", "permissions": [{"actions": ["*/read"]}], "assignableScopes": ["/"]}}`)},
+		"alz_policy_default_values.yml": {Data: []byte("defaults:\n  - default_name: workspace\n    policy_assignments:\n" +
+			"      - policy_assignment_name: pa\n        parameter_names: []\n")},
+		".alzlib/cached/other.alz_policy_assignment.json": {Data: []byte(`{"name": "other"}`)},
+		"README.md": {Data: []byte(`not an asset`)},
+	}
+	alz := alzlib.NewAlzLib(nil)
+	require.NoError(t, alz.Init(t.Context(), alzlib.NewCustomLibraryReferenceFromFS("lib", lib)))
+
+	libAssets, err := libraryAssets(lib)
+	require.NoError(t, err)
+	got := make(map[string][]string)
+	for _, asset := range libAssets {
+		got[asset.AssetType] = append(got[asset.AssetType], asset.Name)
+		// The library has a single version of each definition, which is the latest.
+		switch asset.AssetType {
+		case "policy_definition":
+			assert.Equal(t, asset.Version, *alz.PolicyDefinition(asset.Name, nil).GetVersion())
+		case "policy_set_definition":
+			assert.Equal(t, asset.Version, *alz.PolicySetDefinition(asset.Name, nil).GetVersion())
+		}
+	}
+	// alzlib adds the empty archetype, which is not in the library.
+	archetypes := slices.DeleteFunc(alz.Archetypes(), func(name string) bool { return name == "empty" })
+	assert.Equal(t, map[string][]string{
+		"architecture":          alz.Architectures(),
+		"archetype":             archetypes,
+		"policy_assignment":     alz.PolicyAssignments(),
+		"policy_definition":     alz.PolicyDefinitions(),
+		"policy_set_definition": alz.PolicySetDefinitions(),
+		"role_definition":       alz.RoleDefinitions(),
+		"policy_default_value":  alz.PolicyDefaultValues(),
+	}, got)
+}
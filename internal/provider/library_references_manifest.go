@@ -0,0 +1,199 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/Azure/terraform-provider-alz/internal/gen"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// libraryReferencesManifest is the content of `library_manifest_file`.
+// JSON is a subset of YAML, so the file is decoded as YAML in either format.
+type libraryReferencesManifest struct {
+	LibraryReferences                   []libraryReferencesManifestEntry `yaml:"library_references"`
+	LibraryFetchDependencies            *bool                            `yaml:"library_fetch_dependencies"`
+	LibraryDependencyConflictResolution *string                          `yaml:"library_dependency_conflict_resolution"`
+}
+
+// libraryReferencesManifestEntry is a library reference in `library_manifest_file`.
+// Credentials are not supported, as secrets do not belong in a shared file.
+type libraryReferencesManifestEntry struct {
+	Path          string `yaml:"path"`
+	Ref           string `yaml:"ref"`
+	CustomURL     string `yaml:"custom_url"`
+	LocalPath     string `yaml:"local_path"`
+	PublicKey     string `yaml:"public_key"`
+	SignatureFile string `yaml:"signature_file"`
+}
+
+// applyLibraryReferencesManifest merges the library references and dependency settings from `library_manifest_file`
+// into data. An inline library reference to the same library replaces the reference from the file, other inline
+// references are added after those from the file. Inline dependency settings take precedence over the file.
+func applyLibraryReferencesManifest(ctx context.Context, data *AlzModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if data.LibraryManifestFile.IsNull() || data.LibraryManifestFile.IsUnknown() {
+		return diags
+	}
+	manifestFile := data.LibraryManifestFile.ValueString()
+
+	manifest, err := loadLibraryReferencesManifest(manifestFile)
+	if err != nil {
+		diags.AddError("Failed to load library manifest file", err.Error())
+		return diags
+	}
+
+	var inline []gen.LibraryReferencesValue
+	if !data.LibraryReferences.IsNull() && !data.LibraryReferences.IsUnknown() {
+		inline = make([]gen.LibraryReferencesValue, len(data.LibraryReferences.Elements()))
+		diags.Append(data.LibraryReferences.ElementsAs(ctx, &inline, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	merged := make([]attr.Value, 0, len(manifest.LibraryReferences)+len(inline))
+	used := make([]bool, len(inline))
+	for _, entry := range manifest.LibraryReferences {
+		// Local paths in the file are relative to the file, and are made relative to the configuration directory.
+		entry.LocalPath = libraryReferencesManifestPath(manifestFile, entry.LocalPath)
+		entry.SignatureFile = libraryReferencesManifestPath(manifestFile, entry.SignatureFile)
+		val, d := entry.toLibraryReferencesValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		if idx := slices.IndexFunc(inline, func(v gen.LibraryReferencesValue) bool {
+			return libraryReferencesValueIdentity(v) == libraryReferencesValueIdentity(val)
+		}); idx != -1 {
+			val = inline[idx]
+			used[idx] = true
+		}
+		merged = append(merged, val)
+	}
+	for i, val := range inline {
+		if !used[i] {
+			merged = append(merged, val)
+		}
+	}
+	if len(merged) == 0 {
+		diags.AddError("Failed to load library manifest file", fmt.Sprintf("no library references in `library_references` or %q", manifestFile))
+		return diags
+	}
+
+	data.LibraryReferences, diags = types.ListValue(gen.NewLibraryReferencesValueNull().Type(ctx), merged)
+	if diags.HasError() {
+		return diags
+	}
+	if data.LibraryFetchDependencies.IsNull() && manifest.LibraryFetchDependencies != nil {
+		data.LibraryFetchDependencies = types.BoolValue(*manifest.LibraryFetchDependencies)
+	}
+	if data.LibraryDependencyConflictResolution.IsNull() && manifest.LibraryDependencyConflictResolution != nil {
+		data.LibraryDependencyConflictResolution = types.StringValue(*manifest.LibraryDependencyConflictResolution)
+	}
+	return diags
+}
+
+// loadLibraryReferencesManifest loads and validates the library manifest file at path.
+func loadLibraryReferencesManifest(path string) (*libraryReferencesManifest, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is provided by the operator via provider config.
+	if err != nil {
+		return nil, fmt.Errorf("reading library manifest file %q: %w", path, err)
+	}
+	var res libraryReferencesManifest
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&res); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("decoding library manifest file %q: %w", path, err)
+	}
+	if err := res.validate(); err != nil {
+		return nil, fmt.Errorf("validating library manifest file %q: %w", path, err)
+	}
+	return &res, nil
+}
+
+// libraryReferencesManifestPath returns the path in the manifest file relative to the configuration directory.
+func libraryReferencesManifestPath(manifestFile, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(filepath.Dir(manifestFile), path)
+}
+
+// validate applies the same rules as the provider schema validators of the equivalent attributes.
+func (m *libraryReferencesManifest) validate() error {
+	var errs []error
+	if v := m.LibraryDependencyConflictResolution; v != nil && !slices.Contains([]string{
+		libraryDependencyConflictResolutionAll,
+		libraryDependencyConflictResolutionError,
+		libraryDependencyConflictResolutionHighest,
+		libraryDependencyConflictResolutionExplicit,
+	}, *v) {
+		errs = append(errs, fmt.Errorf("library_dependency_conflict_resolution %q must be one of `all`, `error`, `highest` or `explicit`", *v))
+	}
+	for i, entry := range m.LibraryReferences {
+		var set int
+		for _, v := range []string{entry.Path, entry.CustomURL, entry.LocalPath} {
+			if v != "" {
+				set++
+			}
+		}
+		switch {
+		case set != 1:
+			errs = append(errs, fmt.Errorf("library_references[%d] must have exactly one of `path`, `custom_url` or `local_path`", i))
+		case (entry.Path == "") != (entry.Ref == ""):
+			errs = append(errs, fmt.Errorf("library_references[%d] must have both `path` and `ref`", i))
+		}
+		if entry.SignatureFile != "" && entry.PublicKey == "" {
+			errs = append(errs, fmt.Errorf("library_references[%d] `signature_file` requires `public_key`", i))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// toLibraryReferencesValue returns the entry as a `library_references` element.
+func (e libraryReferencesManifestEntry) toLibraryReferencesValue(ctx context.Context) (gen.LibraryReferencesValue, diag.Diagnostics) {
+	stringOrNull := func(s string) types.String {
+		if s == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(s)
+	}
+	return gen.NewLibraryReferencesValue(
+		gen.NewLibraryReferencesValueNull().AttributeTypes(ctx),
+		map[string]attr.Value{
+			"path":           stringOrNull(e.Path),
+			"ref":            stringOrNull(e.Ref),
+			"custom_url":     stringOrNull(e.CustomURL),
+			"local_path":     stringOrNull(e.LocalPath),
+			"public_key":     stringOrNull(e.PublicKey),
+			"signature_file": stringOrNull(e.SignatureFile),
+			"credentials":    types.ObjectNull(gen.NewCredentialsValueNull().AttributeTypes(ctx)),
+		},
+	)
+}
+
+// libraryReferencesValueIdentity returns the identity of the library that a `library_references` element refers to,
+// which is the same for different refs of the same library.
+func libraryReferencesValueIdentity(v gen.LibraryReferencesValue) string {
+	switch {
+	case !v.LocalPath.IsNull():
+		return "local_path:" + filepath.Clean(v.LocalPath.ValueString())
+	case !v.CustomUrl.IsNull():
+		return "custom_url:" + v.CustomUrl.ValueString()
+	default:
+		return "path:" + v.Path.ValueString()
+	}
+}
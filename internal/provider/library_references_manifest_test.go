@@ -0,0 +1,157 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Azure/terraform-provider-alz/internal/gen"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLibraryReferencesList returns a list of library references from the manifest entries.
+func newLibraryReferencesList(ctx context.Context, t *testing.T, entries ...libraryReferencesManifestEntry) types.List {
+	t.Helper()
+	vals := make([]attr.Value, len(entries))
+	for i, entry := range entries {
+		v, diags := entry.toLibraryReferencesValue(ctx)
+		require.False(t, diags.HasError(), diags)
+		vals[i] = v
+	}
+	return types.ListValueMust(gen.NewLibraryReferencesValueNull().Type(ctx), vals)
+}
+
+func TestApplyLibraryReferencesManifest(t *testing.T) {
+	ctx := t.Context()
+	dir := t.TempDir()
+	t.Chdir(dir)
+	require.NoError(t, os.MkdirAll("manifests", 0o700))
+
+	manifestYAML := `
+library_references:
+  - path: platform/alz
+    ref: 2025.01.0
+  - custom_url: git::https://github.com/contoso/lib.git?ref=v1.0.0
+  - local_path: ../lib
+    public_key: key
+    signature_file: lib.sig
+library_fetch_dependencies: false
+library_dependency_conflict_resolution: highest
+`
+	require.NoError(t, os.WriteFile(filepath.Join("manifests", "approved.yaml"), []byte(manifestYAML), 0o600))
+
+	var data AlzModel
+	data.LibraryManifestFile = types.StringValue(filepath.Join("manifests", "approved.yaml"))
+	data.LibraryReferences = newLibraryReferencesList(ctx, t,
+		libraryReferencesManifestEntry{CustomURL: "git::https://github.com/contoso/extra.git"},
+		libraryReferencesManifestEntry{Path: "platform/alz", Ref: "2025.02.0"},
+	)
+	data.LibraryFetchDependencies = types.BoolNull()
+	data.LibraryDependencyConflictResolution = types.StringValue(libraryDependencyConflictResolutionExplicit)
+
+	diags := applyLibraryReferencesManifest(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+
+	refs := make([]gen.LibraryReferencesValue, 0)
+	require.False(t, data.LibraryReferences.ElementsAs(ctx, &refs, false).HasError())
+	require.Len(t, refs, 4)
+	// The inline reference to platform/alz replaces the reference from the file, in the same position.
+	assert.Equal(t, "platform/alz", refs[0].Path.ValueString())
+	assert.Equal(t, "2025.02.0", refs[0].Ref.ValueString())
+	assert.Equal(t, "git::https://github.com/contoso/lib.git?ref=v1.0.0", refs[1].CustomUrl.ValueString())
+	// Local paths in the file are relative to the file.
+	assert.Equal(t, "lib", refs[2].LocalPath.ValueString())
+	assert.Equal(t, filepath.Join("manifests", "lib.sig"), refs[2].SignatureFile.ValueString())
+	assert.Equal(t, "git::https://github.com/contoso/extra.git", refs[3].CustomUrl.ValueString())
+
+	// Inline settings take precedence over the file.
+	assert.False(t, data.LibraryFetchDependencies.ValueBool())
+	assert.Equal(t, libraryDependencyConflictResolutionExplicit, data.LibraryDependencyConflictResolution.ValueString())
+}
+
+func TestApplyLibraryReferencesManifestJSON(t *testing.T) {
+	ctx := t.Context()
+	manifestFile := filepath.Join(t.TempDir(), "approved.json")
+	require.NoError(t, os.WriteFile(manifestFile, []byte(`{"library_references": [{"path": "platform/alz", "ref": "2025.01.0"}]}`), 0o600))
+
+	var data AlzModel
+	data.LibraryManifestFile = types.StringValue(manifestFile)
+	data.LibraryReferences = types.ListNull(gen.NewLibraryReferencesValueNull().Type(ctx))
+	diags := applyLibraryReferencesManifest(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+	assert.Len(t, data.LibraryReferences.Elements(), 1)
+	assert.True(t, data.LibraryFetchDependencies.IsNull())
+}
+
+func TestApplyLibraryReferencesManifestNotSet(t *testing.T) {
+	ctx := t.Context()
+	var data AlzModel
+	data.LibraryManifestFile = types.StringNull()
+	data.LibraryReferences = newLibraryReferencesList(ctx, t, libraryReferencesManifestEntry{Path: "platform/alz", Ref: "2025.01.0"})
+	expected := data.LibraryReferences
+
+	diags := applyLibraryReferencesManifest(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, expected, data.LibraryReferences)
+}
+
+func TestLoadLibraryReferencesManifest(t *testing.T) {
+	dir := t.TempDir()
+	testCases := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "Unknown field",
+			content:  `{"library_references": [{"path": "platform/alz", "ref": "2025.01.0", "credentials": {}}]}`,
+			expected: []string{"field credentials not found"},
+		},
+		{
+			name: "Invalid entries",
+			content: `
+library_references:
+  - path: platform/alz
+  - path: platform/alz
+    ref: 2025.01.0
+    custom_url: https://example.com/lib.zip
+  - local_path: lib
+    signature_file: lib.sig
+library_dependency_conflict_resolution: lowest
+`,
+			expected: []string{
+				`library_dependency_conflict_resolution "lowest" must be one of`,
+				"library_references[0] must have both `path` and `ref`",
+				"library_references[1] must have exactly one of `path`, `custom_url` or `local_path`",
+				"library_references[2] `signature_file` requires `public_key`",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(dir, "manifest.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+			_, err := loadLibraryReferencesManifest(path)
+			require.Error(t, err)
+			for _, expected := range tc.expected {
+				assert.ErrorContains(t, err, expected)
+			}
+		})
+	}
+
+	_, err := loadLibraryReferencesManifest(filepath.Join(dir, "missing.yaml"))
+	assert.ErrorContains(t, err, "reading library manifest file")
+
+	empty := filepath.Join(dir, "empty.yaml")
+	require.NoError(t, os.WriteFile(empty, nil, 0o600))
+	manifest, err := loadLibraryReferencesManifest(empty)
+	require.NoError(t, err)
+	assert.Empty(t, manifest.LibraryReferences)
+}
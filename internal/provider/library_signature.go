@@ -0,0 +1,247 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/terraform-provider-alz/internal/gen"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	// libraryManifestFileName is the name of the signed manifest file in the root of a library.
+	libraryManifestFileName = "alz_library_manifest.json"
+	// librarySignatureFileName is the name of the default detached signature file in the root of a library.
+	librarySignatureFileName = libraryManifestFileName + ".sig"
+)
+
+// errInvalidLibrarySignature is returned when the manifest signature is not valid for the public key.
+var errInvalidLibrarySignature = errors.New("the manifest signature is not valid for the public key")
+
+// libraryVerification is the trusted public key and optional detached signature file of a library reference.
+type libraryVerification struct {
+	PublicKey     string
+	SignatureFile string
+}
+
+// libraryManifest lists the SHA-256 hash of every file in a library, keyed by the slash separated path
+// relative to the library root. The manifest and signature files are not included.
+type libraryManifest struct {
+	Files map[string]string `json:"files"`
+}
+
+// generateLibraryVerifications returns the verification settings of the library references that have a public key,
+// keyed by library reference.
+func generateLibraryVerifications(ctx context.Context, data *AlzModel) (map[string]libraryVerification, diag.Diagnostics) {
+	alzLibRefs := make([]gen.LibraryReferencesValue, len(data.LibraryReferences.Elements()))
+	diags := data.LibraryReferences.ElementsAs(ctx, &alzLibRefs, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	res := make(map[string]libraryVerification)
+	for _, libRef := range alzLibRefs {
+		if libRef.PublicKey.IsNull() || libRef.PublicKey.IsUnknown() {
+			continue
+		}
+		var refStr string
+		switch {
+		case !libRef.LocalPath.IsNull():
+			refStr = libRef.LocalPath.ValueString()
+		case !libRef.CustomUrl.IsNull():
+			refStr = libRef.CustomUrl.ValueString()
+		default:
+			refStr = alzlib.NewAlzLibraryReference(libRef.Path.ValueString(), libRef.Ref.ValueString()).String()
+		}
+		res[refStr] = libraryVerification{
+			PublicKey:     libRef.PublicKey.ValueString(),
+			SignatureFile: libRef.SignatureFile.ValueString(),
+		}
+	}
+	return res, diags
+}
+
+// newVerifyingLibraryFetchFunc returns a fetch function that verifies the signed manifest of each library reference
+// with verification settings as soon as it is fetched, so that the metadata of a library is only used to fetch its
+// dependencies once the library has been verified.
+func newVerifyingLibraryFetchFunc(fetch libraryFetchFunc, verifications map[string]libraryVerification) libraryFetchFunc {
+	return func(ctx context.Context, ref alzlib.LibraryReference) (fs.FS, error) {
+		lib, err := fetch(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		v, ok := verifications[ref.String()]
+		if !ok {
+			return lib, nil
+		}
+		if err := verifyLibrary(lib, v); err != nil {
+			return nil, fmt.Errorf("verifying library %s: %w", ref.String(), err)
+		}
+		return lib, nil
+	}
+}
+
+// verifyLibraries verifies the signed manifest of each library reference with verification settings.
+// The library references are fetched if they have not been already.
+// A library reference with verification settings that is not in libRefs, because it was replaced by another ref of
+// the same library during dependency resolution, is an error.
+func verifyLibraries(ctx context.Context, libRefs alzlib.LibraryReferences, verifications map[string]libraryVerification) error {
+	refStrs := make([]string, 0, len(verifications))
+	for refStr := range verifications {
+		refStrs = append(refStrs, refStr)
+	}
+	slices.Sort(refStrs)
+
+	for _, refStr := range refStrs {
+		idx := slices.IndexFunc(libRefs, func(ref alzlib.LibraryReference) bool { return ref.String() == refStr })
+		if idx == -1 {
+			return fmt.Errorf("library %s has a public key but is not loaded, as it was replaced by another ref of the same library", refStr)
+		}
+		lib, err := fetchLibrary(ctx, libRefs[idx])
+		if err != nil {
+			return fmt.Errorf("fetching library %s: %w", refStr, err)
+		}
+		if err := verifyLibrary(lib, verifications[refStr]); err != nil {
+			return fmt.Errorf("verifying library %s: %w", refStr, err)
+		}
+	}
+	return nil
+}
+
+// verifyLibrary verifies the signature of the library manifest, then that the library content matches the manifest.
+func verifyLibrary(lib fs.FS, v libraryVerification) error {
+	key, err := parseLibraryPublicKey(v.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	manifestData, err := fs.ReadFile(lib, libraryManifestFileName)
+	if err != nil {
+		return fmt.Errorf("reading manifest: %w", err)
+	}
+	var sigData []byte
+	if v.SignatureFile != "" {
+		sigData, err = os.ReadFile(v.SignatureFile) // #nosec G304 -- path is provided by the operator via provider config.
+	} else {
+		sigData, err = fs.ReadFile(lib, librarySignatureFileName)
+	}
+	if err != nil {
+		return fmt.Errorf("reading signature: %w", err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(sigData)))
+	if err != nil {
+		return fmt.Errorf("decoding signature: %w", err)
+	}
+	if err := verifyLibrarySignature(key, manifestData, sig); err != nil {
+		return err
+	}
+
+	var manifest libraryManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		return fmt.Errorf("unmarshaling manifest: %w", err)
+	}
+	return verifyLibraryManifest(lib, manifest)
+}
+
+// verifyLibraryManifest checks that the files in the library are exactly the files in the manifest, with the same
+// content. The directories that are not part of the library content, e.g. `.git`, are skipped.
+func verifyLibraryManifest(lib fs.FS, manifest libraryManifest) error {
+	var errs []error
+	seen := make(map[string]bool, len(manifest.Files))
+	err := fs.WalkDir(lib, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != "." && isLibraryIgnoredDir(d.Name()) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if path == libraryManifestFileName || path == librarySignatureFileName {
+			return nil
+		}
+		expected, ok := manifest.Files[path]
+		if !ok {
+			errs = append(errs, fmt.Errorf("file %s is not in the manifest", path))
+			return nil
+		}
+		seen[path] = true
+		data, err := fs.ReadFile(lib, path)
+		if err != nil {
+			return err
+		}
+		if sum := sha256.Sum256(data); !strings.EqualFold(hex.EncodeToString(sum[:]), expected) {
+			errs = append(errs, fmt.Errorf("file %s does not match the manifest", path))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	missing := make([]string, 0, len(manifest.Files))
+	for path := range manifest.Files {
+		if !seen[path] {
+			missing = append(missing, path)
+		}
+	}
+	slices.Sort(missing)
+	for _, path := range missing {
+		errs = append(errs, fmt.Errorf("file %s is in the manifest but not in the library", path))
+	}
+	return errors.Join(errs...)
+}
+
+// parseLibraryPublicKey parses a PEM encoded PKIX public key.
+func parseLibraryPublicKey(pemData string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(pemData))
+	if block == nil {
+		return nil, errors.New("public key is not PEM encoded")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing public key: %w", err)
+	}
+	return key, nil
+}
+
+// verifyLibrarySignature verifies the signature of data.
+// Ed25519 signatures are over the data, ECDSA (ASN.1 encoded) and RSA (PKCS #1 v1.5) signatures are over the
+// SHA-256 digest of the data.
+func verifyLibrarySignature(key crypto.PublicKey, data, sig []byte) error {
+	digest := sha256.Sum256(data)
+	var ok bool
+	switch k := key.(type) {
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(k, data, sig)
+	case *ecdsa.PublicKey:
+		ok = ecdsa.VerifyASN1(k, digest[:], sig)
+	case *rsa.PublicKey:
+		ok = rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig) == nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+	if !ok {
+		return errInvalidLibrarySignature
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package provider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/Azure/alzlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSignedTestLibrary returns a library with a manifest of its files signed by the signer,
+// and the PEM encoded public key of the signer.
+func newSignedTestLibrary(t *testing.T, signer crypto.Signer) (fstest.MapFS, string) {
+	t.Helper()
+	lib := fstest.MapFS{
+		"a.alz_policy_assignment.json":     {Data: []byte(`{"name": "a"}`)},
+		"sub/b.alz_policy_definition.json": {Data: []byte(`{"name": "b"}`)},
+	}
+	manifest := libraryManifest{Files: make(map[string]string)}
+	for path, f := range lib {
+		sum := sha256.Sum256(f.Data)
+		manifest.Files[path] = hex.EncodeToString(sum[:])
+	}
+	manifestData, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	lib[libraryManifestFileName] = &fstest.MapFile{Data: manifestData}
+	lib[librarySignatureFileName] = &fstest.MapFile{Data: []byte(signTestData(t, signer, manifestData))}
+
+	der, err := x509.MarshalPKIXPublicKey(signer.Public())
+	require.NoError(t, err)
+	return lib, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+// signTestData returns the base64 encoded signature of data.
+func signTestData(t *testing.T, signer crypto.Signer, data []byte) string {
+	t.Helper()
+	digest := sha256.Sum256(data)
+	var sig []byte
+	var err error
+	switch signer.(type) {
+	case ed25519.PrivateKey:
+		sig, err = signer.Sign(rand.Reader, data, crypto.Hash(0))
+	case *ecdsa.PrivateKey, *rsa.PrivateKey:
+		sig, err = signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func TestVerifyLibrary(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	for name, signer := range map[string]crypto.Signer{"Ed25519": edKey, "ECDSA": ecKey, "RSA": rsaKey} {
+		t.Run(name, func(t *testing.T) {
+			lib, publicKey := newSignedTestLibrary(t, signer)
+			assert.NoError(t, verifyLibrary(lib, libraryVerification{PublicKey: publicKey}))
+		})
+	}
+
+	t.Run("Wrong key", func(t *testing.T) {
+		lib, _ := newSignedTestLibrary(t, edKey)
+		_, otherPublicKey := newSignedTestLibrary(t, ecKey)
+		assert.ErrorIs(t, verifyLibrary(lib, libraryVerification{PublicKey: otherPublicKey}), errInvalidLibrarySignature)
+	})
+
+	t.Run("Tampered manifest", func(t *testing.T) {
+		lib, publicKey := newSignedTestLibrary(t, edKey)
+		lib[libraryManifestFileName].Data = append(lib[libraryManifestFileName].Data, ' ')
+		assert.ErrorIs(t, verifyLibrary(lib, libraryVerification{PublicKey: publicKey}), errInvalidLibrarySignature)
+	})
+
+	t.Run("Content does not match manifest", func(t *testing.T) {
+		lib, publicKey := newSignedTestLibrary(t, edKey)
+		lib["a.alz_policy_assignment.json"] = &fstest.MapFile{Data: []byte(`{"name": "changed"}`)}
+		lib["c.alz_policy_assignment.json"] = &fstest.MapFile{Data: []byte(`{"name": "c"}`)}
+		delete(lib, "sub/b.alz_policy_definition.json")
+		lib[".alzlib/cached/d.alz_policy_assignment.json"] = &fstest.MapFile{Data: []byte(`{"name": "d"}`)}
+		err := verifyLibrary(lib, libraryVerification{PublicKey: publicKey})
+		require.Error(t, err)
+		assert.Equal(t, "file a.alz_policy_assignment.json does not match the manifest\n"+
+			"file c.alz_policy_assignment.json is not in the manifest\n"+
+			"file sub/b.alz_policy_definition.json is in the manifest but not in the library", err.Error())
+	})
+
+	t.Run("Version control metadata", func(t *testing.T) {
+		lib, publicKey := newSignedTestLibrary(t, edKey)
+		lib[".git/config"] = &fstest.MapFile{Data: []byte("[core]")}
+		lib[".git/logs/HEAD"] = &fstest.MapFile{Data: []byte("clone")}
+		assert.NoError(t, verifyLibrary(lib, libraryVerification{PublicKey: publicKey}))
+	})
+
+	t.Run("Detached signature file", func(t *testing.T) {
+		lib, publicKey := newSignedTestLibrary(t, edKey)
+		sigFile := filepath.Join(t.TempDir(), "library.sig")
+		require.NoError(t, os.WriteFile(sigFile, lib[librarySignatureFileName].Data, 0o600))
+		delete(lib, librarySignatureFileName)
+		assert.NoError(t, verifyLibrary(lib, libraryVerification{PublicKey: publicKey, SignatureFile: sigFile}))
+		assert.ErrorContains(t, verifyLibrary(lib, libraryVerification{PublicKey: publicKey}), "reading signature")
+	})
+
+	t.Run("Missing manifest", func(t *testing.T) {
+		lib, publicKey := newSignedTestLibrary(t, edKey)
+		delete(lib, libraryManifestFileName)
+		assert.ErrorContains(t, verifyLibrary(lib, libraryVerification{PublicKey: publicKey}), "reading manifest")
+	})
+
+	t.Run("Invalid public key", func(t *testing.T) {
+		lib, _ := newSignedTestLibrary(t, edKey)
+		assert.ErrorContains(t, verifyLibrary(lib, libraryVerification{PublicKey: "not a key"}), "public key is not PEM encoded")
+	})
+}
+
+func TestVerifyLibraries(t *testing.T) {
+	ctx := t.Context()
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	lib, publicKey := newSignedTestLibrary(t, edKey)
+	libRefs := alzlib.LibraryReferences{
+		alzlib.NewCustomLibraryReferenceFromFS("unsigned", fstest.MapFS{}),
+		alzlib.NewCustomLibraryReferenceFromFS("signed", lib),
+	}
+
+	assert.NoError(t, verifyLibraries(ctx, libRefs, map[string]libraryVerification{"signed": {PublicKey: publicKey}}))
+	assert.ErrorContains(t,
+		verifyLibraries(ctx, libRefs, map[string]libraryVerification{"unsigned": {PublicKey: publicKey}}),
+		"verifying library unsigned: reading manifest",
+	)
+	assert.ErrorContains(t,
+		verifyLibraries(ctx, libRefs, map[string]libraryVerification{"platform/alz@2025.01.0": {PublicKey: publicKey}}),
+		"library platform/alz@2025.01.0 has a public key but is not loaded",
+	)
+}
+
+func TestNewVerifyingLibraryFetchFunc(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	lib, publicKey := newSignedTestLibrary(t, edKey)
+	// The metadata of the library has been tampered with, to add a dependency that is not in the manifest.
+	lib[libraryMetadataFileName] = &fstest.MapFile{Data: []byte(`{"dependencies": [{"path": "platform/evil", "ref": "1.0.0"}]}`)}
+
+	var fetched []string
+	fetch := func(_ context.Context, ref alzlib.LibraryReference) (fs.FS, error) {
+		fetched = append(fetched, ref.String())
+		return ref.FS(), nil
+	}
+	verifications := map[string]libraryVerification{"signed": {PublicKey: publicKey}}
+	_, _, err = resolveLibraryDependencies(t.Context(), alzlib.LibraryReferences{alzlib.NewCustomLibraryReferenceFromFS("signed", lib)},
+		libraryDependencyConflictResolutionError, newVerifyingLibraryFetchFunc(fetch, verifications))
+	assert.ErrorContains(t, err, "verifying library signed: file "+libraryMetadataFileName+" is not in the manifest")
+	// The dependency in the unverified metadata is not fetched.
+	assert.Equal(t, []string{"signed"}, fetched)
+
+	delete(lib, libraryMetadataFileName)
+	res, _, err := resolveLibraryDependencies(t.Context(), alzlib.LibraryReferences{alzlib.NewCustomLibraryReferenceFromFS("signed", lib)},
+		libraryDependencyConflictResolutionError, newVerifyingLibraryFetchFunc(fetch, verifications))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"signed"}, libraryReferenceStrings(res))
+}
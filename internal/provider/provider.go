@@ -4,8 +4,11 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"math"
 	"math/rand"
 	"os"
@@ -61,6 +64,8 @@ type AlzProvider struct {
 	// testing.
 	version string
 	data    *clients.Client
+	// localLibrariesFingerprint is the fingerprint of the local path libraries that data was created with.
+	localLibrariesFingerprint string
 }
 
 // AlzModel is the data model for the ALZ provider.
@@ -85,15 +90,6 @@ func (p *AlzProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 func (p *AlzProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Debug(ctx, "Provider configuration started")
 
-	if p.data != nil {
-		tflog.Debug(ctx, "Provider AlzLib already present, skipping configuration")
-		resp.DataSourceData = p.data
-		resp.ResourceData = p.data
-		return
-	}
-
-	tflog.Debug(ctx, "Provider AlzLib not present, beginning configuration")
-
 	var data AlzModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -102,6 +98,33 @@ func (p *AlzProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
+	// Merge the library references from the manifest file, before they are used.
+	resp.Diagnostics.Append(applyLibraryReferencesManifest(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Local path libraries can change while the provider is running, e.g. between acceptance test steps,
+	// so the AlzLib is only reused if their content has not changed.
+	fingerprint, diags := localLibrariesFingerprint(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if p.data != nil && p.localLibrariesFingerprint == fingerprint {
+		tflog.Debug(ctx, "Provider AlzLib already present, skipping configuration")
+		resp.DataSourceData = p.data
+		resp.ResourceData = p.data
+		return
+	}
+
+	if p.data != nil {
+		tflog.Debug(ctx, "Provider AlzLib present but local library content has changed, beginning configuration")
+	} else {
+		tflog.Debug(ctx, "Provider AlzLib not present, beginning configuration")
+	}
+
 	// Read the environment variables and set in data
 	// if the data is not already set and the environment variable is set.
 	data.ConfigureFromEnv()
@@ -122,10 +145,15 @@ func (p *AlzProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		resp.Diagnostics.AddError("Failed to configure cloud environment", err.Error())
 		return
 	}
-	cred, err := aztfauth.NewCredential(authOptions)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to create Azure token credential", err.Error())
-		return
+
+	// In offline mode no Azure API calls are made, so there is no need for a token credential.
+	var cred azcore.TokenCredential
+	if !data.OfflineEnabled.ValueBool() {
+		cred, err = aztfauth.NewCredential(authOptions)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create Azure token credential", err.Error())
+			return
+		}
 	}
 
 	// Create the AlzLib.
@@ -155,14 +183,36 @@ func (p *AlzProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		"instance": r,
 	})
 
-	// Fetch the library dependencies if enabled.
+	// Fetch the custom libraries that have credentials, as alzlib can only fetch them if the secrets are in the URL.
+	libraryCredentials, diags := generateLibraryCredentials(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	libRefs, err = fetchLibrariesWithCredentials(ctx, libRefs, libraryCredentials)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to fetch library", err.Error())
+		return
+	}
+
+	// The libraries that have a trusted public key are verified as soon as they are fetched,
+	// so that the dependencies named in their metadata are only fetched from verified libraries.
+	verifications, diags := generateLibraryVerifications(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch the library dependencies if enabled, resolving conflicting refs of the same library.
 	// If not, the refs passed to alzlib.Init() will be fetched on demand without dependencies.
+	var dependencyGraph []clients.LibraryDependency
 	if data.LibraryFetchDependencies.ValueBool() {
 		var err error
 		tflog.Debug(ctx, "Begin fetch library dependencies", map[string]interface{}{
 			"library_references": libRefs,
 		})
-		libRefs, err = libRefs.FetchWithDependencies(ctx)
+		libRefs, dependencyGraph, err = resolveLibraryDependencies(ctx, libRefs, data.LibraryDependencyConflictResolution.ValueString(),
+			newVerifyingLibraryFetchFunc(newLibraryFetchFunc(libraryCredentials), verifications))
 		if err != nil {
 			resp.Diagnostics.AddError("Failed to fetch library dependencies", err.Error())
 			return
@@ -172,16 +222,77 @@ func (p *AlzProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		})
 	}
 
+	// Verify the signed manifests of the libraries that have a trusted public key, before they are used.
+	// Libraries fetched with their dependencies have already been verified, this verifies the others and checks
+	// that none were replaced by another ref of the same library.
+	if err := verifyLibraries(ctx, libRefs, verifications); err != nil {
+		resp.Diagnostics.AddError("Failed to verify library signature", err.Error())
+		return
+	}
+
+	// Check the fetched libraries against the lock file, or (re)generate it.
+	// This must happen before the archetype overrides are added, so that only the library content is hashed.
+	if lockFileName := data.LibraryLockFileName.ValueString(); lockFileName != "" {
+		if err := checkLibraryLockFile(ctx, lockFileName, libRefs, dependencyGraph, data.LibraryLockFileUpdateEnabled.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Failed to check library lock file", err.Error())
+			return
+		}
+	}
+
+	// Add the archetype overrides to the last library, so that its architectures can reference them.
+	overridesFS, diags := archetypeOverridesToFS(ctx, data.ArchetypeOverrides)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	libRefs, err = addArchetypeOverridesToLibraryReferences(ctx, libRefs, overridesFS)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add archetype overrides", err.Error())
+		return
+	}
+
 	// If a cache file was supplied and exists, load it and inject into AlzLib so
 	// that built-in policy and policy set definitions can be served from the cache
 	// without making Azure API calls during Init.
+	// The cache file must have been created for the same cloud, and is not used once it has expired so that it can be
+	// refreshed. In offline mode the cache is the only source of built-in definitions, so it must exist and not expire.
 	cacheFileName := data.CacheFileName.ValueString()
+	cacheFileMeta := newCacheFileMetadata(authOptions.Cloud, p.version)
+	var builtInCache alzlib.BuiltInCache
+	var loadedCache *cache.Cache
 	if cacheFileName != "" {
-		if err := loadCacheFile(ctx, alz, cacheFileName); err != nil {
+		var maxAge time.Duration
+		if v := data.CacheFileMaxAge.ValueString(); v != "" {
+			if maxAge, err = time.ParseDuration(v); err != nil {
+				resp.Diagnostics.AddError("Invalid cache file maximum age", err.Error())
+				return
+			}
+		}
+		c, warnings, err := loadCacheFile(ctx, alz, cacheFileName, cacheFileMeta, maxAge)
+		for _, warning := range warnings {
+			resp.Diagnostics.AddWarning("Cache file version mismatch", warning)
+		}
+		switch {
+		case errors.Is(err, errCacheFileExpired) && !data.OfflineEnabled.ValueBool():
+			resp.Diagnostics.AddWarning(
+				"Cache file expired",
+				fmt.Sprintf("%s, built-in definitions will be fetched from Azure. Set `cache_file_save_enabled = true` to refresh the cache file.", err),
+			)
+		case err != nil:
 			resp.Diagnostics.AddError("Failed to load cache file", err.Error())
 			return
+		case c != nil:
+			builtInCache = c
+			loadedCache = c
 		}
 	}
+	if data.OfflineEnabled.ValueBool() && builtInCache == nil {
+		resp.Diagnostics.AddError(
+			"Failed to load cache file",
+			fmt.Sprintf("cache file %q does not exist, it is required when `offline_enabled` is `true`", cacheFileName),
+		)
+		return
+	}
 
 	// Init alzlib
 	if err := alz.Init(ctx, libRefs...); err != nil {
@@ -189,14 +300,36 @@ func (p *AlzProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
+	// Report the library assets that were replaced by later libraries.
+	var overwrites []clients.LibraryOverwrite
+	if data.LibraryOverwriteEnabled.ValueBool() {
+		overwrites, err = libraryOverwrites(libRefs)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to determine library overwrites", err.Error())
+			return
+		}
+		for _, ovr := range overwrites {
+			tflog.Debug(ctx, "Library asset overwritten", map[string]interface{}{
+				"asset_type":                    ovr.AssetType,
+				"name":                          ovr.Name,
+				"version":                       ovr.Version,
+				"original_library_reference":    ovr.OriginalLibraryReference,
+				"overwriting_library_reference": ovr.OverwritingLibraryReference,
+			})
+		}
+	}
+
 	// If requested, persist the built-in cache to disk so that subsequent runs
-	// can use it. This must happen after Init so that the AlzLib has been
-	// populated with the built-in definitions referenced by the library.
+	// can use it. Built-in definitions are only loaded when a hierarchy is built,
+	// so the cache file is saved after each `alz_architecture` read.
+	var saveBuiltInCache clients.SaveBuiltInCacheFunc
 	if cacheFileName != "" && data.CacheFileSaveEnabled.ValueBool() {
-		if err := saveCacheFile(ctx, alz, cacheFileName); err != nil {
+		saver, err := newCacheFileSaver(alz, cacheFileName, cacheFileMeta, data.CacheFilePruneEnabled.ValueBool(), loadedCache)
+		if err != nil {
 			resp.Diagnostics.AddError("Failed to save cache file", err.Error())
 			return
 		}
+		saveBuiltInCache = saver.save
 	}
 
 	// Store the alz pointer in the provider struct so we don't have to do all this work every time `.Configure` is called.
@@ -204,28 +337,15 @@ func (p *AlzProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	clientOpts := []clients.Option{
 		clients.WithAlzLib(alz),
 		clients.WithSuppressWarningPolicyRoleAssignments(data.SuppressWarningPolicyRoleAssignments.ValueBool()),
+		clients.WithLibraryOverwrites(overwrites),
+		clients.WithLibraryDependencyGraph(dependencyGraph),
+		clients.WithOffline(data.OfflineEnabled.ValueBool(), builtInCache),
+		clients.WithSaveBuiltInCache(saveBuiltInCache),
+		nonComplianceMessageSubstitutionSettings(data),
 	}
 
-	// Parse non-compliance message substitution settings, applying provider-level
-	// defaults when the block (or any individual attribute) is not configured.
-	placeholder := defaultEnforcementModePlaceholder
-	enforcedRepl := defaultEnforcedReplacement
-	notEnforcedRepl := defaultNotEnforcedReplacement
-	ncmSubSettings := data.NonComplianceMessageSubstitutionSettings
-	if !ncmSubSettings.IsNull() && !ncmSubSettings.IsUnknown() {
-		if v := ncmSubSettings.EnforcementModePlaceholder; !v.IsNull() && !v.IsUnknown() && v.ValueString() != "" {
-			placeholder = v.ValueString()
-		}
-		if v := ncmSubSettings.EnforcedReplacement; !v.IsNull() && !v.IsUnknown() && v.ValueString() != "" {
-			enforcedRepl = v.ValueString()
-		}
-		if v := ncmSubSettings.NotEnforcedReplacement; !v.IsNull() && !v.IsUnknown() && v.ValueString() != "" {
-			notEnforcedRepl = v.ValueString()
-		}
-	}
-	clientOpts = append(clientOpts, clients.WithNonComplianceMessageSubstitutionSettings(placeholder, enforcedRepl, notEnforcedRepl))
-
 	p.data = clients.NewClient(clientOpts...)
+	p.localLibrariesFingerprint = fingerprint
 	resp.DataSourceData = p.data
 	resp.ResourceData = p.data
 	tflog.Debug(ctx, "Provider configuration finished")
@@ -238,6 +358,7 @@ func (p *AlzProvider) Resources(ctx context.Context) []func() resource.Resource
 func (p *AlzProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		services.NewArchitectureDataSource,
+		services.NewArchitectureLintDataSource,
 		services.NewMetadataDataSource,
 	}
 }
@@ -246,6 +367,27 @@ func (p *AlzProvider) Functions(ctx context.Context) []func() function.Function
 	return []func() function.Function{}
 }
 
+// nonComplianceMessageSubstitutionSettings returns the client option for the non-compliance message substitution
+// settings, applying provider-level defaults when the block (or any individual attribute) is not configured.
+func nonComplianceMessageSubstitutionSettings(data AlzModel) clients.Option {
+	placeholder := defaultEnforcementModePlaceholder
+	enforcedRepl := defaultEnforcedReplacement
+	notEnforcedRepl := defaultNotEnforcedReplacement
+	ncmSubSettings := data.NonComplianceMessageSubstitutionSettings
+	if !ncmSubSettings.IsNull() && !ncmSubSettings.IsUnknown() {
+		if v := ncmSubSettings.EnforcementModePlaceholder; !v.IsNull() && !v.IsUnknown() && v.ValueString() != "" {
+			placeholder = v.ValueString()
+		}
+		if v := ncmSubSettings.EnforcedReplacement; !v.IsNull() && !v.IsUnknown() && v.ValueString() != "" {
+			enforcedRepl = v.ValueString()
+		}
+		if v := ncmSubSettings.NotEnforcedReplacement; !v.IsNull() && !v.IsUnknown() && v.ValueString() != "" {
+			notEnforcedRepl = v.ValueString()
+		}
+	}
+	return clients.WithNonComplianceMessageSubstitutionSettings(placeholder, enforcedRepl, notEnforcedRepl)
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &AlzProvider{
@@ -268,11 +410,19 @@ func generateLibraryDefinitions(ctx context.Context, data *AlzModel) (alzlib.Lib
 
 	libRefs := make(alzlib.LibraryReferences, len(alzLibRefs))
 	for i, libRef := range alzLibRefs {
-		if libRef.CustomUrl.IsNull() {
+		switch {
+		case !libRef.LocalPath.IsNull():
+			ref, err := localLibraryReference(libRef.LocalPath.ValueString())
+			if err != nil {
+				diags.AddError("Invalid library local path", err.Error())
+				return nil, diags
+			}
+			libRefs[i] = ref
+		case !libRef.CustomUrl.IsNull():
+			libRefs[i] = alzlib.NewCustomLibraryReference(libRef.CustomUrl.ValueString())
+		default:
 			libRefs[i] = alzlib.NewAlzLibraryReference(libRef.Path.ValueString(), libRef.Ref.ValueString())
-			continue
 		}
-		libRefs[i] = alzlib.NewCustomLibraryReference(libRef.CustomUrl.ValueString())
 	}
 	return libRefs, nil
 }
@@ -366,6 +516,12 @@ func configureAlzLib(token azcore.TokenCredential, data AlzModel, cloudConfig cl
 		UniqueRoleDefinitions: !data.RoleDefinitionsUseSuppliedNamesEnabled.ValueBool(),
 	}
 	alz := alzlib.NewAlzLib(opts)
+
+	// Without a policy client, built-in definitions that are not in the cache cannot be fetched from Azure.
+	if data.OfflineEnabled.ValueBool() {
+		return alz, diags
+	}
+
 	cf, err := armpolicy.NewClientFactory("", token, popts)
 	if err != nil {
 		diags.AddError("failed to create Azure Policy client factory: %v", err.Error())
@@ -394,91 +550,145 @@ func configureDefaults(_ context.Context, data *AlzModel) {
 		data.LibraryFetchDependencies = types.BoolValue(true)
 	}
 
+	// Load every requested ref of libraries with conflicting dependency refs by default, as before conflict resolution.
+	if data.LibraryDependencyConflictResolution.IsNull() {
+		data.LibraryDependencyConflictResolution = types.StringValue(libraryDependencyConflictResolutionAll)
+	}
+
 	// Do not skip warning policy role assignments by default.
 	if data.SuppressWarningPolicyRoleAssignments.IsNull() {
 		data.SuppressWarningPolicyRoleAssignments = types.BoolValue(false)
 	}
 
+	// Check the libraries against the lock file by default.
+	if data.LibraryLockFileUpdateEnabled.IsNull() {
+		data.LibraryLockFileUpdateEnabled = types.BoolValue(false)
+	}
+
 	// Do not save the cache file by default.
 	if data.CacheFileSaveEnabled.IsNull() {
 		data.CacheFileSaveEnabled = types.BoolValue(false)
 	}
+
+	// Keep all the definitions in the cache file by default.
+	if data.CacheFilePruneEnabled.IsNull() {
+		data.CacheFilePruneEnabled = types.BoolValue(false)
+	}
+
+	// Allow Azure API calls by default.
+	if data.OfflineEnabled.IsNull() {
+		data.OfflineEnabled = types.BoolValue(false)
+	}
 }
 
-// loadCacheFile loads the gzipped cache file at the given path and injects it
-// into the AlzLib. If the file does not exist, this is treated as a no-op so
-// that the cache file can be created on first run when used with
-// `cache_file_save_enabled = true`.
-func loadCacheFile(ctx context.Context, alz *alzlib.AlzLib, path string) error {
-	f, err := os.Open(path) // #nosec G304 -- path is provided by the operator via provider config.
+// loadCacheFile loads the gzipped cache file at the given path, checks its
+// metadata against want, injects it into the AlzLib and returns it, together
+// with any warnings from the check. If the file does not exist, this is treated
+// as a no-op that returns a nil cache, so that the cache file can be created on
+// first run when used with `cache_file_save_enabled = true`. If the cache file
+// is older than maxAge, an error wrapping errCacheFileExpired is returned.
+// Cache files without metadata, e.g. those created by alzlibtool, are only
+// checked for their age, which is taken from the file modification time.
+func loadCacheFile(ctx context.Context, alz *alzlib.AlzLib, path string, want cacheFileMetadata, maxAge time.Duration) (*cache.Cache, []string, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is provided by the operator via provider config.
 	if err != nil {
 		if os.IsNotExist(err) {
 			tflog.Debug(ctx, "Cache file does not exist, skipping load", map[string]interface{}{
 				"cache_file_name": path,
 			})
-			return nil
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("opening cache file %q: %w", path, err)
+	}
+
+	meta, err := readCacheFileMetadata(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading cache file %q: %w", path, err)
+	}
+	var warnings []string
+	if meta != nil {
+		warnings, err = meta.check(want, maxAge, time.Now())
+	} else {
+		tflog.Debug(ctx, "Cache file has no metadata, only checking its age", map[string]interface{}{
+			"cache_file_name": path,
+		})
+		var fi os.FileInfo
+		if fi, err = os.Stat(path); err == nil {
+			err = checkCacheFileAge(fi.ModTime(), time.Now(), maxAge)
 		}
-		return fmt.Errorf("opening cache file %q: %w", path, err)
 	}
-	defer f.Close() // #nosec G307 -- read-only.
+	if err != nil {
+		return nil, warnings, fmt.Errorf("checking cache file %q: %w", path, err)
+	}
 
-	c, err := cache.NewCache(f)
+	c, err := cache.NewCache(bytes.NewReader(data))
 	if err != nil {
-		return fmt.Errorf("reading cache file %q: %w", path, err)
+		return nil, warnings, fmt.Errorf("reading cache file %q: %w", path, err)
 	}
 	alz.AddCache(c)
 	tflog.Debug(ctx, "Loaded AlzLib built-in cache from file", map[string]interface{}{
 		"cache_file_name": path,
 	})
-	return nil
+	return c, warnings, nil
 }
 
-// saveCacheFile exports the built-in policy and policy set definitions from the
-// AlzLib and writes them to the given path as a gzipped JSON file. The write is
-// performed via a temporary file in the same directory and renamed atomically
-// to avoid leaving a corrupt cache file if the process is interrupted.
-func saveCacheFile(ctx context.Context, alz *alzlib.AlzLib, path string) error {
-	c := alz.ExportBuiltInCache()
+// saveCacheFile writes the built-in policy and policy set definitions in the
+// cache to the given path as a gzipped JSON file, together with the metadata.
+// The write is performed via a temporary file in the same directory and renamed
+// atomically to avoid leaving a corrupt cache file if the process is interrupted.
+func saveCacheFile(ctx context.Context, c *cache.Cache, path string, meta cacheFileMetadata) error {
+	var buf bytes.Buffer
+	if err := c.Save(&buf); err != nil {
+		return fmt.Errorf("saving cache file: %w", err)
+	}
+	if err := writeFileAtomic(path, func(w io.Writer) error { return addCacheFileMetadata(w, buf.Bytes(), meta) }); err != nil {
+		return fmt.Errorf("saving cache file: %w", err)
+	}
+	tflog.Debug(ctx, "Saved AlzLib built-in cache to file", map[string]interface{}{
+		"cache_file_name": path,
+	})
+	return nil
+}
 
+// writeFileAtomic writes the file by calling write with a temporary file in the same directory,
+// which is then renamed to path. The directory is created if it does not exist.
+func writeFileAtomic(path string, write func(w io.Writer) error) error {
 	dir := filepath.Dir(path)
 	if err := os.MkdirAll(dir, 0o755); err != nil {
-		return fmt.Errorf("creating cache directory %q: %w", dir, err)
+		return fmt.Errorf("creating directory %q: %w", dir, err)
 	}
 
 	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
 	if err != nil {
-		return fmt.Errorf("creating temporary cache file in %q: %w", dir, err)
+		return fmt.Errorf("creating temporary file in %q: %w", dir, err)
 	}
 	tmpName := tmp.Name()
 	// Best-effort cleanup if we don't make it to the rename.
 	defer func() { _ = os.Remove(tmpName) }()
 
-	if err := c.Save(tmp); err != nil {
+	if err := write(tmp); err != nil {
 		_ = tmp.Close()
-		return fmt.Errorf("writing cache file %q: %w", path, err)
+		return fmt.Errorf("writing file %q: %w", path, err)
 	}
 	if err := tmp.Close(); err != nil {
-		return fmt.Errorf("closing cache file %q: %w", tmpName, err)
+		return fmt.Errorf("closing file %q: %w", tmpName, err)
 	}
 	if err := os.Rename(tmpName, path); err != nil {
 		// On some platforms (notably older Windows behaviour) os.Rename can
 		// fail when the destination file already exists. Fall back to
 		// removing the existing destination and renaming again so
-		// cache_file_save_enabled works on the second and subsequent runs.
+		// the file can be rewritten on the second and subsequent runs.
 		// The temp file is fully written and closed before this point, so
 		// the file content remains valid.
 		if _, statErr := os.Stat(path); statErr != nil {
-			return fmt.Errorf("renaming cache file %q to %q: %w", tmpName, path, err)
+			return fmt.Errorf("renaming file %q to %q: %w", tmpName, path, err)
 		}
 		if rmErr := os.Remove(path); rmErr != nil && !os.IsNotExist(rmErr) {
-			return fmt.Errorf("removing existing cache file %q: %w", path, rmErr)
+			return fmt.Errorf("removing existing file %q: %w", path, rmErr)
 		}
 		if err := os.Rename(tmpName, path); err != nil {
-			return fmt.Errorf("renaming cache file %q to %q: %w", tmpName, path, err)
+			return fmt.Errorf("renaming file %q to %q: %w", tmpName, path, err)
 		}
 	}
-	tflog.Debug(ctx, "Saved AlzLib built-in cache to file", map[string]interface{}{
-		"cache_file_name": path,
-	})
 	return nil
 }
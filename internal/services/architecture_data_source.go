@@ -2,10 +2,14 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"strings"
+	"testing/fstest"
 	"time"
 
 	"github.com/Azure/alzlib"
@@ -13,6 +17,7 @@ import (
 	"github.com/Azure/alzlib/deployment"
 	"github.com/Azure/alzlib/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armpolicy"
+	"github.com/Azure/terraform-provider-alz/internal/alzvalidators"
 	"github.com/Azure/terraform-provider-alz/internal/clients"
 	"github.com/Azure/terraform-provider-alz/internal/gen"
 	"github.com/Azure/terraform-provider-alz/internal/typehelper"
@@ -103,14 +108,219 @@ func (d *architectureDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	// Build the final hierarchy from the configuration
+	h := newArchitectureHierarchy(ctx, d.data, data, "architectureDataSource.Read()", resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	depl := h.Hierarchy
+
+	// Generate policy role assignments
+	policyRoleAssignments, err := depl.PolicyRoleAssignments(ctx)
+	if err != nil {
+		var praErr *deployment.PolicyRoleAssignmentErrors
+		as := errors.As(err, &praErr)
+		if !as {
+			resp.Diagnostics.AddError(
+				"architectureDataSource.Read() Error generating policy role assignments",
+				err.Error(),
+			)
+			return
+		}
+		if !d.data.SuppressWarningPolicyRoleAssignments() {
+			resp.Diagnostics.AddWarning(
+				"architectureDataSource.Read() External role assignment creation required for Azure Policy assignments.",
+				fmt.Sprintf("This is a known limitation, please do not raise GitHub issues!\nTo suppress this message see the provider flag: `suppress_warning_policy_role_assignments`\n\nSee `https://github.com/Azure/alzlib/issues/189`\n\n%s", praErr.Error()),
+			)
+		}
+	}
+
+	policyRoleAssignmentsVal, diags := policyRoleAssignmentsSetToProviderType(ctx, policyRoleAssignments.ToSlice(), policyAssignmentUserAssignedIdentityIds(depl))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.PolicyRoleAssignments = policyRoleAssignmentsVal
+
+	// Generate the dependencies between assets and the deployment order
+	graph := newAssetGraph(depl, h.exemptions, policyRoleAssignments.ToSlice())
+	deploymentOrder, err := graph.deploymentOrder()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"architectureDataSource.Read() Error generating deployment order",
+			err.Error(),
+		)
+		return
+	}
+	assetDependenciesVal, diags := assetDependenciesToProviderType(ctx, graph.dependencies())
+	resp.Diagnostics.Append(diags...)
+	deploymentOrderVal, diags := types.ListValueFrom(ctx, types.StringType, deploymentOrder)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.AssetDependencies = assetDependenciesVal
+	data.DeploymentOrder = deploymentOrderVal
+
+	// Resolve the effective policy effects
+	policyAssignmentEffectsVal, diags := policyAssignmentEffectsToProviderType(ctx, hierarchyPolicyAssignmentEffects(depl, d.data.AlzLib))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.PolicyAssignmentEffects = policyAssignmentEffectsVal
+
+	// Generate the remediation targets for DeployIfNotExists and Modify policies
+	policyRemediationTargetsVal, diags := policyRemediationTargetsToProviderType(ctx, policyRemediationTargets(depl, d.data.AlzLib))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.PolicyRemediationTargets = policyRemediationTargetsVal
+
+	// Set computed values
+	mgNames := depl.ManagementGroupNames()
+	mgVals := make([]gen.ManagementGroupsValue, len(mgNames))
+	mgResourceIds := make(map[string]attr.Value, len(mgNames))
+	for i, mgName := range mgNames {
+		mg := depl.ManagementGroup(mgName)
+		mgVal, diags := alzMgToProviderType(ctx, mg, h.PolicyAssignmentMap(mg), h.exemptions[mgName])
+		resp.Diagnostics.Append(diags...)
+		mgVals[i] = mgVal
+		mgResourceIds[mgName] = types.StringValue(mg.ResourceID())
+	}
+	mgs, diags := types.ListValueFrom(ctx, gen.NewManagementGroupsValueNull().Type(ctx), &mgVals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ManagementGroups = mgs
+	data.ManagementGroupResourceIds = types.MapValueMust(types.StringType, mgResourceIds)
+
+	// Set the id to keep ACC tests happy
+	data.Id = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ArchitectureHierarchy is the final hierarchy built from the alz_architecture configuration.
+type ArchitectureHierarchy struct {
+	*deployment.Hierarchy
+	// mgLocations are the locations of the management groups, which are the locations of their policy assignments.
+	// alzlib sets the location of every policy assignment to the default location, and has no option to change it,
+	// so the locations are applied to the policy assignments by policyAssignmentMap.
+	mgLocations map[string]string
+	// exemptions are the policy exemptions, by management group.
+	exemptions map[string]map[string]*armpolicy.Exemption
+}
+
+// ManagementGroupLocation returns the location of the management group, with the `management_group_locations`
+// overrides applied.
+func (h *ArchitectureHierarchy) ManagementGroupLocation(mg *deployment.HierarchyManagementGroup) string {
+	if location := h.mgLocations[mg.Name()]; location != "" {
+		return location
+	}
+	return mg.Location()
+}
+
+// PolicyAssignmentMap returns a copy of the policy assignments of the management group, with the location of the
+// management group set on the policy assignments that have a location.
+func (h *ArchitectureHierarchy) PolicyAssignmentMap(mg *deployment.HierarchyManagementGroup) map[string]*assets.PolicyAssignment {
+	res := mg.PolicyAssignmentMap()
+	location := h.ManagementGroupLocation(mg)
+	for _, pa := range res {
+		if pa.Location != nil {
+			pa.Location = to.Ptr(location)
+		}
+	}
+	return res
+}
+
+// PolicyExemptions returns the policy exemptions of the management group.
+func (h *ArchitectureHierarchy) PolicyExemptions(mgName string) map[string]*armpolicy.Exemption {
+	return h.exemptions[mgName]
+}
+
+// BuildArchitectureHierarchy builds the final hierarchy from the alz_architecture configuration in the same way as
+// the data source, so that the command line tools render the same hierarchy that Terraform deploys.
+func BuildArchitectureHierarchy(ctx context.Context, client *clients.Client, data gen.ArchitectureModel) (*ArchitectureHierarchy, diag.Diagnostics) {
+	var resp datasource.ReadResponse
+	h := newArchitectureHierarchy(ctx, client, data, "BuildArchitectureHierarchy()", &resp)
+	return h, resp.Diagnostics
+}
+
+// newArchitectureHierarchy builds the final hierarchy from the alz_architecture configuration.
+// The caller, e.g. `architectureDataSource.Read()`, prefixes the summary of the cache file warning, as it is not
+// about the configuration of the data source being read.
+func newArchitectureHierarchy(ctx context.Context, client *clients.Client, data gen.ArchitectureModel, caller string, resp *datasource.ReadResponse) *ArchitectureHierarchy {
+	// Use the inline architecture definition, if supplied
+	archName := data.Name.ValueString()
+	if isKnown(data.ArchitectureManagementGroups) {
+		archName = inlineArchitecture(ctx, client, archName, data.ArchitectureManagementGroups, resp)
+		if resp.Diagnostics.HasError() {
+			return nil
+		}
+	}
+
+	// Rename the management groups in the architecture, if required
+	archName, mgRenames := renameArchitecture(ctx, client, archName, data.ManagementGroupNaming, resp)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+
+	// In offline mode, report all the built-in definitions missing from the cache, instead of failing on the first
+	// one that alzlib cannot fetch from Azure.
+	if client.Offline() {
+		missing, err := missingBuiltInDefinitions(client.AlzLib, client.BuiltInCache(), archName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("architectureDataSource.Read() Error creating architecture %s", data.Name.ValueString()),
+				err.Error(),
+			)
+			return nil
+		}
+		if len(missing) > 0 {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("architectureDataSource.Read() Error creating architecture %s", data.Name.ValueString()),
+				fmt.Sprintf(
+					"Offline mode is enabled and the following built-in definitions are not in the cache file. "+
+						"Regenerate the cache file for this library and architecture with `terraform-provider-alz cache build -architecture %s`, "+
+						"or by running with `cache_file_save_enabled = true` and offline mode disabled.\n\n%s",
+					data.Name.ValueString(), strings.Join(missing, "\n"),
+				),
+			)
+			return nil
+		}
+	}
+
 	// Use alzlib to create the hierarchy from the supplied architecture
-	depl := deployment.NewHierarchy(d.data.AlzLib)
-	if err := depl.FromArchitecture(ctx, data.Name.ValueString(), data.RootManagementGroupId.ValueString(), data.Location.ValueString()); err != nil {
+	depl := deployment.NewHierarchy(client.AlzLib)
+	if err := depl.FromArchitecture(ctx, archName, data.RootManagementGroupId.ValueString(), data.Location.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("architectureDataSource.Read() Error creating architecture %s", data.Name.ValueString()),
 			err.Error(),
 		)
-		return
+		return nil
+	}
+
+	// Save the built-in definitions used by the hierarchy to the cache file, if enabled.
+	// Any set definitions fetched from Azure are now in alzlib, so their members are included.
+	referenced, err := architectureDefinitionRequests(client.AlzLib, client.BuiltInCache(), archName)
+	if err == nil {
+		err = client.SaveBuiltInCache(ctx, referenced)
+	}
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("%s Warning saving cache file", caller),
+			err.Error(),
+		)
+	}
+
+	// Update library not scopes that reference renamed management groups
+	renamePolicyAssignmentNotScopes(depl, mgRenames, resp)
+	if resp.Diagnostics.HasError() {
+		return nil
 	}
 
 	// Process assignPermissions overrides setting the values in the alzlib
@@ -122,14 +332,14 @@ func (d *architectureDataSource) Read(ctx context.Context, req datasource.ReadRe
 	)...)
 
 	if resp.Diagnostics.HasError() {
-		return
+		return nil
 	}
 
 	for _, assignPermissionsSetValue := range assignPermissionsSetValues {
 		if assignPermissionsSetValue.DefinitionName.IsUnknown() || assignPermissionsSetValue.ParameterName.IsUnknown() {
 			continue
 		}
-		d.data.SetAssignPermissionsOnDefinitionParameter(
+		client.SetAssignPermissionsOnDefinitionParameter(
 			assignPermissionsSetValue.DefinitionName.ValueString(),
 			assignPermissionsSetValue.ParameterName.ValueString(),
 		)
@@ -144,14 +354,14 @@ func (d *architectureDataSource) Read(ctx context.Context, req datasource.ReadRe
 	)...)
 
 	if resp.Diagnostics.HasError() {
-		return
+		return nil
 	}
 
 	for _, assignPermissionsUnsetValue := range assignPermissionsUnsetValues {
 		if assignPermissionsUnsetValue.DefinitionName.IsUnknown() || assignPermissionsUnsetValue.ParameterName.IsUnknown() {
 			continue
 		}
-		d.data.UnsetAssignPermissionsOnDefinitionParameter(
+		client.UnsetAssignPermissionsOnDefinitionParameter(
 			assignPermissionsUnsetValue.DefinitionName.ValueString(),
 			assignPermissionsUnsetValue.ParameterName.ValueString(),
 		)
@@ -160,7 +370,7 @@ func (d *architectureDataSource) Read(ctx context.Context, req datasource.ReadRe
 	// Set policy assignment defaults
 	defaultsMap := convertPolicyAssignmentParametersMapToSdkType(data.PolicyDefaultValues, resp)
 	if resp.Diagnostics.HasError() {
-		return
+		return nil
 	}
 	for defName, paramVal := range defaultsMap {
 		if err := depl.AddDefaultPolicyAssignmentValue(ctx, defName, paramVal); err != nil {
@@ -168,10 +378,26 @@ func (d *architectureDataSource) Read(ctx context.Context, req datasource.ReadRe
 				fmt.Sprintf("architectureDataSource.Read() Error applying policy assignment default `%s`", defName),
 				err.Error(),
 			)
-			return
+			return nil
 		}
 	}
 
+	// Resolve the location of each management group and apply it to location-typed parameters
+	mgLocations := managementGroupLocations(ctx, depl, data.Location.ValueString(), data.ManagementGroupLocations, resp)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+	applyManagementGroupLocations(depl, client.AlzLib, data.Location.ValueString(), mgLocations, resp)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+
+	// Apply the default identity to policy assignments that require one
+	applyDefaultIdentity(ctx, depl, client.AlzLib, data.DefaultIdentity, resp)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+
 	// Handle default non-compliance messages for policy assignments
 	nonComplianceConfig := NewNonComplianceMessageConfig()            // Non-compliance message config with sensible defaults
 	nonComplianceSettings := data.DefaultNonComplianceMessageSettings // Caller supplied settings
@@ -188,69 +414,38 @@ func (d *architectureDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	// provider-level substitution settings (defaults applied during provider configure)
-	nonComplianceConfig.Placeholder = d.data.NonComplianceMessagePlaceholder()
-	nonComplianceConfig.EnforcedReplacement = d.data.NonComplianceMessageEnforcedReplacement()
-	nonComplianceConfig.NotEnforcedReplacement = d.data.NonComplianceMessageNotEnforcedReplacement()
+	nonComplianceConfig.Placeholder = client.NonComplianceMessagePlaceholder()
+	nonComplianceConfig.EnforcedReplacement = client.NonComplianceMessageEnforcedReplacement()
+	nonComplianceConfig.NotEnforcedReplacement = client.NonComplianceMessageNotEnforcedReplacement()
 
 	// Modify policy assignments (explicit configs take precedence over defaults)
 	modifyPolicyAssignments(ctx, depl, data, resp)
 	if resp.Diagnostics.HasError() {
-		return
+		return nil
 	}
 
 	// Apply default non-compliance messages after policy assignments are modified
-	applyDefaultNonComplianceMessages(depl, d.data.AlzLib, nonComplianceConfig, resp)
+	applyDefaultNonComplianceMessages(depl, client.AlzLib, nonComplianceConfig, resp)
 	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Generate policy role assignments
-	policyRoleAssignments, err := depl.PolicyRoleAssignments(ctx)
-	if err != nil {
-		var praErr *deployment.PolicyRoleAssignmentErrors
-		as := errors.As(err, &praErr)
-		if !as {
-			resp.Diagnostics.AddError(
-				"architectureDataSource.Read() Error generating policy role assignments",
-				err.Error(),
-			)
-			return
-		}
-		if !d.data.SuppressWarningPolicyRoleAssignments() {
-			resp.Diagnostics.AddWarning(
-				"architectureDataSource.Read() External role assignment creation required for Azure Policy assignments.",
-				fmt.Sprintf("This is a known limitation, please do not raise GitHub issues!\nTo suppress this message see the provider flag: `suppress_warning_policy_role_assignments`\n\nSee `https://github.com/Azure/alzlib/issues/189`\n\n%s", praErr.Error()),
-			)
-		}
+		return nil
 	}
 
-	policyRoleAssignmentsVal, diags := policyRoleAssignmentsSetToProviderType(ctx, policyRoleAssignments.ToSlice())
-	resp.Diagnostics.Append(diags...)
+	// Generate the policy exemptions, validated against the final policy assignments
+	exemptions := policyExemptions(ctx, depl, client.AlzLib, data.PolicyExemptions, time.Now(), resp)
 	if resp.Diagnostics.HasError() {
-		return
+		return nil
 	}
-	data.PolicyRoleAssignments = policyRoleAssignmentsVal
 
-	// Set computed values
-	mgNames := depl.ManagementGroupNames()
-	mgVals := make([]gen.ManagementGroupsValue, len(mgNames))
-	for i, mgName := range mgNames {
-		mgVal, diags := alzMgToProviderType(ctx, depl.ManagementGroup(mgName))
-		resp.Diagnostics.Append(diags...)
-		mgVals[i] = mgVal
-	}
-	mgs, diags := types.ListValueFrom(ctx, gen.NewManagementGroupsValueNull().Type(ctx), &mgVals)
-	resp.Diagnostics.Append(diags...)
+	// Flag policy assignments that reference deprecated or preview definitions
+	checkDefinitionStatus(depl, client.AlzLib, data.PolicyDefinitionChecks, resp)
 	if resp.Diagnostics.HasError() {
-		return
+		return nil
+	}
+	return &ArchitectureHierarchy{
+		Hierarchy:   depl,
+		mgLocations: mgLocations,
+		exemptions:  exemptions,
 	}
-	data.ManagementGroups = mgs
-
-	// Set the id to keep ACC tests happy
-	data.Id = data.Name
-
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func modifyPolicyAssignments(ctx context.Context, depl *deployment.Hierarchy, data gen.ArchitectureModel, resp *datasource.ReadResponse) {
@@ -308,11 +503,14 @@ func modifyPolicyAssignments(ctx context.Context, depl *deployment.Hierarchy, da
 	}
 }
 
-func policyRoleAssignmentsSetToProviderType(ctx context.Context, input []deployment.PolicyRoleAssignment) (basetypes.SetValue, diag.Diagnostics) {
+// policyRoleAssignmentsSetToProviderType converts the policy role assignments to the framework type.
+// The identityIds map is keyed by management group id and then by policy assignment name, and is used
+// to populate the user assigned identity that the role assignment should target.
+func policyRoleAssignmentsSetToProviderType(ctx context.Context, input []deployment.PolicyRoleAssignment, identityIds map[string]map[string]string) (basetypes.SetValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	praSlice := make([]gen.PolicyRoleAssignmentsValue, 0, len(input))
 	for _, v := range input {
-		pra, diag := policyRoleAssignmentToProviderType(ctx, v)
+		pra, diag := policyRoleAssignmentToProviderType(ctx, v, identityIds[v.ManagementGroupID][v.AssignmentName])
 		diags.Append(diag...)
 		praSlice = append(praSlice, pra)
 	}
@@ -322,7 +520,11 @@ func policyRoleAssignmentsSetToProviderType(ctx context.Context, input []deploym
 	return types.SetValueFrom(ctx, gen.NewPolicyRoleAssignmentsValueNull().Type(ctx), &praSlice)
 }
 
-func policyRoleAssignmentToProviderType(ctx context.Context, input deployment.PolicyRoleAssignment) (gen.PolicyRoleAssignmentsValue, diag.Diagnostics) {
+func policyRoleAssignmentToProviderType(ctx context.Context, input deployment.PolicyRoleAssignment, identityId string) (gen.PolicyRoleAssignmentsValue, diag.Diagnostics) {
+	identityIdVal := types.StringNull()
+	if identityId != "" {
+		identityIdVal = types.StringValue(identityId)
+	}
 	return gen.NewPolicyRoleAssignmentsValue(
 		gen.NewPolicyRoleAssignmentsValueNull().AttributeTypes(ctx),
 		map[string]attr.Value{
@@ -330,37 +532,249 @@ func policyRoleAssignmentToProviderType(ctx context.Context, input deployment.Po
 			"scope":                  types.StringValue(input.Scope),
 			"policy_assignment_name": types.StringValue(input.AssignmentName),
 			"management_group_id":    types.StringValue(input.ManagementGroupID),
+			"identity_id":            identityIdVal,
 		},
 	)
 }
 
-func alzMgToProviderType(ctx context.Context, mg *deployment.HierarchyManagementGroup) (gen.ManagementGroupsValue, diag.Diagnostics) {
+// policyAssignmentUserAssignedIdentityIds returns the user assigned identity ids of the policy assignments in the hierarchy.
+// The result is keyed by management group id and then by policy assignment name.
+// Policy assignments that do not use a user assigned identity are omitted.
+func policyAssignmentUserAssignedIdentityIds(depl *deployment.Hierarchy) map[string]map[string]string {
+	res := make(map[string]map[string]string)
+	for _, mgName := range depl.ManagementGroupNames() {
+		mg := depl.ManagementGroup(mgName)
+		if mg == nil {
+			continue
+		}
+		for paName, pa := range mg.PolicyAssignmentMap() {
+			if pa.Identity == nil || pa.Identity.Type == nil || *pa.Identity.Type != armpolicy.ResourceIdentityTypeUserAssigned {
+				continue
+			}
+			for id := range pa.Identity.UserAssignedIdentities {
+				if _, ok := res[mgName]; !ok {
+					res[mgName] = make(map[string]string)
+				}
+				res[mgName][paName] = id
+			}
+		}
+	}
+	return res
+}
+
+// alzMgToProviderType converts the management group to the framework type.
+// The supplied policy assignments and exemptions are those at the management group.
+func alzMgToProviderType(ctx context.Context, mg *deployment.HierarchyManagementGroup, paMap map[string]*assets.PolicyAssignment, exemptions map[string]*armpolicy.Exemption) (gen.ManagementGroupsValue, diag.Diagnostics) {
 	var respDiags diag.Diagnostics
-	policyAssignments, diags := typehelper.ConvertAlzMapToFrameworkType(mg.PolicyAssignmentMap())
+	pdMap := mg.PolicyDefinitionsMap()
+	psdMap := mg.PolicySetDefinitionsMap()
+	rdMap := mg.RoleDefinitionsMap()
+	policyAssignments, diags := typehelper.ConvertAlzMapToFrameworkType(paMap)
+	respDiags.Append(diags...)
+	policyDefinitions, diags := typehelper.ConvertAlzMapToFrameworkType(pdMap)
 	respDiags.Append(diags...)
-	policyDefinitions, diags := typehelper.ConvertAlzMapToFrameworkType(mg.PolicyDefinitionsMap())
+	policySetDefinitions, diags := typehelper.ConvertAlzMapToFrameworkType(psdMap)
 	respDiags.Append(diags...)
-	policySetDefinitions, diags := typehelper.ConvertAlzMapToFrameworkType(mg.PolicySetDefinitionsMap())
+	roleDefinitions, diags := typehelper.ConvertAlzMapToFrameworkType(rdMap)
 	respDiags.Append(diags...)
-	roleDefinitions, diags := typehelper.ConvertAlzMapToFrameworkType(mg.RoleDefinitionsMap())
+	policyExemptions, diags := typehelper.ConvertAlzMapToFrameworkType(exemptions)
 	respDiags.Append(diags...)
 	if respDiags.HasError() {
 		return gen.NewManagementGroupsValueNull(), respDiags
 	}
-	return gen.NewManagementGroupsValue(
-		gen.NewManagementGroupsValueNull().AttributeTypes(ctx),
-		map[string]attr.Value{
-			"id":                     types.StringValue(mg.Name()),
-			"parent_id":              types.StringValue(mg.ParentID()),
-			"display_name":           types.StringValue(mg.DisplayName()),
-			"exists":                 types.BoolValue(mg.Exists()),
-			"level":                  types.NumberValue(big.NewFloat(float64(mg.Level()))),
-			"policy_assignments":     policyAssignments,
-			"policy_definitions":     policyDefinitions,
-			"policy_set_definitions": policySetDefinitions,
-			"role_definitions":       roleDefinitions,
-		},
-	)
+	policyAssignmentIds := resourceIdMapToProviderType(paMap, func(v *assets.PolicyAssignment) *string { return v.ID })
+	policyDefinitionIds := resourceIdMapToProviderType(pdMap, func(v *assets.PolicyDefinition) *string { return v.ID })
+	policySetDefinitionIds := resourceIdMapToProviderType(psdMap, func(v *assets.PolicySetDefinition) *string { return v.ID })
+	roleDefinitionIds := resourceIdMapToProviderType(rdMap, func(v *assets.RoleDefinition) *string { return v.ID })
+	policyExemptionIds := resourceIdMapToProviderType(exemptions, func(v *armpolicy.Exemption) *string { return v.ID })
+	return gen.NewManagementGroupsValue(
+		gen.NewManagementGroupsValueNull().AttributeTypes(ctx),
+		map[string]attr.Value{
+			"id":                        types.StringValue(mg.Name()),
+			"parent_id":                 types.StringValue(mg.ParentID()),
+			"display_name":              types.StringValue(mg.DisplayName()),
+			"exists":                    types.BoolValue(mg.Exists()),
+			"level":                     types.NumberValue(big.NewFloat(float64(mg.Level()))),
+			"policy_assignments":        policyAssignments,
+			"policy_definitions":        policyDefinitions,
+			"policy_set_definitions":    policySetDefinitions,
+			"role_definitions":          roleDefinitions,
+			"policy_exemptions":         policyExemptions,
+			"policy_assignment_ids":     policyAssignmentIds,
+			"policy_definition_ids":     policyDefinitionIds,
+			"policy_set_definition_ids": policySetDefinitionIds,
+			"role_definition_ids":       roleDefinitionIds,
+			"policy_exemption_ids":      policyExemptionIds,
+		},
+	)
+}
+
+// resourceIdMapToProviderType returns a map of the keys of the supplied map to the resource ids of its values.
+// Values without a resource id are omitted.
+func resourceIdMapToProviderType[T any](m map[string]T, id func(T) *string) basetypes.MapValue {
+	res := make(map[string]attr.Value, len(m))
+	for k, v := range m {
+		if resId := id(v); resId != nil {
+			res[k] = types.StringValue(*resId)
+		}
+	}
+	return types.MapValueMust(types.StringType, res)
+}
+
+// policyExemptionIDFmt is the format string for policy exemption resource IDs at management group scope.
+const policyExemptionIDFmt = deployment.ManagementGroupIDFmt + "/providers/Microsoft.Authorization/policyExemptions/%s"
+
+// policyExemptionType is the ARM resource type of policy exemptions.
+const policyExemptionType = "Microsoft.Authorization/policyExemptions"
+
+// policyExemptions converts the supplied policy exemptions to the Azure Go SDK type, validating them against the hierarchy.
+// The result is a map of management group id to a map of policy exemption name to policy exemption.
+// Exemptions that have expired before now generate a warning.
+func policyExemptions(ctx context.Context, depl *deployment.Hierarchy, az *alzlib.AlzLib, src types.List, now time.Time, resp *datasource.ReadResponse) map[string]map[string]*armpolicy.Exemption {
+	if !isKnown(src) {
+		return nil
+	}
+
+	exemptions := make([]gen.PolicyExemptionsValue, 0, len(src.Elements()))
+	resp.Diagnostics.Append(src.ElementsAs(ctx, &exemptions, false)...)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+
+	res := make(map[string]map[string]*armpolicy.Exemption)
+	for _, pe := range exemptions {
+		name := pe.Name.ValueString()
+		mgName := pe.ManagementGroupId.ValueString()
+		paName := pe.PolicyAssignmentName.ValueString()
+		mg := depl.ManagementGroup(mgName)
+		if mg == nil {
+			resp.Diagnostics.AddError(
+				"architectureDataSource.Read() Error creating policy exemption",
+				fmt.Sprintf("Policy exemption `%s`: management group `%s` not found in the architecture", name, mgName),
+			)
+			return nil
+		}
+		if _, exists := res[mgName][name]; exists {
+			resp.Diagnostics.AddError(
+				"architectureDataSource.Read() Error creating policy exemption",
+				fmt.Sprintf("Policy exemption `%s` is defined more than once at management group `%s`", name, mgName),
+			)
+			return nil
+		}
+
+		paMg, pa := policyAssignmentInScope(mg, paName)
+		if pa == nil {
+			resp.Diagnostics.AddError(
+				"architectureDataSource.Read() Error creating policy exemption",
+				fmt.Sprintf("Policy exemption `%s`: policy assignment `%s` not found at management group `%s` or its ancestors", name, paName, mgName),
+			)
+			return nil
+		}
+
+		props := &armpolicy.ExemptionProperties{
+			ExemptionCategory:  to.Ptr(armpolicy.ExemptionCategory(pe.ExemptionCategory.ValueString())),
+			PolicyAssignmentID: to.Ptr(fmt.Sprintf(deployment.PolicyAssignmentIDFmt, paMg.Name(), paName)),
+			DisplayName:        pe.DisplayName.ValueStringPointer(),
+			Description:        pe.Description.ValueStringPointer(),
+		}
+
+		if isKnown(pe.ExpiresOn) {
+			expiresOn, err := time.Parse(time.RFC3339, pe.ExpiresOn.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"architectureDataSource.Read() Error creating policy exemption",
+					fmt.Sprintf("Policy exemption `%s`: unable to parse `expires_on`: %s", name, err.Error()),
+				)
+				return nil
+			}
+			if expiresOn.Before(now) {
+				resp.Diagnostics.AddWarning(
+					"architectureDataSource.Read() Warning policy exemption expired",
+					fmt.Sprintf("Policy exemption `%s` at management group `%s` expired at %s", name, mgName, expiresOn.Format(time.RFC3339)),
+				)
+			}
+			props.ExpiresOn = to.Ptr(expiresOn.UTC())
+		}
+
+		if isKnown(pe.PolicyDefinitionReferenceIds) && len(pe.PolicyDefinitionReferenceIds.Elements()) > 0 {
+			refIds := make([]string, 0, len(pe.PolicyDefinitionReferenceIds.Elements()))
+			resp.Diagnostics.Append(pe.PolicyDefinitionReferenceIds.ElementsAs(ctx, &refIds, false)...)
+			if resp.Diagnostics.HasError() {
+				return nil
+			}
+			validRefIds, err := policyAssignmentDefinitionReferenceIds(pa, az)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"architectureDataSource.Read() Error creating policy exemption",
+					fmt.Sprintf("Policy exemption `%s`: `policy_definition_reference_ids` cannot be used with policy assignment `%s`: %s", name, paName, err.Error()),
+				)
+				return nil
+			}
+			slices.Sort(refIds)
+			for _, refId := range refIds {
+				if !slices.Contains(validRefIds, refId) {
+					resp.Diagnostics.AddError(
+						"architectureDataSource.Read() Error creating policy exemption",
+						fmt.Sprintf("Policy exemption `%s`: policy definition reference id `%s` not found in the policy set definition assigned by `%s`. Valid values are: %s", name, refId, paName, strings.Join(validRefIds, ", ")),
+					)
+					return nil
+				}
+			}
+			props.PolicyDefinitionReferenceIDs = to.SliceOfPtrs(refIds...)
+		}
+
+		if res[mgName] == nil {
+			res[mgName] = make(map[string]*armpolicy.Exemption)
+		}
+		res[mgName][name] = &armpolicy.Exemption{
+			ID:         to.Ptr(fmt.Sprintf(policyExemptionIDFmt, mgName, name)),
+			Name:       to.Ptr(name),
+			Type:       to.Ptr(policyExemptionType),
+			Properties: props,
+		}
+	}
+	return res
+}
+
+// policyAssignmentInScope returns the nearest policy assignment with the supplied name,
+// searching from the management group up through its ancestors in the hierarchy.
+// It also returns the management group at which the policy assignment was found.
+func policyAssignmentInScope(mg *deployment.HierarchyManagementGroup, paName string) (*deployment.HierarchyManagementGroup, *assets.PolicyAssignment) {
+	for ; mg != nil; mg = mg.Parent() {
+		if pa, ok := mg.PolicyAssignmentMap()[paName]; ok {
+			return mg, pa
+		}
+	}
+	return nil, nil
+}
+
+// policyAssignmentDefinitionReferenceIds returns the sorted policy definition reference ids of the policy set definition
+// assigned by the policy assignment.
+// An error is returned if the policy assignment does not assign a policy set definition, or it cannot be found.
+func policyAssignmentDefinitionReferenceIds(pa *assets.PolicyAssignment, az *alzlib.AlzLib) ([]string, error) {
+	if pa.Properties == nil || pa.Properties.PolicyDefinitionID == nil {
+		return nil, errors.New("policy assignment has no policy definition id")
+	}
+	resID, version, err := pa.ReferencedPolicyDefinitionResourceIDAndVersion()
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(resID.ResourceType.Type, alzlib.PolicySetDefinitionsType) {
+		return nil, errors.New("policy assignment does not assign a policy set definition")
+	}
+	psd := az.PolicySetDefinition(resID.Name, version)
+	if psd == nil {
+		return nil, fmt.Errorf("policy set definition `%s` not found", resID.Name)
+	}
+	res := make([]string, 0, len(psd.PolicyDefinitionReferences()))
+	for _, ref := range psd.PolicyDefinitionReferences() {
+		if ref == nil || ref.PolicyDefinitionReferenceID == nil {
+			continue
+		}
+		res = append(res, *ref.PolicyDefinitionReferenceID)
+	}
+	slices.Sort(res)
+	return res, nil
 }
 
 // policyAssignmentType2ArmPolicyValues returns a set of Azure Go SDK values from a PolicyAssignmentType.
@@ -623,10 +1037,7 @@ func convertPolicyAssignmentIdentityToSdkType(typ types.String, ids types.Set, r
 		}
 		id = idStr.ValueString()
 
-		identity = to.Ptr(armpolicy.Identity{
-			Type:                   to.Ptr(armpolicy.ResourceIdentityTypeUserAssigned),
-			UserAssignedIdentities: map[string]*armpolicy.UserAssignedIdentitiesValue{id: {}},
-		})
+		identity = userAssignedIdentity(id)
 	default:
 		resp.Diagnostics.AddError(
 			"convertPolicyAssignmentIdentityToSdkType: error",
@@ -637,6 +1048,501 @@ func convertPolicyAssignmentIdentityToSdkType(typ types.String, ids types.Set, r
 	return identity
 }
 
+// managementGroupLocations returns the location of each management group in the hierarchy, keyed by management group id.
+// The location is taken from the nearest management group (self or ancestor) with an override in the supplied map,
+// falling back to the default location.
+func managementGroupLocations(ctx context.Context, depl *deployment.Hierarchy, defaultLocation string, src types.Map, resp *datasource.ReadResponse) map[string]string {
+	overrides := make(map[string]string)
+	if isKnown(src) {
+		resp.Diagnostics.Append(src.ElementsAs(ctx, &overrides, false)...)
+		if resp.Diagnostics.HasError() {
+			return nil
+		}
+	}
+
+	for mgName := range overrides {
+		if depl.ManagementGroup(mgName) == nil {
+			resp.Diagnostics.AddWarning(
+				"architectureDataSource.Read() Warning applying management group locations",
+				fmt.Sprintf("Management group `%s` not found in hierarchy", mgName),
+			)
+		}
+	}
+
+	res := make(map[string]string)
+	for _, mgName := range depl.ManagementGroupNames() {
+		res[mgName] = defaultLocation
+		for mg := depl.ManagementGroup(mgName); mg != nil; mg = mg.Parent() {
+			if loc, ok := overrides[mg.Name()]; ok {
+				res[mgName] = loc
+				break
+			}
+		}
+	}
+	return res
+}
+
+// applyManagementGroupLocations updates location-typed policy assignment parameters for management groups
+// whose location differs from the default location.
+// Only string parameter values equal to the default location are updated, other values have been set deliberately.
+func applyManagementGroupLocations(depl *deployment.Hierarchy, az *alzlib.AlzLib, defaultLocation string, mgLocations map[string]string, resp *datasource.ReadResponse) {
+	for mgName, location := range mgLocations {
+		if strings.EqualFold(location, defaultLocation) {
+			continue
+		}
+		mg := depl.ManagementGroup(mgName)
+		if mg == nil {
+			continue
+		}
+		for paName, pa := range mg.PolicyAssignmentMap() {
+			if pa.Properties == nil {
+				continue
+			}
+			params := make(map[string]*armpolicy.ParameterValuesValue)
+			for paramName, paramVal := range pa.Properties.Parameters {
+				if paramVal == nil {
+					continue
+				}
+				if v, ok := paramVal.Value.(string); !ok || !strings.EqualFold(v, defaultLocation) {
+					continue
+				}
+				if !policyAssignmentParameterIsLocation(pa, az, paramName) {
+					continue
+				}
+				params[paramName] = &armpolicy.ParameterValuesValue{Value: location}
+			}
+			if len(params) == 0 {
+				continue
+			}
+			if err := mg.ModifyPolicyAssignment(paName, deployment.WithParameters(params)); err != nil {
+				resp.Diagnostics.AddError(
+					"architectureDataSource.Read() Error applying management group location",
+					fmt.Sprintf("Error applying location `%s` for `%s` at mg `%s`: %s", location, paName, mgName, err.Error()),
+				)
+				return
+			}
+		}
+	}
+}
+
+// policyAssignmentParameterIsLocation returns true if the parameter in the referenced policy definition
+// or policy set definition has the `location` strong type.
+func policyAssignmentParameterIsLocation(pa *assets.PolicyAssignment, az *alzlib.AlzLib, paramName string) bool {
+	resID, version, err := pa.ReferencedPolicyDefinitionResourceIDAndVersion()
+	if err != nil || resID == nil {
+		return false
+	}
+
+	var param *armpolicy.ParameterDefinitionsValue
+	switch strings.ToLower(resID.ResourceType.Type) {
+	case alzlib.PolicyDefinitionsType:
+		param = az.PolicyDefinition(resID.Name, version).Parameter(paramName)
+	case alzlib.PolicySetDefinitionsType:
+		param = az.PolicySetDefinition(resID.Name, version).Parameter(paramName)
+	}
+	if param == nil || param.Metadata == nil || param.Metadata.StrongType == nil {
+		return false
+	}
+	return strings.EqualFold(*param.Metadata.StrongType, "location")
+}
+
+// libArchitecture is the library file representation of an architecture.
+type libArchitecture struct {
+	Name             string                           `json:"name"`
+	ManagementGroups []libArchitectureManagementGroup `json:"management_groups"`
+}
+
+// libArchitectureManagementGroup is the library file representation of a management group in an architecture.
+type libArchitectureManagementGroup struct {
+	ID          string   `json:"id"`
+	DisplayName string   `json:"display_name"`
+	Archetypes  []string `json:"archetypes"`
+	ParentID    *string  `json:"parent_id"`
+	Exists      bool     `json:"exists"`
+}
+
+// managementGroupNaming contains the settings used to rename the management groups in an architecture.
+type managementGroupNaming struct {
+	idTemplate          string
+	displayNameTemplate string
+	ids                 map[string]string
+	displayNames        map[string]string
+}
+
+// renameArchitecture returns the name of the architecture to deploy, and a map of the original to the renamed management group ids.
+// If management group naming is configured, a renamed copy of the architecture is generated and added to alzlib.
+// If no management groups are renamed, the supplied architecture name is returned with a nil map.
+func renameArchitecture(ctx context.Context, client *clients.Client, name string, src gen.ManagementGroupNamingValue, resp *datasource.ReadResponse) (string, map[string]string) {
+	if !isKnown(src) {
+		return name, nil
+	}
+
+	arch := client.Architecture(name)
+	if arch == nil {
+		// Let FromArchitecture() report the missing architecture
+		return name, nil
+	}
+
+	naming := managementGroupNaming{
+		idTemplate:          src.IdTemplate.ValueString(),
+		displayNameTemplate: src.DisplayNameTemplate.ValueString(),
+		ids:                 make(map[string]string),
+		displayNames:        make(map[string]string),
+	}
+	if isKnown(src.Ids) {
+		resp.Diagnostics.Append(src.Ids.ElementsAs(ctx, &naming.ids, false)...)
+	}
+	if isKnown(src.DisplayNames) {
+		resp.Diagnostics.Append(src.DisplayNames.ElementsAs(ctx, &naming.displayNames, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return name, nil
+	}
+
+	lib := architectureToLib(arch)
+	for _, key := range naming.unknownKeys(lib) {
+		resp.Diagnostics.AddWarning(
+			"architectureDataSource.Read() Warning renaming management groups",
+			fmt.Sprintf("Management group `%s` not found in architecture `%s`", key, name),
+		)
+	}
+
+	renamed, renames, err := naming.rename(lib)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"architectureDataSource.Read() Error renaming management groups",
+			err.Error(),
+		)
+		return name, nil
+	}
+	if len(renames) == 0 && slices.Equal(managementGroupDisplayNames(lib), managementGroupDisplayNames(renamed)) {
+		return name, nil
+	}
+
+	renamedName, err := addLibArchitecture(ctx, client, renamed)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"architectureDataSource.Read() Error renaming management groups",
+			fmt.Sprintf("Error adding renamed architecture to alzlib: %s", err.Error()),
+		)
+		return name, nil
+	}
+	return renamedName, renames
+}
+
+// inlineArchitecture adds the inline architecture definition to alzlib and returns the name of the generated architecture.
+func inlineArchitecture(ctx context.Context, client *clients.Client, name string, src types.List, resp *datasource.ReadResponse) string {
+	mgs := make([]gen.ArchitectureManagementGroupsValue, 0, len(src.Elements()))
+	resp.Diagnostics.Append(src.ElementsAs(ctx, &mgs, false)...)
+	if resp.Diagnostics.HasError() {
+		return ""
+	}
+
+	lib := libArchitecture{
+		Name:             name,
+		ManagementGroups: make([]libArchitectureManagementGroup, len(mgs)),
+	}
+	for i, mg := range mgs {
+		archetypes := make([]string, 0, len(mg.Archetypes.Elements()))
+		resp.Diagnostics.Append(mg.Archetypes.ElementsAs(ctx, &archetypes, false)...)
+		if resp.Diagnostics.HasError() {
+			return ""
+		}
+		slices.Sort(archetypes)
+		lib.ManagementGroups[i] = libArchitectureManagementGroup{
+			ID:          mg.Id.ValueString(),
+			DisplayName: mg.DisplayName.ValueString(),
+			Archetypes:  archetypes,
+			ParentID:    mg.ParentId.ValueStringPointer(),
+			Exists:      mg.Exists.ValueBool(),
+		}
+	}
+
+	res, err := addLibArchitecture(ctx, client, lib)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"architectureDataSource.Read() Error processing inline architecture",
+			err.Error(),
+		)
+		return ""
+	}
+	return res
+}
+
+// addLibArchitecture adds the architecture to alzlib and returns the name it was added with.
+// A content hash is appended to the name, so the same architecture is only added once and
+// does not conflict with architectures in the library.
+func addLibArchitecture(ctx context.Context, client *clients.Client, lib libArchitecture) (string, error) {
+	content, err := json.Marshal(lib)
+	if err != nil {
+		return "", fmt.Errorf("marshaling architecture `%s`: %w", lib.Name, err)
+	}
+	sum := sha256.Sum256(content)
+	lib.Name = fmt.Sprintf("%s_%x", lib.Name, sum[:8])
+	if content, err = json.Marshal(lib); err != nil {
+		return "", fmt.Errorf("marshaling architecture `%s`: %w", lib.Name, err)
+	}
+
+	libFS := fstest.MapFS{
+		lib.Name + ".alz_architecture_definition.json": &fstest.MapFile{Data: content},
+	}
+	if err := client.InitArchitectureFromFS(ctx, lib.Name, libFS); err != nil {
+		return "", err
+	}
+	return lib.Name, nil
+}
+
+// architectureToLib converts the architecture to its library file representation.
+// Management groups are sorted by id so that the result is deterministic.
+func architectureToLib(arch *alzlib.Architecture) libArchitecture {
+	res := libArchitecture{
+		Name: arch.Name(),
+	}
+	var walk func(mg *alzlib.ArchitectureManagementGroup, parentID *string)
+	walk = func(mg *alzlib.ArchitectureManagementGroup, parentID *string) {
+		archetypes := make([]string, 0)
+		for _, a := range mg.Archetypes() {
+			archetypes = append(archetypes, a.Name())
+		}
+		res.ManagementGroups = append(res.ManagementGroups, libArchitectureManagementGroup{
+			ID:          mg.ID(),
+			DisplayName: mg.DisplayName(),
+			Archetypes:  archetypes,
+			ParentID:    parentID,
+			Exists:      mg.Exists(),
+		})
+		for _, child := range mg.Children() {
+			walk(child, to.Ptr(mg.ID()))
+		}
+	}
+	for _, mg := range arch.RootMgs() {
+		walk(mg, nil)
+	}
+	slices.SortFunc(res.ManagementGroups, func(a, b libArchitectureManagementGroup) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return res
+}
+
+// managementGroupDisplayNames returns the display names of the management groups in the architecture.
+func managementGroupDisplayNames(lib libArchitecture) []string {
+	res := make([]string, len(lib.ManagementGroups))
+	for i, mg := range lib.ManagementGroups {
+		res[i] = mg.DisplayName
+	}
+	return res
+}
+
+// unknownKeys returns the keys of the explicit id and display name maps that are not in the architecture.
+func (n managementGroupNaming) unknownKeys(lib libArchitecture) []string {
+	known := make(map[string]struct{}, len(lib.ManagementGroups))
+	for _, mg := range lib.ManagementGroups {
+		known[mg.ID] = struct{}{}
+	}
+	var res []string
+	for _, m := range []map[string]string{n.ids, n.displayNames} {
+		for k := range m {
+			if _, ok := known[k]; !ok && !slices.Contains(res, k) {
+				res = append(res, k)
+			}
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+// rename returns a copy of the architecture with the management group ids and display names updated.
+// Explicit ids and display names take precedence over the templates.
+// The returned map contains the original and new ids of the management groups whose id has changed.
+func (n managementGroupNaming) rename(lib libArchitecture) (libArchitecture, map[string]string, error) {
+	renames := make(map[string]string)
+	newIds := make(map[string]string, len(lib.ManagementGroups))
+	seen := make(map[string]string, len(lib.ManagementGroups))
+	for _, mg := range lib.ManagementGroups {
+		newID := mg.ID
+		if n.idTemplate != "" {
+			newID = strings.ReplaceAll(n.idTemplate, "{id}", mg.ID)
+		}
+		if id, ok := n.ids[mg.ID]; ok {
+			newID = id
+		}
+		if !alzvalidators.IsManagementGroupId(newID) {
+			return libArchitecture{}, nil, fmt.Errorf("management group `%s` renamed to `%s`, which is not a valid management group id", mg.ID, newID)
+		}
+		if other, ok := seen[strings.ToLower(newID)]; ok {
+			return libArchitecture{}, nil, fmt.Errorf("management groups `%s` and `%s` are both renamed to `%s`", other, mg.ID, newID)
+		}
+		seen[strings.ToLower(newID)] = mg.ID
+		newIds[mg.ID] = newID
+		if newID != mg.ID {
+			renames[mg.ID] = newID
+		}
+	}
+
+	res := libArchitecture{
+		Name:             lib.Name,
+		ManagementGroups: make([]libArchitectureManagementGroup, len(lib.ManagementGroups)),
+	}
+	for i, mg := range lib.ManagementGroups {
+		mg.ID = newIds[mg.ID]
+		if mg.ParentID != nil {
+			mg.ParentID = to.Ptr(newIds[*mg.ParentID])
+		}
+		if n.displayNameTemplate != "" {
+			mg.DisplayName = strings.ReplaceAll(n.displayNameTemplate, "{display_name}", mg.DisplayName)
+		}
+		if dn, ok := n.displayNames[lib.ManagementGroups[i].ID]; ok {
+			mg.DisplayName = dn
+		}
+		res.ManagementGroups[i] = mg
+	}
+	return res, renames, nil
+}
+
+// renamePolicyAssignmentNotScopes updates policy assignment not scopes that reference renamed management groups.
+func renamePolicyAssignmentNotScopes(depl *deployment.Hierarchy, renames map[string]string, resp *datasource.ReadResponse) {
+	if len(renames) == 0 {
+		return
+	}
+	for _, mgName := range depl.ManagementGroupNames() {
+		mg := depl.ManagementGroup(mgName)
+		if mg == nil {
+			continue
+		}
+		for paName, pa := range mg.PolicyAssignmentMap() {
+			if pa.Properties == nil || len(pa.Properties.NotScopes) == 0 {
+				continue
+			}
+			notScopes, changed := renameManagementGroupScopes(pa.Properties.NotScopes, renames)
+			if !changed {
+				continue
+			}
+			if err := mg.ModifyPolicyAssignment(paName, deployment.WithNotScopes(notScopes)); err != nil {
+				resp.Diagnostics.AddError(
+					"architectureDataSource.Read() Error renaming management groups",
+					fmt.Sprintf("Error updating not scopes for `%s` at mg `%s`: %s", paName, mgName, err.Error()),
+				)
+				return
+			}
+		}
+	}
+}
+
+// renameManagementGroupScopes returns the scopes with management group resource ids updated using the supplied renames.
+// The bool return value is true if any scope was changed.
+func renameManagementGroupScopes(scopes []*string, renames map[string]string) ([]*string, bool) {
+	const prefix = "/providers/microsoft.management/managementgroups/"
+	changed := false
+	res := make([]*string, len(scopes))
+	for i, scope := range scopes {
+		res[i] = scope
+		if scope == nil || !strings.HasPrefix(strings.ToLower(*scope), prefix) {
+			continue
+		}
+		name := (*scope)[len(prefix):]
+		if newName, ok := renames[name]; ok {
+			res[i] = to.Ptr(fmt.Sprintf(deployment.ManagementGroupIDFmt, newName))
+			changed = true
+		}
+	}
+	return res, changed
+}
+
+// userAssignedIdentity returns a policy assignment identity for the supplied user assigned identity resource id.
+func userAssignedIdentity(id string) *armpolicy.Identity {
+	return to.Ptr(armpolicy.Identity{
+		Type:                   to.Ptr(armpolicy.ResourceIdentityTypeUserAssigned),
+		UserAssignedIdentities: map[string]*armpolicy.UserAssignedIdentitiesValue{id: {}},
+	})
+}
+
+// applyDefaultIdentity sets the supplied user assigned identity on all policy assignments that require an identity.
+// Per management group overrides take precedence over the default identity id.
+// This runs before modifyPolicyAssignments, so identities set in policy_assignments_to_modify take precedence.
+func applyDefaultIdentity(ctx context.Context, depl *deployment.Hierarchy, az *alzlib.AlzLib, src gen.DefaultIdentityValue, resp *datasource.ReadResponse) {
+	if !isKnown(src) || !isKnown(src.IdentityId) {
+		return
+	}
+
+	mgIdentityIds := make(map[string]string)
+	if isKnown(src.ManagementGroupIdentityIds) {
+		resp.Diagnostics.Append(src.ManagementGroupIdentityIds.ElementsAs(ctx, &mgIdentityIds, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for mgName := range mgIdentityIds {
+		if depl.ManagementGroup(mgName) == nil {
+			resp.Diagnostics.AddWarning(
+				"architectureDataSource.Read() Warning applying default identity",
+				fmt.Sprintf("Management group `%s` not found in hierarchy", mgName),
+			)
+		}
+	}
+
+	for _, mgName := range depl.ManagementGroupNames() {
+		mg := depl.ManagementGroup(mgName)
+		if mg == nil {
+			continue
+		}
+
+		identityId := src.IdentityId.ValueString()
+		if id, ok := mgIdentityIds[mgName]; ok {
+			identityId = id
+		}
+
+		for paName, pa := range mg.PolicyAssignmentMap() {
+			if !policyAssignmentRequiresIdentity(pa, az) {
+				continue
+			}
+			if err := mg.ModifyPolicyAssignment(
+				paName,
+				deployment.WithIdentity(userAssignedIdentity(identityId)),
+			); err != nil {
+				resp.Diagnostics.AddError(
+					"architectureDataSource.Read() Error applying default identity",
+					fmt.Sprintf("Error applying default identity for `%s` at mg `%s`: %s", paName, mgName, err.Error()),
+				)
+				return
+			}
+		}
+	}
+}
+
+// policyAssignmentRequiresIdentity returns true if the policy assignment needs a managed identity.
+// This is the case if the assignment already has an identity, or if the referenced policy definition
+// (or any policy definition in the referenced policy set definition) declares role definition ids.
+// Role definition ids are mandatory for the DeployIfNotExists and Modify effects.
+func policyAssignmentRequiresIdentity(pa *assets.PolicyAssignment, az *alzlib.AlzLib) bool {
+	if pa.Identity != nil && pa.Identity.Type != nil && *pa.Identity.Type != armpolicy.ResourceIdentityTypeNone {
+		return true
+	}
+
+	pd, psd := policyAssignmentDefinitions(pa, az)
+	if pd != nil {
+		return policyDefinitionHasRoleDefinitionIds(pd)
+	}
+	if psd == nil {
+		return false
+	}
+	for _, ref := range psd.PolicyDefinitionReferences() {
+		if policyDefinitionHasRoleDefinitionIds(policySetDefinitionMember(ref, az)) {
+			return true
+		}
+	}
+	return false
+}
+
+// policyDefinitionHasRoleDefinitionIds returns true if the policy definition declares role definition ids in its policy rule.
+func policyDefinitionHasRoleDefinitionIds(pd *assets.PolicyDefinition) bool {
+	if pd == nil {
+		return false
+	}
+	rdids, err := pd.RoleDefinitionResourceIDs()
+	return err == nil && len(rdids) > 0
+}
+
 // convertPolicyAssignmentParametersMapToSdkType converts a map with a JSON string value to a map[string]*armpolicy.ParameterValuesValue.
 func convertPolicyAssignmentParametersMapToSdkType(src types.Map, resp *datasource.ReadResponse) map[string]*armpolicy.ParameterValuesValue {
 	if !isKnown(src) {
@@ -800,28 +1706,53 @@ func enforcementModeReplacement(mode *armpolicy.EnforcementMode, enforcedRepl, n
 // Checks the policy definition of an assignment and returns true if it's mode is resource provider specific, e.g. "Microsoft.KeyVault.Data".
 // Direct policy assignments with resource provider mode definitions do not support non-compliance messages.
 func isResourceProviderModePolicyDefinitionAssignment(pa *assets.PolicyAssignment, az *alzlib.AlzLib) bool {
-	if pa.Properties == nil || pa.Properties.PolicyDefinitionID == nil {
+	// Only check direct policy definition assignments, not policy set definitions
+	// Default non-compliance messages are supported even if they contain resource provider mode policy definitions.
+	pd, _ := policyAssignmentDefinitions(pa, az)
+	if pd == nil || pd.Properties == nil || pd.Properties.Mode == nil {
 		return false
 	}
 
+	mode := strings.ToLower(*pd.Properties.Mode)
+	return mode != "all" && mode != "indexed"
+}
+
+// policyAssignmentDefinitions looks up the policy definition or policy set definition assigned by the policy assignment.
+// At most one of the returned values is non-nil. Both are nil if the assigned definition cannot be found.
+func policyAssignmentDefinitions(pa *assets.PolicyAssignment, az *alzlib.AlzLib) (*assets.PolicyDefinition, *assets.PolicySetDefinition) {
+	if pa.Properties == nil || pa.Properties.PolicyDefinitionID == nil {
+		return nil, nil
+	}
+
 	resID, version, err := pa.ReferencedPolicyDefinitionResourceIDAndVersion()
 	if err != nil || resID == nil {
-		return false
+		return nil, nil
 	}
 
-	// Only check direct policy definition assignments, not policy set definitions
-	// Default non-compliance messages are supported even if they contain resource provider mode policy definitions.
-	if !strings.EqualFold(resID.ResourceType.Type, "policyDefinitions") {
-		return false
+	switch strings.ToLower(resID.ResourceType.Type) {
+	case alzlib.PolicyDefinitionsType:
+		if pd := az.PolicyDefinition(resID.Name, version); pd != nil {
+			return pd, nil
+		}
+	case alzlib.PolicySetDefinitionsType:
+		if psd := az.PolicySetDefinition(resID.Name, version); psd != nil {
+			return nil, psd
+		}
 	}
+	return nil, nil
+}
 
-	pd := az.PolicyDefinition(resID.Name, version)
-	if pd == nil || pd.Properties == nil || pd.Properties.Mode == nil {
-		return false
+// policySetDefinitionMember looks up the policy definition referenced by a member of a policy set definition.
+// Nil is returned if the policy definition cannot be found.
+func policySetDefinitionMember(ref *armpolicy.DefinitionReference, az *alzlib.AlzLib) *assets.PolicyDefinition {
+	if ref == nil || ref.PolicyDefinitionID == nil {
+		return nil
 	}
-
-	mode := strings.ToLower(*pd.Properties.Mode)
-	return mode != "all" && mode != "indexed"
+	pdName, err := assets.NameFromResourceID(*ref.PolicyDefinitionID)
+	if err != nil {
+		return nil
+	}
+	return az.PolicyDefinition(pdName, ref.DefinitionVersion)
 }
 
 func isKnown(val attr.Value) bool {
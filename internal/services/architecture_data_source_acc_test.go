@@ -1,6 +1,8 @@
 package services_test
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/Azure/terraform-provider-alz/internal/acceptance"
@@ -267,6 +269,215 @@ func TestAccAlzArchitectureDataSourceNonComplianceMessageCustomSubstitution(t *t
 	})
 }
 
+// TestAccAlzArchitectureDataSourceDefaultIdentity tests that the default identity is applied to policy assignments
+// that require an identity, and that the per management group override and policy role assignments are honoured.
+func TestAccAlzArchitectureDataSourceDefaultIdentity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.AccTestProtoV6ProviderFactoriesUnique(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"azapi": {
+				Source:            "azure/azapi",
+				VersionConstraint: "~> 2.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchitectureDataSourceConfigDefaultIdentity(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("identity_type", "UserAssigned"),
+					resource.TestCheckOutput("identity_id", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/test-override"),
+					resource.TestCheckOutput("policy_role_assignment_identity_ids", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/test-override"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAlzArchitectureDataSourceManagementGroupLocations tests that the per management group location
+// overrides the architecture location for policy assignments.
+func TestAccAlzArchitectureDataSourceManagementGroupLocations(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.AccTestProtoV6ProviderFactoriesUnique(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"azapi": {
+				Source:            "azure/azapi",
+				VersionConstraint: "~> 2.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchitectureDataSourceConfigManagementGroupLocations(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("policy_assignment_location", "westeurope"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAlzArchitectureDataSourceManagementGroupNaming tests that management group ids and display names
+// are renamed and that the renames are propagated to parent ids and policy assignments.
+func TestAccAlzArchitectureDataSourceManagementGroupNaming(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.AccTestProtoV6ProviderFactoriesUnique(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"azapi": {
+				Source:            "azure/azapi",
+				VersionConstraint: "~> 2.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchitectureDataSourceConfigManagementGroupNaming(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("management_group_ids", "sbx-child,sbx-root"),
+					resource.TestCheckOutput("child_parent_id", "sbx-root"),
+					resource.TestCheckOutput("child_display_name", "Sandbox Child"),
+					resource.TestCheckOutput("policy_assignment_scope", "/providers/Microsoft.Management/managementGroups/sbx-root"),
+					resource.TestCheckOutput("policy_assignment_not_scope", "/providers/Microsoft.Management/managementGroups/sbx-child"),
+					resource.TestCheckOutput("policy_assignment_id", "/providers/Microsoft.Management/managementGroups/sbx-root/providers/Microsoft.Authorization/policyAssignments/test-policy-assignment"),
+					resource.TestCheckOutput("policy_definition_id", "/providers/Microsoft.Management/managementGroups/sbx-root/providers/Microsoft.Authorization/policyDefinitions/test-policy-definition"),
+					resource.TestCheckOutput("child_resource_id", "/providers/Microsoft.Management/managementGroups/sbx-child"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAlzArchitectureDataSourceInlineArchitecture tests that an inline architecture definition
+// can be used instead of an architecture in the library.
+func TestAccAlzArchitectureDataSourceInlineArchitecture(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.AccTestProtoV6ProviderFactoriesUnique(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"azapi": {
+				Source:            "azure/azapi",
+				VersionConstraint: "~> 2.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchitectureDataSourceConfigInlineArchitecture(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.alz_architecture.test", "management_groups.#", "2"),
+					resource.TestCheckOutput("management_group_ids", "inline-child,inline-root"),
+					resource.TestCheckOutput("child_parent_id", "inline-root"),
+					resource.TestCheckOutput("child_exists", "true"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAlzArchitectureDataSourcePolicyExemptions tests that policy exemptions are returned
+// with full resource ids on the management group where they are created.
+func TestAccAlzArchitectureDataSourcePolicyExemptions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.AccTestProtoV6ProviderFactoriesUnique(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"azapi": {
+				Source:            "azure/azapi",
+				VersionConstraint: "~> 2.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchitectureDataSourceConfigPolicyExemptions(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("exemption_id", "/providers/Microsoft.Management/managementGroups/child/providers/Microsoft.Authorization/policyExemptions/exempt-storage"),
+					resource.TestCheckOutput("exemption_policy_assignment_id", "/providers/Microsoft.Management/managementGroups/root/providers/Microsoft.Authorization/policyAssignments/test-set-assignment"),
+					resource.TestCheckOutput("exemption_category", "Mitigated"),
+					resource.TestCheckOutput("exemption_reference_ids", "audit-storage-a"),
+					resource.TestCheckOutput("root_exemption_count", "0"),
+					resource.TestCheckOutput("exemption_dependencies", "/providers/Microsoft.Management/managementGroups/child,/providers/Microsoft.Management/managementGroups/root/providers/Microsoft.Authorization/policyAssignments/test-set-assignment"),
+					resource.TestCheckOutput("last_in_deployment_order", "/providers/Microsoft.Management/managementGroups/child/providers/Microsoft.Authorization/policyExemptions/exempt-storage"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAlzArchitectureDataSourcePolicyRemediationTargets tests that DeployIfNotExists and Modify policies,
+// including members of policy set definitions, are returned as remediation targets.
+func TestAccAlzArchitectureDataSourcePolicyRemediationTargets(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.AccTestProtoV6ProviderFactoriesUnique(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"azapi": {
+				Source:            "azure/azapi",
+				VersionConstraint: "~> 2.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchitectureDataSourceConfigPolicyRemediationTargets(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("remediation_targets", "modify-assignment/Modify,set-assignment/dine/DeployIfNotExists,set-assignment/modify/Modify"),
+					resource.TestCheckOutput("remediation_scope", "/providers/Microsoft.Management/managementGroups/root"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAlzArchitectureDataSourcePolicyAssignmentEffects tests that the effective policy effects are resolved
+// through the policy assignment, policy set definition and policy definition parameters.
+func TestAccAlzArchitectureDataSourcePolicyAssignmentEffects(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.AccTestProtoV6ProviderFactoriesUnique(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"azapi": {
+				Source:            "azure/azapi",
+				VersionConstraint: "~> 2.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchitectureDataSourceConfigPolicyAssignmentEffects(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("deny_effects", "set-assignment/audit/policy_assignment"),
+					resource.TestCheckOutput("dine_assignment_effect", "AuditIfNotExists"),
+					resource.TestCheckOutput("set_dine_effect", "AuditIfNotExists/policy_assignment"),
+					resource.TestCheckOutput("effect_count", "6"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAlzArchitectureDataSourcePolicyDefinitionChecks tests the deprecated and preview policy definition checks.
+func TestAccAlzArchitectureDataSourcePolicyDefinitionChecks(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.AccTestProtoV6ProviderFactoriesUnique(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"azapi": {
+				Source:            "azure/azapi",
+				VersionConstraint: "~> 2.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchitectureDataSourceConfigPolicyDefinitionChecks("none", "warning"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.alz_architecture.test", "management_groups.#", "1"),
+				),
+			},
+			{
+				Config:      testAccArchitectureDataSourceConfigPolicyDefinitionChecks("error", "none"),
+				ExpectError: regexp.MustCompile("reference deprecated policy definitions"),
+			},
+		},
+	})
+}
+
 // testAccArchitectureDataSourceConfigRemoteLib returns a test configuration for TestAccAlzArchetypeDataSource.
 func testAccArchitectureDataSourceConfigRemoteLib() string {
 	return `
@@ -766,3 +977,354 @@ output "policy_with_message_nc_default" {
 }
 `
 }
+
+func testAccArchitectureDataSourceConfigDefaultIdentity() string {
+	return `
+provider "alz" {
+  library_references = [
+    {
+      custom_url = "${path.root}/testdata/testacc_lib"
+    }
+  ]
+}
+
+data "azapi_client_config" "current" {}
+
+data "alz_architecture" "test" {
+  name                     = "test"
+  root_management_group_id = data.azapi_client_config.current.tenant_id
+  location                 = "northeurope"
+
+  default_identity = {
+    identity_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/test-default"
+    management_group_identity_ids = {
+      test = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/test-override"
+    }
+  }
+}
+
+locals {
+  test_policy_assignment_decoded = jsondecode(data.alz_architecture.test.management_groups[0].policy_assignments["test-policy-assignment"])
+}
+
+output "identity_type" {
+  value = local.test_policy_assignment_decoded.identity.type
+}
+
+output "identity_id" {
+  value = keys(local.test_policy_assignment_decoded.identity.userAssignedIdentities)[0]
+}
+
+output "policy_role_assignment_identity_ids" {
+  value = join(",", distinct([for pra in data.alz_architecture.test.policy_role_assignments : pra.identity_id]))
+}
+`
+}
+
+func testAccArchitectureDataSourceConfigManagementGroupLocations() string {
+	return `
+provider "alz" {
+  library_references = [
+    {
+      custom_url = "${path.root}/testdata/testacc_lib"
+    }
+  ]
+}
+
+data "azapi_client_config" "current" {}
+
+data "alz_architecture" "test" {
+  name                     = "test"
+  root_management_group_id = data.azapi_client_config.current.tenant_id
+  location                 = "northeurope"
+
+  management_group_locations = {
+    test = "westeurope"
+  }
+}
+
+output "policy_assignment_location" {
+  value = jsondecode(data.alz_architecture.test.management_groups[0].policy_assignments["test-policy-assignment"]).location
+}
+`
+}
+
+func testAccArchitectureDataSourceConfigManagementGroupNaming() string {
+	return `
+provider "alz" {
+  library_references = [
+    {
+      custom_url = "${path.root}/testdata/managementgroupnaming"
+    }
+  ]
+}
+
+data "azapi_client_config" "current" {}
+
+data "alz_architecture" "test" {
+  name                     = "test"
+  root_management_group_id = data.azapi_client_config.current.tenant_id
+  location                 = "northeurope"
+
+  management_group_naming = {
+    id_template           = "sbx-{id}"
+    display_name_template = "Sandbox {display_name}"
+  }
+}
+
+locals {
+  management_groups      = { for mg in data.alz_architecture.test.management_groups : mg.id => mg }
+  test_policy_assignment = jsondecode(local.management_groups["sbx-root"].policy_assignments["test-policy-assignment"])
+}
+
+output "management_group_ids" {
+  value = join(",", sort(keys(local.management_groups)))
+}
+
+output "child_parent_id" {
+  value = local.management_groups["sbx-child"].parent_id
+}
+
+output "child_display_name" {
+  value = local.management_groups["sbx-child"].display_name
+}
+
+output "policy_assignment_scope" {
+  value = local.test_policy_assignment.properties.scope
+}
+
+output "policy_assignment_not_scope" {
+  value = local.test_policy_assignment.properties.notScopes[0]
+}
+
+output "policy_assignment_id" {
+  value = local.management_groups["sbx-root"].policy_assignment_ids["test-policy-assignment"]
+}
+
+output "policy_definition_id" {
+  value = local.management_groups["sbx-root"].policy_definition_ids["test-policy-definition"]
+}
+
+output "child_resource_id" {
+  value = data.alz_architecture.test.management_group_resource_ids["sbx-child"]
+}
+`
+}
+
+func testAccArchitectureDataSourceConfigInlineArchitecture() string {
+	return `
+provider "alz" {
+  library_references = [
+    {
+      custom_url = "${path.root}/testdata/testacc_lib"
+    }
+  ]
+}
+
+data "azapi_client_config" "current" {}
+
+data "alz_architecture" "test" {
+  name                     = "inline"
+  root_management_group_id = data.azapi_client_config.current.tenant_id
+  location                 = "northeurope"
+
+  architecture_management_groups = [
+    {
+      id           = "inline-root"
+      display_name = "Inline root"
+      archetypes   = ["test"]
+    },
+    {
+      id           = "inline-child"
+      display_name = "Inline child"
+      parent_id    = "inline-root"
+      archetypes   = ["test"]
+      exists       = true
+    }
+  ]
+}
+
+locals {
+  management_groups = { for mg in data.alz_architecture.test.management_groups : mg.id => mg }
+}
+
+output "management_group_ids" {
+  value = join(",", sort(keys(local.management_groups)))
+}
+
+output "child_parent_id" {
+  value = local.management_groups["inline-child"].parent_id
+}
+
+output "child_exists" {
+  value = tostring(local.management_groups["inline-child"].exists)
+}
+`
+}
+
+func testAccArchitectureDataSourceConfigPolicyExemptions() string {
+	return `
+provider "alz" {
+  library_references = [
+    {
+      custom_url = "${path.root}/testdata/policyexemptions"
+    }
+  ]
+}
+
+data "azapi_client_config" "current" {}
+
+data "alz_architecture" "test" {
+  name                     = "test"
+  root_management_group_id = data.azapi_client_config.current.tenant_id
+  location                 = "northeurope"
+
+  policy_exemptions = [
+    {
+      name                            = "exempt-storage"
+      management_group_id             = "child"
+      policy_assignment_name          = "test-set-assignment"
+      exemption_category              = "Mitigated"
+      display_name                    = "Storage accounts are audited elsewhere"
+      expires_on                      = "2099-12-31T00:00:00Z"
+      policy_definition_reference_ids = ["audit-storage-a"]
+    }
+  ]
+}
+
+locals {
+  management_groups = { for mg in data.alz_architecture.test.management_groups : mg.id => mg }
+  exemption         = jsondecode(local.management_groups["child"].policy_exemptions["exempt-storage"])
+}
+
+output "exemption_id" {
+  value = local.exemption.id
+}
+
+output "exemption_policy_assignment_id" {
+  value = local.exemption.properties.policyAssignmentId
+}
+
+output "exemption_category" {
+  value = local.exemption.properties.exemptionCategory
+}
+
+output "exemption_reference_ids" {
+  value = join(",", local.exemption.properties.policyDefinitionReferenceIds)
+}
+
+output "root_exemption_count" {
+  value = tostring(length(local.management_groups["root"].policy_exemptions))
+}
+
+output "exemption_dependencies" {
+  value = join(",", sort([for d in data.alz_architecture.test.asset_dependencies : d.depends_on_resource_id if d.resource_id == local.exemption.id]))
+}
+
+output "last_in_deployment_order" {
+  value = element(data.alz_architecture.test.deployment_order, length(data.alz_architecture.test.deployment_order) - 1)
+}
+`
+}
+
+func testAccArchitectureDataSourceConfigPolicyRemediationTargets() string {
+	return `
+provider "alz" {
+  library_references = [
+    {
+      custom_url = "${path.root}/testdata/policyeffects"
+    }
+  ]
+}
+
+data "azapi_client_config" "current" {}
+
+data "alz_architecture" "test" {
+  name                     = "test"
+  root_management_group_id = data.azapi_client_config.current.tenant_id
+  location                 = "northeurope"
+}
+
+output "remediation_targets" {
+  value = join(",", [for t in data.alz_architecture.test.policy_remediation_targets : join("/", compact([t.policy_assignment_name, t.policy_definition_reference_id, t.effect]))])
+}
+
+output "remediation_scope" {
+  value = data.alz_architecture.test.policy_remediation_targets[0].scope
+}
+`
+}
+
+func testAccArchitectureDataSourceConfigPolicyAssignmentEffects() string {
+	return `
+provider "alz" {
+  library_references = [
+    {
+      custom_url = "${path.root}/testdata/policyeffects"
+    }
+  ]
+}
+
+data "azapi_client_config" "current" {}
+
+data "alz_architecture" "test" {
+  name                     = "test"
+  root_management_group_id = data.azapi_client_config.current.tenant_id
+  location                 = "northeurope"
+
+  policy_assignments_to_modify = {
+    root = {
+      policy_assignments = {
+        set-assignment = {
+          parameters = {
+            dineEffect = jsonencode({ value = "AuditIfNotExists" })
+          }
+        }
+      }
+    }
+  }
+}
+
+output "deny_effects" {
+  value = join(",", [for e in data.alz_architecture.test.policy_assignment_effects : "${e.policy_assignment_name}/${e.policy_definition_reference_id}/${e.effect_source}" if e.effect == "Deny"])
+}
+
+output "dine_assignment_effect" {
+  value = one([for e in data.alz_architecture.test.policy_assignment_effects : e.effect if e.policy_assignment_name == "dine-assignment"])
+}
+
+output "set_dine_effect" {
+  value = one([for e in data.alz_architecture.test.policy_assignment_effects : "${e.effect}/${e.effect_source}" if e.policy_assignment_name == "set-assignment" && e.policy_definition_reference_id == "dine"])
+}
+
+output "effect_count" {
+  value = tostring(length(data.alz_architecture.test.policy_assignment_effects))
+}
+`
+}
+
+// testAccArchitectureDataSourceConfigPolicyDefinitionChecks returns a test configuration for TestAccAlzArchitectureDataSourcePolicyDefinitionChecks.
+func testAccArchitectureDataSourceConfigPolicyDefinitionChecks(deprecated, preview string) string {
+	return fmt.Sprintf(`
+provider "alz" {
+  library_references = [
+    {
+      custom_url = "${path.root}/testdata/definitionchecks"
+    }
+  ]
+}
+
+data "azapi_client_config" "current" {}
+
+data "alz_architecture" "test" {
+  name                     = "test"
+  root_management_group_id = data.azapi_client_config.current.tenant_id
+  location                 = "northeurope"
+
+  policy_definition_checks = {
+    deprecated = %q
+    preview    = %q
+  }
+}
+`, deprecated, preview)
+}
@@ -1,21 +1,28 @@
 package services
 
 import (
+	"context"
+	"errors"
+	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/alzlib"
 	"github.com/Azure/alzlib/assets"
 	"github.com/Azure/alzlib/deployment"
 	"github.com/Azure/alzlib/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armpolicy"
+	"github.com/Azure/terraform-provider-alz/internal/clients"
 	"github.com/Azure/terraform-provider-alz/internal/gen"
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -318,12 +325,12 @@ func TestPolicyAssignmentType2ArmPolicyValues(t *testing.T) {
 func TestPolicyRoleAssignmentsSetToProviderType(t *testing.T) {
 	ctx := t.Context()
 	// Test with nil input
-	res, diags := policyRoleAssignmentsSetToProviderType(ctx, nil)
+	res, diags := policyRoleAssignmentsSetToProviderType(ctx, nil, nil)
 	assert.False(t, diags.HasError())
 	assert.Empty(t, len(res.Elements()))
 
 	// Test with empty input
-	res, diags = policyRoleAssignmentsSetToProviderType(ctx, make([]deployment.PolicyRoleAssignment, 0))
+	res, diags = policyRoleAssignmentsSetToProviderType(ctx, make([]deployment.PolicyRoleAssignment, 0), nil)
 	assert.False(t, diags.HasError())
 	assert.Empty(t, len(res.Elements()))
 
@@ -335,7 +342,7 @@ func TestPolicyRoleAssignmentsSetToProviderType(t *testing.T) {
 			AssignmentName:   "test1",
 		},
 	)
-	res, _ = policyRoleAssignmentsSetToProviderType(ctx, src.ToSlice())
+	res, _ = policyRoleAssignmentsSetToProviderType(ctx, src.ToSlice(), nil)
 	assert.NotNil(t, res)
 	assert.Len(t, res.Elements(), src.Cardinality())
 	for _, v := range res.Elements() {
@@ -346,9 +353,593 @@ func TestPolicyRoleAssignmentsSetToProviderType(t *testing.T) {
 			AssignmentName:   praval.PolicyAssignmentName.ValueString(),
 		}
 		assert.True(t, src.Contains(setMember))
+		assert.True(t, praval.IdentityId.IsNull())
+	}
+
+	// Test with user assigned identity ids
+	uami := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/test-identity"
+	src = mapset.NewThreadUnsafeSet[deployment.PolicyRoleAssignment](
+		deployment.PolicyRoleAssignment{
+			RoleDefinitionID:  "test1",
+			Scope:             "test1",
+			AssignmentName:    "test1",
+			ManagementGroupID: "mg1",
+		},
+		deployment.PolicyRoleAssignment{
+			RoleDefinitionID:  "test2",
+			Scope:             "test2",
+			AssignmentName:    "test2",
+			ManagementGroupID: "mg1",
+		},
+	)
+	res, diags = policyRoleAssignmentsSetToProviderType(ctx, src.ToSlice(), map[string]map[string]string{"mg1": {"test1": uami}})
+	assert.False(t, diags.HasError())
+	assert.Len(t, res.Elements(), src.Cardinality())
+	for _, v := range res.Elements() {
+		praval := v.(gen.PolicyRoleAssignmentsValue) //nolint:forcetypeassert
+		switch praval.PolicyAssignmentName.ValueString() {
+		case "test1":
+			assert.Equal(t, uami, praval.IdentityId.ValueString())
+		default:
+			assert.True(t, praval.IdentityId.IsNull())
+		}
+	}
+}
+
+// TestPolicyAssignmentRequiresIdentity tests the detection of policy assignments that need a managed identity.
+func TestPolicyAssignmentRequiresIdentity(t *testing.T) {
+	az := alzlib.NewAlzLib(nil)
+
+	dinePd := armpolicy.Definition{
+		Name: to.Ptr("dine-def"),
+		Properties: &armpolicy.DefinitionProperties{
+			Mode: to.Ptr("All"),
+			PolicyRule: map[string]any{
+				"if": map[string]any{"field": "type", "equals": "Microsoft.Storage/storageAccounts"},
+				"then": map[string]any{
+					"effect": "deployIfNotExists",
+					"details": map[string]any{
+						"roleDefinitionIds": []any{
+							"/providers/Microsoft.Authorization/roleDefinitions/b24988ac-6180-42a0-ab88-20f7382dd24c",
+						},
+					},
+				},
+			},
+		},
+	}
+	auditPd := armpolicy.Definition{
+		Name: to.Ptr("audit-def"),
+		Properties: &armpolicy.DefinitionProperties{
+			Mode: to.Ptr("All"),
+			PolicyRule: map[string]any{
+				"if":   map[string]any{"field": "type", "equals": "Microsoft.Storage/storageAccounts"},
+				"then": map[string]any{"effect": "audit"},
+			},
+		},
+	}
+	assert.NoError(t, az.AddPolicyDefinitions(assets.NewPolicyDefinition(dinePd), assets.NewPolicyDefinition(auditPd)))
+
+	dinePsd := armpolicy.SetDefinition{
+		Name: to.Ptr("dine-set"),
+		Properties: &armpolicy.SetDefinitionProperties{
+			PolicyDefinitions: []*armpolicy.DefinitionReference{
+				{
+					PolicyDefinitionID:          to.Ptr("/providers/Microsoft.Authorization/policyDefinitions/audit-def"),
+					PolicyDefinitionReferenceID: to.Ptr("audit"),
+				},
+				{
+					PolicyDefinitionID:          to.Ptr("/providers/Microsoft.Authorization/policyDefinitions/dine-def"),
+					PolicyDefinitionReferenceID: to.Ptr("dine"),
+				},
+			},
+		},
+	}
+	auditPsd := armpolicy.SetDefinition{
+		Name: to.Ptr("audit-set"),
+		Properties: &armpolicy.SetDefinitionProperties{
+			PolicyDefinitions: []*armpolicy.DefinitionReference{
+				{
+					PolicyDefinitionID:          to.Ptr("/providers/Microsoft.Authorization/policyDefinitions/audit-def"),
+					PolicyDefinitionReferenceID: to.Ptr("audit"),
+				},
+			},
+		},
+	}
+	assert.NoError(t, az.AddPolicySetDefinitions(assets.NewPolicySetDefinition(dinePsd), assets.NewPolicySetDefinition(auditPsd)))
+
+	testCases := []struct {
+		name     string
+		pa       *assets.PolicyAssignment
+		expected bool
+	}{
+		{
+			name: "Existing system assigned identity",
+			pa: assets.NewPolicyAssignment(armpolicy.Assignment{
+				Identity: &armpolicy.Identity{Type: to.Ptr(armpolicy.ResourceIdentityTypeSystemAssigned)},
+				Properties: &armpolicy.AssignmentProperties{
+					PolicyDefinitionID: to.Ptr("/providers/Microsoft.Authorization/policyDefinitions/audit-def"),
+				},
+			}),
+			expected: true,
+		},
+		{
+			name: "DeployIfNotExists policy definition",
+			pa: assets.NewPolicyAssignment(armpolicy.Assignment{
+				Properties: &armpolicy.AssignmentProperties{
+					PolicyDefinitionID: to.Ptr("/providers/Microsoft.Authorization/policyDefinitions/dine-def"),
+				},
+			}),
+			expected: true,
+		},
+		{
+			name: "Audit policy definition",
+			pa: assets.NewPolicyAssignment(armpolicy.Assignment{
+				Identity: &armpolicy.Identity{Type: to.Ptr(armpolicy.ResourceIdentityTypeNone)},
+				Properties: &armpolicy.AssignmentProperties{
+					PolicyDefinitionID: to.Ptr("/providers/Microsoft.Authorization/policyDefinitions/audit-def"),
+				},
+			}),
+			expected: false,
+		},
+		{
+			name: "Policy set definition with DeployIfNotExists member",
+			pa: assets.NewPolicyAssignment(armpolicy.Assignment{
+				Properties: &armpolicy.AssignmentProperties{
+					PolicyDefinitionID: to.Ptr("/providers/Microsoft.Authorization/policySetDefinitions/dine-set"),
+				},
+			}),
+			expected: true,
+		},
+		{
+			name: "Policy set definition without DeployIfNotExists member",
+			pa: assets.NewPolicyAssignment(armpolicy.Assignment{
+				Properties: &armpolicy.AssignmentProperties{
+					PolicyDefinitionID: to.Ptr("/providers/Microsoft.Authorization/policySetDefinitions/audit-set"),
+				},
+			}),
+			expected: false,
+		},
+		{
+			name: "Unknown definition",
+			pa: assets.NewPolicyAssignment(armpolicy.Assignment{
+				Properties: &armpolicy.AssignmentProperties{
+					PolicyDefinitionID: to.Ptr("/providers/Microsoft.Authorization/policyDefinitions/nonexistent-def"),
+				},
+			}),
+			expected: false,
+		},
+		{
+			name: "Nil properties",
+			pa: assets.NewPolicyAssignment(armpolicy.Assignment{
+				Properties: nil,
+			}),
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, policyAssignmentRequiresIdentity(tc.pa, az))
+		})
 	}
 }
 
+func TestPolicyAssignmentParameterIsLocation(t *testing.T) {
+	az := alzlib.NewAlzLib(nil)
+
+	params := map[string]*armpolicy.ParameterDefinitionsValue{
+		"location": {
+			Type:     to.Ptr(armpolicy.ParameterTypeString),
+			Metadata: &armpolicy.ParameterDefinitionsValueMetadata{StrongType: to.Ptr("location")},
+		},
+		"workspaceName": {
+			Type: to.Ptr(armpolicy.ParameterTypeString),
+		},
+	}
+	pd := armpolicy.Definition{
+		Name: to.Ptr("location-def"),
+		Properties: &armpolicy.DefinitionProperties{
+			Mode:       to.Ptr("All"),
+			Parameters: params,
+			PolicyRule: map[string]any{
+				"if":   map[string]any{"field": "type", "equals": "Microsoft.Storage/storageAccounts"},
+				"then": map[string]any{"effect": "audit"},
+			},
+		},
+	}
+	assert.NoError(t, az.AddPolicyDefinitions(assets.NewPolicyDefinition(pd)))
+
+	psd := armpolicy.SetDefinition{
+		Name: to.Ptr("location-set"),
+		Properties: &armpolicy.SetDefinitionProperties{
+			Parameters: params,
+			PolicyDefinitions: []*armpolicy.DefinitionReference{
+				{
+					PolicyDefinitionID:          to.Ptr("/providers/Microsoft.Authorization/policyDefinitions/location-def"),
+					PolicyDefinitionReferenceID: to.Ptr("location"),
+				},
+			},
+		},
+	}
+	assert.NoError(t, az.AddPolicySetDefinitions(assets.NewPolicySetDefinition(psd)))
+
+	testCases := []struct {
+		name     string
+		defID    string
+		param    string
+		expected bool
+	}{
+		{
+			name:     "Policy definition location parameter",
+			defID:    "/providers/Microsoft.Authorization/policyDefinitions/location-def",
+			param:    "location",
+			expected: true,
+		},
+		{
+			name:     "Policy definition non-location parameter",
+			defID:    "/providers/Microsoft.Authorization/policyDefinitions/location-def",
+			param:    "workspaceName",
+			expected: false,
+		},
+		{
+			name:     "Policy set definition location parameter",
+			defID:    "/providers/Microsoft.Authorization/policySetDefinitions/location-set",
+			param:    "location",
+			expected: true,
+		},
+		{
+			name:     "Missing parameter",
+			defID:    "/providers/Microsoft.Authorization/policySetDefinitions/location-set",
+			param:    "nonexistent",
+			expected: false,
+		},
+		{
+			name:     "Unknown definition",
+			defID:    "/providers/Microsoft.Authorization/policyDefinitions/nonexistent-def",
+			param:    "location",
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pa := assets.NewPolicyAssignment(armpolicy.Assignment{
+				Properties: &armpolicy.AssignmentProperties{
+					PolicyDefinitionID: to.Ptr(tc.defID),
+				},
+			})
+			assert.Equal(t, tc.expected, policyAssignmentParameterIsLocation(pa, az, tc.param))
+		})
+	}
+}
+
+func TestArchitectureHierarchyPolicyAssignmentMap(t *testing.T) {
+	_, depl := newPolicyEffectsTestHierarchy(t)
+	mg := depl.ManagementGroup("root")
+
+	h := &ArchitectureHierarchy{Hierarchy: depl}
+	for name, pa := range h.PolicyAssignmentMap(mg) {
+		assert.Equal(t, "northeurope", *pa.Location, name)
+	}
+
+	h.mgLocations = map[string]string{"root": "westeurope"}
+	for name, pa := range h.PolicyAssignmentMap(mg) {
+		assert.Equal(t, "westeurope", *pa.Location, name)
+	}
+	// The hierarchy is not modified, so the result does not depend on the order of the calls.
+	for name, pa := range mg.PolicyAssignmentMap() {
+		assert.Equal(t, "northeurope", *pa.Location, name)
+	}
+}
+
+func TestManagementGroupNamingRename(t *testing.T) {
+	lib := libArchitecture{
+		Name: "test",
+		ManagementGroups: []libArchitectureManagementGroup{
+			{ID: "child", DisplayName: "Child", Archetypes: []string{"child"}, ParentID: to.Ptr("root")},
+			{ID: "root", DisplayName: "Root", Archetypes: []string{"root"}},
+		},
+	}
+
+	t.Run("Templates", func(t *testing.T) {
+		naming := managementGroupNaming{
+			idTemplate:          "sbx-{id}",
+			displayNameTemplate: "Sandbox {display_name}",
+		}
+		res, renames, err := naming.rename(lib)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"child": "sbx-child", "root": "sbx-root"}, renames)
+		assert.Equal(t, "sbx-child", res.ManagementGroups[0].ID)
+		assert.Equal(t, "Sandbox Child", res.ManagementGroups[0].DisplayName)
+		assert.Equal(t, "sbx-root", *res.ManagementGroups[0].ParentID)
+		assert.Nil(t, res.ManagementGroups[1].ParentID)
+		assert.Equal(t, "child", lib.ManagementGroups[0].ID, "input must not be modified")
+	})
+
+	t.Run("Explicit values take precedence", func(t *testing.T) {
+		naming := managementGroupNaming{
+			idTemplate:          "sbx-{id}",
+			displayNameTemplate: "Sandbox {display_name}",
+			ids:                 map[string]string{"root": "tenant-root"},
+			displayNames:        map[string]string{"child": "Landing zones"},
+		}
+		res, renames, err := naming.rename(lib)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"child": "sbx-child", "root": "tenant-root"}, renames)
+		assert.Equal(t, "Landing zones", res.ManagementGroups[0].DisplayName)
+		assert.Equal(t, "tenant-root", *res.ManagementGroups[0].ParentID)
+		assert.Equal(t, "Sandbox Root", res.ManagementGroups[1].DisplayName)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		naming := managementGroupNaming{
+			idTemplate: "sbx/{id}",
+		}
+		_, _, err := naming.rename(lib)
+		assert.ErrorContains(t, err, "not a valid management group id")
+	})
+
+	t.Run("Duplicate id", func(t *testing.T) {
+		naming := managementGroupNaming{
+			ids: map[string]string{"child": "ROOT"},
+		}
+		_, _, err := naming.rename(lib)
+		assert.ErrorContains(t, err, "are both renamed to")
+	})
+
+	t.Run("Unknown keys", func(t *testing.T) {
+		naming := managementGroupNaming{
+			ids:          map[string]string{"root": "tenant-root", "missing1": "x"},
+			displayNames: map[string]string{"missing2": "x", "missing1": "y"},
+		}
+		assert.Equal(t, []string{"missing1", "missing2"}, naming.unknownKeys(lib))
+	})
+}
+
+func TestRenameManagementGroupScopes(t *testing.T) {
+	renames := map[string]string{"child": "sbx-child"}
+
+	res, changed := renameManagementGroupScopes([]*string{
+		to.Ptr("/providers/Microsoft.Management/managementGroups/child"),
+		to.Ptr("/providers/microsoft.management/managementgroups/other"),
+		to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000"),
+		nil,
+	}, renames)
+	assert.True(t, changed)
+	assert.Equal(t, "/providers/Microsoft.Management/managementGroups/sbx-child", *res[0])
+	assert.Equal(t, "/providers/microsoft.management/managementgroups/other", *res[1])
+	assert.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000", *res[2])
+	assert.Nil(t, res[3])
+
+	_, changed = renameManagementGroupScopes([]*string{to.Ptr("/providers/Microsoft.Management/managementGroups/other")}, renames)
+	assert.False(t, changed)
+}
+
+func TestRenameArchitecture(t *testing.T) {
+	ctx := t.Context()
+	az := alzlib.NewAlzLib(nil)
+	assert.NoError(t, az.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/managementgroupnaming", os.DirFS("testdata/managementgroupnaming"))))
+	client := clients.NewClient(clients.WithAlzLib(az))
+
+	naming, diags := gen.NewManagementGroupNamingValue(
+		gen.NewManagementGroupNamingValueNull().AttributeTypes(ctx),
+		map[string]attr.Value{
+			"id_template":           types.StringValue("sbx-{id}"),
+			"display_name_template": types.StringNull(),
+			"ids":                   types.MapNull(types.StringType),
+			"display_names":         types.MapValueMust(types.StringType, map[string]attr.Value{"root": types.StringValue("Sandbox")}),
+		},
+	)
+	assert.False(t, diags.HasError())
+
+	resp := &datasource.ReadResponse{}
+	archName, renames := renameArchitecture(ctx, client, "test", naming, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.NotEqual(t, "test", archName)
+	assert.Equal(t, map[string]string{"root": "sbx-root", "child": "sbx-child"}, renames)
+
+	// The same naming must reuse the generated architecture
+	archName2, _ := renameArchitecture(ctx, client, "test", naming, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, archName, archName2)
+
+	depl := deployment.NewHierarchy(az)
+	assert.NoError(t, depl.FromArchitecture(ctx, archName, "00000000-0000-0000-0000-000000000000", "northeurope"))
+	renamePolicyAssignmentNotScopes(depl, renames, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	assert.ElementsMatch(t, []string{"sbx-root", "sbx-child"}, depl.ManagementGroupNames())
+	root := depl.ManagementGroup("sbx-root")
+	assert.Equal(t, "Sandbox", root.DisplayName())
+	assert.Equal(t, "sbx-root", depl.ManagementGroup("sbx-child").ParentID())
+	pa := root.PolicyAssignmentMap()["test-policy-assignment"]
+	assert.Equal(t, "/providers/Microsoft.Management/managementGroups/sbx-root", *pa.Properties.Scope)
+	assert.Equal(t, "/providers/Microsoft.Management/managementGroups/sbx-root/providers/Microsoft.Authorization/policyDefinitions/test-policy-definition", *pa.Properties.PolicyDefinitionID)
+	assert.Equal(t, "/providers/Microsoft.Management/managementGroups/sbx-child", *pa.Properties.NotScopes[0])
+
+	// Without naming the original architecture is used
+	archName, renames = renameArchitecture(ctx, client, "test", gen.NewManagementGroupNamingValueNull(), resp)
+	assert.Equal(t, "test", archName)
+	assert.Nil(t, renames)
+}
+
+func TestInlineArchitecture(t *testing.T) {
+	ctx := t.Context()
+	az := alzlib.NewAlzLib(nil)
+	assert.NoError(t, az.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/managementgroupnaming", os.DirFS("testdata/managementgroupnaming"))))
+	client := clients.NewClient(clients.WithAlzLib(az))
+
+	newMgList := func(mgs ...map[string]attr.Value) types.List {
+		vals := make([]attr.Value, len(mgs))
+		for i, mg := range mgs {
+			vals[i] = gen.NewArchitectureManagementGroupsValueMust(gen.NewArchitectureManagementGroupsValueNull().AttributeTypes(ctx), mg)
+		}
+		return types.ListValueMust(gen.NewArchitectureManagementGroupsValueNull().Type(ctx), vals)
+	}
+	newMg := func(id, parentID, archetype string) map[string]attr.Value {
+		parent := types.StringNull()
+		if parentID != "" {
+			parent = types.StringValue(parentID)
+		}
+		return map[string]attr.Value{
+			"id":           types.StringValue(id),
+			"display_name": types.StringValue(strings.ToUpper(id)),
+			"parent_id":    parent,
+			"archetypes":   types.SetValueMust(types.StringType, []attr.Value{types.StringValue(archetype)}),
+			"exists":       types.BoolNull(),
+		}
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		resp := &datasource.ReadResponse{}
+		archName := inlineArchitecture(ctx, client, "inline", newMgList(newMg("top", "", "root"), newMg("bottom", "top", "child")), resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.True(t, strings.HasPrefix(archName, "inline_"))
+
+		depl := deployment.NewHierarchy(az)
+		assert.NoError(t, depl.FromArchitecture(ctx, archName, "00000000-0000-0000-0000-000000000000", "northeurope"))
+		assert.ElementsMatch(t, []string{"top", "bottom"}, depl.ManagementGroupNames())
+		assert.Equal(t, "top", depl.ManagementGroup("bottom").ParentID())
+		assert.Equal(t, "TOP", depl.ManagementGroup("top").DisplayName())
+		assert.Contains(t, depl.ManagementGroup("top").PolicyAssignmentMap(), "test-policy-assignment")
+
+		// The same definition must reuse the generated architecture
+		archName2 := inlineArchitecture(ctx, client, "inline", newMgList(newMg("top", "", "root"), newMg("bottom", "top", "child")), resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, archName, archName2)
+	})
+
+	t.Run("Unknown archetype", func(t *testing.T) {
+		resp := &datasource.ReadResponse{}
+		inlineArchitecture(ctx, client, "inline", newMgList(newMg("top", "", "nonexistent")), resp)
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+
+	t.Run("Unknown parent", func(t *testing.T) {
+		resp := &datasource.ReadResponse{}
+		inlineArchitecture(ctx, client, "inline", newMgList(newMg("top", "", "root"), newMg("bottom", "nonexistent", "child")), resp)
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
+
+func TestPolicyExemptions(t *testing.T) {
+	ctx := t.Context()
+	az := alzlib.NewAlzLib(nil)
+	assert.NoError(t, az.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/policyexemptions", os.DirFS("testdata/policyexemptions"))))
+	depl := deployment.NewHierarchy(az)
+	assert.NoError(t, depl.FromArchitecture(ctx, "test", "00000000-0000-0000-0000-000000000000", "northeurope"))
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newExemption := func(name, mgID, paName, expiresOn string, refIds ...string) map[string]attr.Value {
+		expires := types.StringNull()
+		if expiresOn != "" {
+			expires = types.StringValue(expiresOn)
+		}
+		refIdVals := make([]attr.Value, len(refIds))
+		for i, refId := range refIds {
+			refIdVals[i] = types.StringValue(refId)
+		}
+		return map[string]attr.Value{
+			"name":                            types.StringValue(name),
+			"management_group_id":             types.StringValue(mgID),
+			"policy_assignment_name":          types.StringValue(paName),
+			"exemption_category":              types.StringValue("Waiver"),
+			"display_name":                    types.StringValue("Test exemption"),
+			"description":                     types.StringNull(),
+			"expires_on":                      expires,
+			"policy_definition_reference_ids": types.SetValueMust(types.StringType, refIdVals),
+		}
+	}
+	newExemptionList := func(exemptions ...map[string]attr.Value) types.List {
+		vals := make([]attr.Value, len(exemptions))
+		for i, pe := range exemptions {
+			vals[i] = gen.NewPolicyExemptionsValueMust(gen.NewPolicyExemptionsValueNull().AttributeTypes(ctx), pe)
+		}
+		return types.ListValueMust(gen.NewPolicyExemptionsValueNull().Type(ctx), vals)
+	}
+
+	t.Run("Null", func(t *testing.T) {
+		resp := &datasource.ReadResponse{}
+		res := policyExemptions(ctx, depl, az, types.ListNull(gen.NewPolicyExemptionsValueNull().Type(ctx)), now, resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Inherited assignment", func(t *testing.T) {
+		resp := &datasource.ReadResponse{}
+		res := policyExemptions(ctx, depl, az, newExemptionList(newExemption("exempt-child", "child", "test-set-assignment", "2025-06-30T00:00:00Z", "audit-storage-b")), now, resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Len(t, resp.Diagnostics.Warnings(), 0)
+		pe := res["child"]["exempt-child"]
+		if !assert.NotNil(t, pe) {
+			return
+		}
+		assert.Equal(t, "/providers/Microsoft.Management/managementGroups/child/providers/Microsoft.Authorization/policyExemptions/exempt-child", *pe.ID)
+		assert.Equal(t, "Microsoft.Authorization/policyExemptions", *pe.Type)
+		assert.Equal(t, "/providers/Microsoft.Management/managementGroups/root/providers/Microsoft.Authorization/policyAssignments/test-set-assignment", *pe.Properties.PolicyAssignmentID)
+		assert.Equal(t, armpolicy.ExemptionCategoryWaiver, *pe.Properties.ExemptionCategory)
+		assert.Equal(t, time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC), *pe.Properties.ExpiresOn)
+		assert.Equal(t, []*string{to.Ptr("audit-storage-b")}, pe.Properties.PolicyDefinitionReferenceIDs)
+		assert.Equal(t, "Test exemption", *pe.Properties.DisplayName)
+		assert.Nil(t, pe.Properties.Description)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		resp := &datasource.ReadResponse{}
+		res := policyExemptions(ctx, depl, az, newExemptionList(newExemption("exempt-root", "root", "test-policy-assignment", "2024-12-31T00:00:00Z")), now, resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Len(t, resp.Diagnostics.Warnings(), 1)
+		assert.Contains(t, res["root"], "exempt-root")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		testCases := map[string]types.List{
+			"Unknown management group":   newExemptionList(newExemption("e", "nonexistent", "test-policy-assignment", "")),
+			"Assignment not in scope":    newExemptionList(newExemption("e", "root", "nonexistent", "")),
+			"Reference id not in set":    newExemptionList(newExemption("e", "root", "test-set-assignment", "", "nonexistent")),
+			"Reference id without a set": newExemptionList(newExemption("e", "root", "test-policy-assignment", "", "audit-storage-a")),
+			"Duplicate name":             newExemptionList(newExemption("e", "root", "test-policy-assignment", ""), newExemption("e", "root", "test-set-assignment", "")),
+		}
+		for name, src := range testCases {
+			t.Run(name, func(t *testing.T) {
+				resp := &datasource.ReadResponse{}
+				policyExemptions(ctx, depl, az, src, now, resp)
+				assert.True(t, resp.Diagnostics.HasError())
+			})
+		}
+	})
+}
+
+func TestAlzMgToProviderTypeResourceIds(t *testing.T) {
+	ctx := t.Context()
+	az := alzlib.NewAlzLib(nil)
+	assert.NoError(t, az.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/policyexemptions", os.DirFS("testdata/policyexemptions"))))
+	depl := deployment.NewHierarchy(az)
+	assert.NoError(t, depl.FromArchitecture(ctx, "test", "00000000-0000-0000-0000-000000000000", "northeurope"))
+
+	exemptions := map[string]*armpolicy.Exemption{
+		"test-exemption": {ID: to.Ptr("/providers/Microsoft.Management/managementGroups/root/providers/Microsoft.Authorization/policyExemptions/test-exemption")},
+		"no-id":          {},
+	}
+	mgVal, diags := alzMgToProviderType(ctx, depl.ManagementGroup("root"), depl.ManagementGroup("root").PolicyAssignmentMap(), exemptions)
+	assert.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, map[string]attr.Value{
+		"test-policy-assignment": types.StringValue("/providers/Microsoft.Management/managementGroups/root/providers/Microsoft.Authorization/policyAssignments/test-policy-assignment"),
+		"test-set-assignment":    types.StringValue("/providers/Microsoft.Management/managementGroups/root/providers/Microsoft.Authorization/policyAssignments/test-set-assignment"),
+	}, mgVal.PolicyAssignmentIds.Elements())
+	assert.Equal(t, map[string]attr.Value{
+		"test-policy-definition": types.StringValue("/providers/Microsoft.Management/managementGroups/root/providers/Microsoft.Authorization/policyDefinitions/test-policy-definition"),
+	}, mgVal.PolicyDefinitionIds.Elements())
+	assert.Equal(t, map[string]attr.Value{
+		"test-policy-set-definition": types.StringValue("/providers/Microsoft.Management/managementGroups/root/providers/Microsoft.Authorization/policySetDefinitions/test-policy-set-definition"),
+	}, mgVal.PolicySetDefinitionIds.Elements())
+	assert.Empty(t, mgVal.RoleDefinitionIds.Elements())
+	assert.Equal(t, map[string]attr.Value{
+		"test-exemption": types.StringValue("/providers/Microsoft.Management/managementGroups/root/providers/Microsoft.Authorization/policyExemptions/test-exemption"),
+	}, mgVal.PolicyExemptionIds.Elements())
+}
+
 // TestEnforcementModeReplacement tests the {enforcementMode} placeholder replacement logic.
 func TestEnforcementModeReplacement(t *testing.T) {
 	testCases := []struct {
@@ -542,3 +1133,33 @@ func TestIsResourceProviderModePolicyDefinitionAssignment(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildArchitectureHierarchySaveCacheWarning(t *testing.T) {
+	ctx := t.Context()
+	az := alzlib.NewAlzLib(nil)
+	assert.NoError(t, az.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/managementgroupnaming", os.DirFS("testdata/managementgroupnaming"))))
+	client := clients.NewClient(clients.WithAlzLib(az), clients.WithSaveBuiltInCache(func(context.Context, []alzlib.BuiltInRequest) error {
+		return errors.New("disk full")
+	}))
+
+	// The cache file warning names the caller, as it is not about the configuration.
+	var schemaResp datasource.SchemaResponse
+	NewArchitectureDataSource().Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	vals := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	vals["name"] = tftypes.NewValue(tftypes.String, "test")
+	vals["root_management_group_id"] = tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000000")
+	vals["location"] = tftypes.NewValue(tftypes.String, "northeurope")
+	var data gen.ArchitectureModel
+	diags := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, vals)}.Get(ctx, &data)
+	assert.False(t, diags.HasError(), diags)
+
+	h, diags := BuildArchitectureHierarchy(ctx, client, data)
+	assert.NotNil(t, h)
+	assert.Equal(t, diag.Diagnostics{
+		diag.NewWarningDiagnostic("BuildArchitectureHierarchy() Warning saving cache file", "disk full"),
+	}, diags)
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/alzlib/deployment"
+	"github.com/Azure/terraform-provider-alz/internal/gen"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// The severities of the policy definition checks.
+const (
+	definitionCheckSeverityNone    = "none"
+	definitionCheckSeverityWarning = "warning"
+	definitionCheckSeverityError   = "error"
+)
+
+// definitionStatus is the status of a policy (set) definition, read from its metadata.
+type definitionStatus struct {
+	Deprecated   bool
+	Preview      bool
+	SupersededBy string
+}
+
+// definitionStatusReference is a reference from a policy assignment to a deprecated or preview definition.
+type definitionStatusReference struct {
+	ManagementGroupId           string
+	PolicyAssignmentName        string
+	PolicyDefinitionReferenceId string
+	DefinitionId                string
+	definitionStatus
+}
+
+// definitionMetadataStatus returns the status from the metadata of a policy (set) definition.
+// The `deprecated` and `preview` properties must be booleans, the `supersededBy` property is the name or
+// resource id of the successor definition.
+func definitionMetadataStatus(metadata any) definitionStatus {
+	m, ok := metadata.(map[string]any)
+	if !ok {
+		return definitionStatus{}
+	}
+	var res definitionStatus
+	res.Deprecated, _ = m["deprecated"].(bool)
+	res.Preview, _ = m["preview"].(bool)
+	res.SupersededBy, _ = m["supersededBy"].(string)
+	return res
+}
+
+// definitionStatusReferences returns the references from the policy assignments in the hierarchy to deprecated
+// or preview definitions, including the members of assigned policy set definitions.
+// The references are sorted by management group, policy assignment and then in policy set definition member order.
+func definitionStatusReferences(depl *deployment.Hierarchy, az *alzlib.AlzLib) []definitionStatusReference {
+	var res []definitionStatusReference
+	add := func(mgName, paName, refId, defId string, name *string, metadata any) {
+		status := definitionMetadataStatus(metadata)
+		if !status.Deprecated && !status.Preview {
+			return
+		}
+		if defId == "" && name != nil {
+			defId = *name
+		}
+		res = append(res, definitionStatusReference{
+			ManagementGroupId:           mgName,
+			PolicyAssignmentName:        paName,
+			PolicyDefinitionReferenceId: refId,
+			DefinitionId:                defId,
+			definitionStatus:            status,
+		})
+	}
+
+	mgNames := depl.ManagementGroupNames()
+	slices.Sort(mgNames)
+	for _, mgName := range mgNames {
+		pas := depl.ManagementGroup(mgName).PolicyAssignmentMap()
+		paNames := make([]string, 0, len(pas))
+		for paName := range pas {
+			paNames = append(paNames, paName)
+		}
+		slices.Sort(paNames)
+		for _, paName := range paNames {
+			pa := pas[paName]
+			if pa.Properties == nil || pa.Properties.PolicyDefinitionID == nil {
+				continue
+			}
+			pd, psd := policyAssignmentDefinitions(pa, az)
+			if deployed := hierarchyPolicySetDefinition(depl, pa); deployed != nil {
+				psd = deployed
+			}
+			if pd != nil && pd.Properties != nil {
+				add(mgName, paName, "", *pa.Properties.PolicyDefinitionID, pd.Name, pd.Properties.Metadata)
+			}
+			if psd == nil || psd.Properties == nil {
+				continue
+			}
+			add(mgName, paName, "", *pa.Properties.PolicyDefinitionID, psd.Name, psd.Properties.Metadata)
+			for _, ref := range psd.PolicyDefinitionReferences() {
+				member := policySetDefinitionMember(ref, az)
+				if member == nil || member.Properties == nil {
+					continue
+				}
+				var refId, defId string
+				if ref.PolicyDefinitionReferenceID != nil {
+					refId = *ref.PolicyDefinitionReferenceID
+				}
+				if ref.PolicyDefinitionID != nil {
+					defId = *ref.PolicyDefinitionID
+				}
+				add(mgName, paName, refId, defId, member.Name, member.Properties.Metadata)
+			}
+		}
+	}
+	return res
+}
+
+// checkDefinitionStatus adds a diagnostic for the policy assignments that reference deprecated or preview definitions,
+// with the severity configured in src.
+func checkDefinitionStatus(depl *deployment.Hierarchy, az *alzlib.AlzLib, src gen.PolicyDefinitionChecksValue, resp *datasource.ReadResponse) {
+	deprecatedSeverity := definitionCheckSeverityWarning
+	previewSeverity := definitionCheckSeverityNone
+	if isKnown(src) {
+		if isKnown(src.Deprecated) {
+			deprecatedSeverity = src.Deprecated.ValueString()
+		}
+		if isKnown(src.Preview) {
+			previewSeverity = src.Preview.ValueString()
+		}
+	}
+	if deprecatedSeverity == definitionCheckSeverityNone && previewSeverity == definitionCheckSeverityNone {
+		return
+	}
+
+	var deprecated, preview []string
+	for _, ref := range definitionStatusReferences(depl, az) {
+		prefix := fmt.Sprintf("Policy assignment `%s` at management group `%s` references", ref.PolicyAssignmentName, ref.ManagementGroupId)
+		var suffix string
+		if ref.PolicyDefinitionReferenceId != "" {
+			suffix = fmt.Sprintf(" with policy definition reference id `%s`", ref.PolicyDefinitionReferenceId)
+		}
+		if ref.Deprecated {
+			msg := fmt.Sprintf("%s deprecated definition `%s`%s", prefix, ref.DefinitionId, suffix)
+			if ref.SupersededBy != "" {
+				msg += fmt.Sprintf(", use `%s` instead", ref.SupersededBy)
+			}
+			deprecated = append(deprecated, msg+".")
+		}
+		if ref.Preview {
+			preview = append(preview, fmt.Sprintf("%s preview definition `%s`%s.", prefix, ref.DefinitionId, suffix))
+		}
+	}
+
+	addDefinitionStatusDiagnostic(resp, deprecatedSeverity, "deprecated", deprecated)
+	addDefinitionStatusDiagnostic(resp, previewSeverity, "preview", preview)
+}
+
+// addDefinitionStatusDiagnostic adds a single diagnostic with the supplied severity that lists the messages.
+func addDefinitionStatusDiagnostic(resp *datasource.ReadResponse, severity, status string, msgs []string) {
+	if len(msgs) == 0 {
+		return
+	}
+	detail := strings.Join(msgs, "\n") + "\n\nTo change the severity of this check see the `policy_definition_checks` attribute."
+	switch severity {
+	case definitionCheckSeverityError:
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("architectureDataSource.Read() Error policy assignments reference %s policy definitions", status),
+			detail,
+		)
+	case definitionCheckSeverityWarning:
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("architectureDataSource.Read() Warning policy assignments reference %s policy definitions", status),
+			detail,
+		)
+	}
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/alzlib/deployment"
+	"github.com/Azure/terraform-provider-alz/internal/gen"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const definitionChecksTestPrefix = "/providers/Microsoft.Management/managementGroups/root/providers/Microsoft.Authorization"
+
+// newDefinitionChecksTestHierarchy returns the hierarchy from the definitionchecks test library.
+func newDefinitionChecksTestHierarchy(t *testing.T) (*alzlib.AlzLib, *deployment.Hierarchy) {
+	t.Helper()
+	ctx := t.Context()
+	az := alzlib.NewAlzLib(nil)
+	assert.NoError(t, az.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/definitionchecks", os.DirFS("testdata/definitionchecks"))))
+	depl := deployment.NewHierarchy(az)
+	assert.NoError(t, depl.FromArchitecture(ctx, "test", "00000000-0000-0000-0000-000000000000", "northeurope"))
+	return az, depl
+}
+
+func TestDefinitionMetadataStatus(t *testing.T) {
+	testCases := map[string]struct {
+		metadata any
+		expected definitionStatus
+	}{
+		"Nil":             {nil, definitionStatus{}},
+		"Not a map":       {"deprecated", definitionStatus{}},
+		"No status":       {map[string]any{"version": "1.0.0"}, definitionStatus{}},
+		"Deprecated":      {map[string]any{"deprecated": true, "supersededBy": "new"}, definitionStatus{Deprecated: true, SupersededBy: "new"}},
+		"Preview":         {map[string]any{"preview": true}, definitionStatus{Preview: true}},
+		"Non-bool status": {map[string]any{"deprecated": "true", "preview": 1}, definitionStatus{}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, definitionMetadataStatus(tc.metadata))
+		})
+	}
+}
+
+func TestDefinitionStatusReferences(t *testing.T) {
+	az, depl := newDefinitionChecksTestHierarchy(t)
+	assert.Equal(t, []definitionStatusReference{
+		{
+			ManagementGroupId:    "root",
+			PolicyAssignmentName: "deprecated-assignment",
+			DefinitionId:         definitionChecksTestPrefix + "/policyDefinitions/deprecated-policy-definition",
+			definitionStatus:     definitionStatus{Deprecated: true, SupersededBy: "new-policy-definition"},
+		},
+		{
+			ManagementGroupId:           "root",
+			PolicyAssignmentName:        "set-assignment",
+			PolicyDefinitionReferenceId: "preview",
+			DefinitionId:                definitionChecksTestPrefix + "/policyDefinitions/preview-policy-definition",
+			definitionStatus:            definitionStatus{Preview: true},
+		},
+	}, definitionStatusReferences(depl, az))
+}
+
+func TestCheckDefinitionStatus(t *testing.T) {
+	ctx := t.Context()
+	checks := func(deprecated, preview attr.Value) gen.PolicyDefinitionChecksValue {
+		return gen.NewPolicyDefinitionChecksValueMust(gen.NewPolicyDefinitionChecksValueNull().AttributeTypes(ctx), map[string]attr.Value{
+			"deprecated": deprecated,
+			"preview":    preview,
+		})
+	}
+	severities := func(diags diag.Diagnostics) []string {
+		res := make([]string, len(diags))
+		for i, d := range diags {
+			res[i] = d.Severity().String() + ": " + d.Summary()
+		}
+		return res
+	}
+
+	testCases := map[string]struct {
+		src      gen.PolicyDefinitionChecksValue
+		expected []string
+	}{
+		"Defaults": {
+			gen.NewPolicyDefinitionChecksValueNull(),
+			[]string{"Warning: architectureDataSource.Read() Warning policy assignments reference deprecated policy definitions"},
+		},
+		"Errors": {
+			checks(types.StringValue("error"), types.StringValue("error")),
+			[]string{
+				"Error: architectureDataSource.Read() Error policy assignments reference deprecated policy definitions",
+				"Error: architectureDataSource.Read() Error policy assignments reference preview policy definitions",
+			},
+		},
+		"Preview warning": {
+			checks(types.StringNull(), types.StringValue("warning")),
+			[]string{
+				"Warning: architectureDataSource.Read() Warning policy assignments reference deprecated policy definitions",
+				"Warning: architectureDataSource.Read() Warning policy assignments reference preview policy definitions",
+			},
+		},
+		"None": {
+			checks(types.StringValue("none"), types.StringValue("none")),
+			[]string{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			az, depl := newDefinitionChecksTestHierarchy(t)
+			resp := &datasource.ReadResponse{}
+			checkDefinitionStatus(depl, az, tc.src, resp)
+			assert.Equal(t, tc.expected, severities(resp.Diagnostics))
+		})
+	}
+
+	t.Run("Detail", func(t *testing.T) {
+		az, depl := newDefinitionChecksTestHierarchy(t)
+		resp := &datasource.ReadResponse{}
+		checkDefinitionStatus(depl, az, checks(types.StringValue("warning"), types.StringValue("warning")), resp)
+		assert.Len(t, resp.Diagnostics, 2)
+		assert.Contains(t, resp.Diagnostics[0].Detail(), "Policy assignment `deprecated-assignment` at management group `root` references deprecated definition `"+
+			definitionChecksTestPrefix+"/policyDefinitions/deprecated-policy-definition`, use `new-policy-definition` instead.")
+		assert.Contains(t, resp.Diagnostics[1].Detail(), "Policy assignment `set-assignment` at management group `root` references preview definition `"+
+			definitionChecksTestPrefix+"/policyDefinitions/preview-policy-definition` with policy definition reference id `preview`.")
+	})
+}
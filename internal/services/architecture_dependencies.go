@@ -0,0 +1,273 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/Azure/alzlib/deployment"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armpolicy"
+	"github.com/Azure/terraform-provider-alz/internal/gen"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// assetDependency is a dependency edge between two resources in the hierarchy, identified by their resource ids.
+// The resource with ResourceId must be deployed after the resource with DependsOnResourceId.
+type assetDependency struct {
+	ResourceId          string
+	DependsOnResourceId string
+}
+
+// assetGraph is a dependency graph of the management groups and assets in the hierarchy.
+// Resource ids are compared case insensitively, as they are in Azure.
+type assetGraph struct {
+	// nodes maps the lower case resource id to the resource id.
+	nodes map[string]string
+	// edges maps the lower case resource id to the set of lower case resource ids it depends on.
+	edges map[string]map[string]struct{}
+	// roleDefinitions maps the lower case role definition name to the lower case resource id.
+	// Policy definitions reference role definitions by tenant scoped resource id, so they are matched by name.
+	roleDefinitions map[string]string
+}
+
+// newAssetGraph builds the dependency graph of the management groups and assets in the hierarchy.
+// The dependencies are derived from the references in each asset:
+//
+//   - Management groups depend on their parent management group in the hierarchy.
+//   - Assets depend on the management group they are deployed to.
+//   - Policy definitions depend on the custom role definitions in their `roleDefinitionIds`.
+//   - Policy set definitions depend on their referenced policy definitions.
+//   - Policy assignments depend on their assigned policy (set) definition.
+//   - Policy exemptions depend on their policy assignment.
+//   - Policy role assignments depend on their policy assignment, and on their scope if it is a management group.
+//
+// Policy role assignments are identified by policyRoleAssignmentResourceID.
+// References to resources that are not in the hierarchy, e.g. built-in definitions, are ignored.
+func newAssetGraph(depl *deployment.Hierarchy, exemptions map[string]map[string]*armpolicy.Exemption, pras []deployment.PolicyRoleAssignment) *assetGraph {
+	g := &assetGraph{
+		nodes:           make(map[string]string),
+		edges:           make(map[string]map[string]struct{}),
+		roleDefinitions: make(map[string]string),
+	}
+
+	// References are resolved once all nodes are known, as assets may reference assets at other management groups.
+	references := make(map[string][]string)
+	roleReferences := make(map[string][]string)
+
+	mgNames := depl.ManagementGroupNames()
+	slices.Sort(mgNames)
+	for _, mgName := range mgNames {
+		mg := depl.ManagementGroup(mgName)
+		mgId := g.addNode(mg.ResourceID())
+		if parent := mg.Parent(); parent != nil {
+			references[mgId] = append(references[mgId], parent.ResourceID())
+		}
+
+		for _, rd := range mg.RoleDefinitionsMap() {
+			if rd.ID == nil {
+				continue
+			}
+			id := g.addNode(*rd.ID)
+			references[id] = append(references[id], mg.ResourceID())
+			if rd.Name != nil {
+				g.roleDefinitions[strings.ToLower(*rd.Name)] = id
+			}
+		}
+
+		for _, pd := range mg.PolicyDefinitionsMap() {
+			if pd.ID == nil {
+				continue
+			}
+			id := g.addNode(*pd.ID)
+			references[id] = append(references[id], mg.ResourceID())
+			if rdIds, err := pd.RoleDefinitionResourceIDs(); err == nil {
+				roleReferences[id] = append(roleReferences[id], rdIds...)
+			}
+		}
+
+		for _, psd := range mg.PolicySetDefinitionsMap() {
+			if psd.ID == nil {
+				continue
+			}
+			id := g.addNode(*psd.ID)
+			references[id] = append(references[id], mg.ResourceID())
+			for _, ref := range psd.PolicyDefinitionReferences() {
+				if ref != nil && ref.PolicyDefinitionID != nil {
+					references[id] = append(references[id], *ref.PolicyDefinitionID)
+				}
+			}
+		}
+
+		for _, pa := range mg.PolicyAssignmentMap() {
+			if pa.ID == nil {
+				continue
+			}
+			id := g.addNode(*pa.ID)
+			references[id] = append(references[id], mg.ResourceID())
+			if pa.Properties != nil && pa.Properties.PolicyDefinitionID != nil {
+				references[id] = append(references[id], *pa.Properties.PolicyDefinitionID)
+			}
+		}
+
+		for _, pe := range exemptions[mgName] {
+			if pe.ID == nil {
+				continue
+			}
+			id := g.addNode(*pe.ID)
+			references[id] = append(references[id], mg.ResourceID())
+			if pe.Properties != nil && pe.Properties.PolicyAssignmentID != nil {
+				references[id] = append(references[id], *pe.Properties.PolicyAssignmentID)
+			}
+		}
+	}
+
+	for _, pra := range pras {
+		id := g.addNode(policyRoleAssignmentResourceID(pra))
+		references[id] = append(references[id],
+			fmt.Sprintf(deployment.ManagementGroupIDFmt, pra.ManagementGroupID),
+			fmt.Sprintf(deployment.PolicyAssignmentIDFmt, pra.ManagementGroupID, pra.AssignmentName),
+			pra.Scope,
+		)
+	}
+
+	for id, refs := range references {
+		for _, ref := range refs {
+			if _, ok := g.nodes[strings.ToLower(ref)]; ok {
+				g.addEdge(id, strings.ToLower(ref))
+			}
+		}
+	}
+	for id, refs := range roleReferences {
+		for _, ref := range refs {
+			if rdId, ok := g.roleDefinitions[strings.ToLower(path.Base(ref))]; ok {
+				g.addEdge(id, rdId)
+			}
+		}
+	}
+	return g
+}
+
+// policyRoleAssignmentResourceID returns the resource id of the policy role assignment.
+// The name is the version 5 UUID in the URL namespace of the policy assignment resource id, the role definition id
+// and the scope, concatenated, e.g. `uuidv5("url", "${policy_assignment_id}${role_definition_id}${scope}")` in Terraform.
+func policyRoleAssignmentResourceID(pra deployment.PolicyRoleAssignment) string {
+	paId := fmt.Sprintf(deployment.PolicyAssignmentIDFmt, pra.ManagementGroupID, pra.AssignmentName)
+	name := uuid.NewSHA1(uuid.NameSpaceURL, []byte(paId+pra.RoleDefinitionID+pra.Scope))
+	return pra.Scope + "/providers/Microsoft.Authorization/roleAssignments/" + name.String()
+}
+
+// addNode adds the resource id to the graph and returns its lower case key.
+func (g *assetGraph) addNode(resourceId string) string {
+	key := strings.ToLower(resourceId)
+	g.nodes[key] = resourceId
+	return key
+}
+
+// addEdge adds a dependency from one lower case resource id to another.
+// Self references are ignored.
+func (g *assetGraph) addEdge(from, to string) {
+	if from == to {
+		return
+	}
+	if g.edges[from] == nil {
+		g.edges[from] = make(map[string]struct{})
+	}
+	g.edges[from][to] = struct{}{}
+}
+
+// dependencies returns the dependency edges of the graph, sorted by resource id.
+func (g *assetGraph) dependencies() []assetDependency {
+	res := make([]assetDependency, 0, len(g.edges))
+	for from, tos := range g.edges {
+		for to := range tos {
+			res = append(res, assetDependency{
+				ResourceId:          g.nodes[from],
+				DependsOnResourceId: g.nodes[to],
+			})
+		}
+	}
+	slices.SortFunc(res, func(a, b assetDependency) int {
+		if c := strings.Compare(a.ResourceId, b.ResourceId); c != 0 {
+			return c
+		}
+		return strings.Compare(a.DependsOnResourceId, b.DependsOnResourceId)
+	})
+	return res
+}
+
+// deploymentOrder returns the resource ids of the graph in topological order, so that each resource
+// appears after all of the resources it depends on.
+// Resources that are ready at the same time are sorted by resource id, so the order is deterministic.
+// An error is returned if the graph contains a cycle.
+func (g *assetGraph) deploymentOrder() ([]string, error) {
+	remaining := make(map[string]int, len(g.nodes))
+	dependents := make(map[string][]string, len(g.nodes))
+	for id := range g.nodes {
+		remaining[id] = len(g.edges[id])
+		for dep := range g.edges[id] {
+			dependents[dep] = append(dependents[dep], id)
+		}
+	}
+
+	ready := make([]string, 0, len(g.nodes))
+	for id, n := range remaining {
+		if n == 0 {
+			ready = append(ready, id)
+		}
+	}
+
+	res := make([]string, 0, len(g.nodes))
+	for len(ready) > 0 {
+		slices.SortFunc(ready, func(a, b string) int {
+			return strings.Compare(g.nodes[a], g.nodes[b])
+		})
+		id := ready[0]
+		ready = ready[1:]
+		res = append(res, g.nodes[id])
+		for _, dependent := range dependents[id] {
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if len(res) != len(g.nodes) {
+		cyclic := make([]string, 0, len(g.nodes)-len(res))
+		for id, n := range remaining {
+			if n > 0 {
+				cyclic = append(cyclic, g.nodes[id])
+			}
+		}
+		slices.Sort(cyclic)
+		return nil, fmt.Errorf("dependency cycle detected between resources: %s", strings.Join(cyclic, ", "))
+	}
+	return res, nil
+}
+
+// assetDependenciesToProviderType converts the dependency edges to the framework type.
+func assetDependenciesToProviderType(ctx context.Context, deps []assetDependency) (basetypes.ListValue, diag.Diagnostics) {
+	var respDiags diag.Diagnostics
+	vals := make([]attr.Value, len(deps))
+	for i, dep := range deps {
+		val, diags := gen.NewAssetDependenciesValue(
+			gen.NewAssetDependenciesValueNull().AttributeTypes(ctx),
+			map[string]attr.Value{
+				"resource_id":            types.StringValue(dep.ResourceId),
+				"depends_on_resource_id": types.StringValue(dep.DependsOnResourceId),
+			},
+		)
+		respDiags.Append(diags...)
+		vals[i] = val
+	}
+	if respDiags.HasError() {
+		return types.ListNull(gen.NewAssetDependenciesValueNull().Type(ctx)), respDiags
+	}
+	return types.ListValue(gen.NewAssetDependenciesValueNull().Type(ctx), vals)
+}
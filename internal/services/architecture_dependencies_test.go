@@ -0,0 +1,87 @@
+package services
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/alzlib/deployment"
+	"github.com/Azure/alzlib/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armpolicy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssetGraph(t *testing.T) {
+	ctx := t.Context()
+	az := alzlib.NewAlzLib(nil)
+	assert.NoError(t, az.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/policyexemptions", os.DirFS("testdata/policyexemptions"))))
+	depl := deployment.NewHierarchy(az)
+	assert.NoError(t, depl.FromArchitecture(ctx, "test", "00000000-0000-0000-0000-000000000000", "northeurope"))
+
+	const (
+		rootId  = "/providers/Microsoft.Management/managementGroups/root"
+		childId = "/providers/Microsoft.Management/managementGroups/child"
+		pdId    = rootId + "/providers/Microsoft.Authorization/policyDefinitions/test-policy-definition"
+		psdId   = rootId + "/providers/Microsoft.Authorization/policySetDefinitions/test-policy-set-definition"
+		paId    = rootId + "/providers/Microsoft.Authorization/policyAssignments/test-policy-assignment"
+		psaId   = rootId + "/providers/Microsoft.Authorization/policyAssignments/test-set-assignment"
+		peId    = childId + "/providers/Microsoft.Authorization/policyExemptions/test-exemption"
+	)
+	exemptions := map[string]map[string]*armpolicy.Exemption{
+		"child": {
+			"test-exemption": {
+				ID:         to.Ptr(peId),
+				Properties: &armpolicy.ExemptionProperties{PolicyAssignmentID: to.Ptr(psaId)},
+			},
+		},
+	}
+
+	const roleDefinitionId = "/providers/Microsoft.Authorization/roleDefinitions/b24988ac-6180-42a0-ab88-20f7382dd24c"
+	pras := []deployment.PolicyRoleAssignment{
+		{RoleDefinitionID: roleDefinitionId, Scope: childId, AssignmentName: "test-policy-assignment", ManagementGroupID: "root"},
+		{RoleDefinitionID: roleDefinitionId, Scope: "/subscriptions/00000000-0000-0000-0000-000000000000", AssignmentName: "test-policy-assignment", ManagementGroupID: "root"},
+	}
+	childRaId := childId + "/providers/Microsoft.Authorization/roleAssignments/692a53e1-2b2a-574a-9827-a02a05eef6e3"
+	assert.Equal(t, childRaId, policyRoleAssignmentResourceID(pras[0]))
+	subRaId := policyRoleAssignmentResourceID(pras[1])
+
+	g := newAssetGraph(depl, exemptions, pras)
+	assert.Equal(t, []assetDependency{
+		{ResourceId: childId, DependsOnResourceId: rootId},
+		{ResourceId: peId, DependsOnResourceId: childId},
+		{ResourceId: peId, DependsOnResourceId: psaId},
+		{ResourceId: childRaId, DependsOnResourceId: childId},
+		{ResourceId: childRaId, DependsOnResourceId: rootId},
+		{ResourceId: childRaId, DependsOnResourceId: paId},
+		{ResourceId: paId, DependsOnResourceId: rootId},
+		{ResourceId: paId, DependsOnResourceId: pdId},
+		{ResourceId: psaId, DependsOnResourceId: rootId},
+		{ResourceId: psaId, DependsOnResourceId: psdId},
+		{ResourceId: pdId, DependsOnResourceId: rootId},
+		{ResourceId: psdId, DependsOnResourceId: rootId},
+		{ResourceId: psdId, DependsOnResourceId: pdId},
+		{ResourceId: subRaId, DependsOnResourceId: rootId},
+		{ResourceId: subRaId, DependsOnResourceId: paId},
+	}, g.dependencies())
+
+	order, err := g.deploymentOrder()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{rootId, childId, pdId, paId, childRaId, psdId, psaId, peId, subRaId}, order)
+}
+
+func TestAssetGraphCycle(t *testing.T) {
+	g := &assetGraph{
+		nodes: make(map[string]string),
+		edges: make(map[string]map[string]struct{}),
+	}
+	a := g.addNode("/A")
+	b := g.addNode("/B")
+	c := g.addNode("/C")
+	g.addEdge(a, b)
+	g.addEdge(b, a)
+	g.addEdge(c, c)
+
+	_, err := g.deploymentOrder()
+	assert.ErrorContains(t, err, "/A, /B")
+	assert.Empty(t, g.edges[c])
+}
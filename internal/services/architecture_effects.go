@@ -0,0 +1,330 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/alzlib/assets"
+	"github.com/Azure/alzlib/deployment"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armpolicy"
+	"github.com/Azure/terraform-provider-alz/internal/gen"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// parameterExpressionRegex matches a value that references a parameter, e.g. `[parameters('effect')]`.
+var parameterExpressionRegex = regexp.MustCompile(`(?i)^\[\s*parameters\(\s*'([^']+)'\s*\)\s*\]$`)
+
+// remediationEffects are the policy effects that require a remediation task for existing resources.
+var remediationEffects = []string{"deployifnotexists", "modify"}
+
+// The sources of a resolved policy effect, i.e. where in the parameter chain the effect value was found.
+const (
+	effectSourcePolicyAssignment           = "policy_assignment"
+	effectSourcePolicySetDefinition        = "policy_set_definition"
+	effectSourcePolicySetDefinitionDefault = "policy_set_definition_default"
+	effectSourcePolicyDefinition           = "policy_definition"
+	effectSourcePolicyDefinitionDefault    = "policy_definition_default"
+)
+
+// policyEffect is the resolved effect of a policy definition assigned by a policy assignment,
+// either directly or as a member of a policy set definition.
+type policyEffect struct {
+	// PolicyDefinitionReferenceId is the reference id of the member in the policy set definition.
+	// It is empty for policy assignments of a policy definition.
+	PolicyDefinitionReferenceId string
+	PolicyDefinitionId          string
+	// Effect is empty if the effect could not be resolved.
+	Effect string
+	// EffectSource is where the effect value was found, one of the effectSource constants.
+	// It is empty if the effect could not be resolved.
+	EffectSource string
+}
+
+// parameterValueFunc returns the value supplied for the named parameter, where it was supplied, and whether it was supplied.
+type parameterValueFunc func(name string) (any, string, bool)
+
+// policyAssignmentEffects resolves the effect of each policy definition assigned by the policy assignment.
+// Policy set definition members are returned in the order of the policy set definition.
+// Nil is returned if the assigned definition cannot be found.
+func policyAssignmentEffects(depl *deployment.Hierarchy, pa *assets.PolicyAssignment, az *alzlib.AlzLib) []policyEffect {
+	pd, psd := policyAssignmentDefinitions(pa, az)
+	if deployed := hierarchyPolicySetDefinition(depl, pa); deployed != nil {
+		psd = deployed
+	}
+	assignmentValue := func(name string) (any, string, bool) {
+		if pa.Properties == nil {
+			return nil, "", false
+		}
+		v, ok := parameterValue(pa.Properties.Parameters, name)
+		return v, effectSourcePolicyAssignment, ok
+	}
+
+	if pd != nil {
+		effect := policyEffect{PolicyDefinitionId: *pa.Properties.PolicyDefinitionID}
+		effect.Effect, effect.EffectSource = policyDefinitionEffect(pd, assignmentValue)
+		return []policyEffect{effect}
+	}
+	if psd == nil {
+		return nil
+	}
+
+	// Set definition parameters take the assignment value, falling back to the set definition default.
+	setValue := func(name string) (any, string, bool) {
+		if v, source, ok := assignmentValue(name); ok {
+			return v, source, true
+		}
+		if param := psd.Parameter(name); param != nil && param.DefaultValue != nil {
+			return param.DefaultValue, effectSourcePolicySetDefinitionDefault, true
+		}
+		return nil, "", false
+	}
+
+	res := make([]policyEffect, 0, len(psd.PolicyDefinitionReferences()))
+	for _, ref := range psd.PolicyDefinitionReferences() {
+		if ref == nil || ref.PolicyDefinitionID == nil {
+			continue
+		}
+		effect := policyEffect{PolicyDefinitionId: *ref.PolicyDefinitionID}
+		if ref.PolicyDefinitionReferenceID != nil {
+			effect.PolicyDefinitionReferenceId = *ref.PolicyDefinitionReferenceID
+		}
+		// Member parameters are either literal values or reference the set definition parameters.
+		memberValue := func(name string) (any, string, bool) {
+			v, ok := parameterValue(ref.Parameters, name)
+			if !ok {
+				return nil, "", false
+			}
+			if s, isString := v.(string); isString {
+				if m := parameterExpressionRegex.FindStringSubmatch(s); m != nil {
+					return setValue(m[1])
+				}
+			}
+			return v, effectSourcePolicySetDefinition, true
+		}
+		if memberPd := policySetDefinitionMember(ref, az); memberPd != nil {
+			effect.Effect, effect.EffectSource = policyDefinitionEffect(memberPd, memberValue)
+		}
+		res = append(res, effect)
+	}
+	return res
+}
+
+// hierarchyPolicySetDefinition returns the policy set definition assigned by the policy assignment, if it is deployed in the hierarchy.
+// Unlike the library copy, its member policy definition ids reference the deployed policy definitions.
+func hierarchyPolicySetDefinition(depl *deployment.Hierarchy, pa *assets.PolicyAssignment) *assets.PolicySetDefinition {
+	if pa.Properties == nil || pa.Properties.PolicyDefinitionID == nil {
+		return nil
+	}
+	resID, _, err := pa.ReferencedPolicyDefinitionResourceIDAndVersion()
+	if err != nil || resID == nil || resID.Parent == nil || !strings.EqualFold(resID.ResourceType.Type, alzlib.PolicySetDefinitionsType) {
+		return nil
+	}
+	mg := depl.ManagementGroup(resID.Parent.Name)
+	if mg == nil {
+		return nil
+	}
+	return mg.PolicySetDefinitionsMap()[resID.Name]
+}
+
+// policyDefinitionEffect resolves the effect in the policy rule of the policy definition, and returns it with its source.
+// If the effect references a parameter, the supplied value is used, falling back to the parameter default.
+// Empty strings are returned if the effect cannot be resolved.
+func policyDefinitionEffect(pd *assets.PolicyDefinition, value parameterValueFunc) (string, string) {
+	if pd.Properties == nil {
+		return "", ""
+	}
+	rule, ok := pd.Properties.PolicyRule.(map[string]any)
+	if !ok {
+		return "", ""
+	}
+	then, ok := caseInsensitiveValue(rule, "then").(map[string]any)
+	if !ok {
+		return "", ""
+	}
+	effect, ok := caseInsensitiveValue(then, "effect").(string)
+	if !ok {
+		return "", ""
+	}
+
+	m := parameterExpressionRegex.FindStringSubmatch(effect)
+	if m == nil {
+		return effect, effectSourcePolicyDefinition
+	}
+	v, source, ok := value(m[1])
+	if !ok {
+		param := pd.Parameter(m[1])
+		if param == nil {
+			return "", ""
+		}
+		v, source = param.DefaultValue, effectSourcePolicyDefinitionDefault
+	}
+	if s, ok := v.(string); ok && parameterExpressionRegex.FindStringSubmatch(s) == nil {
+		return s, source
+	}
+	return "", ""
+}
+
+// parameterValue returns the value of the named parameter, matching the name case insensitively as ARM does.
+func parameterValue(params map[string]*armpolicy.ParameterValuesValue, name string) (any, bool) {
+	if v, ok := params[name]; ok && v != nil {
+		return v.Value, true
+	}
+	for k, v := range params {
+		if strings.EqualFold(k, name) && v != nil {
+			return v.Value, true
+		}
+	}
+	return nil, false
+}
+
+// caseInsensitiveValue returns the value of the key in the map, matching the key case insensitively.
+func caseInsensitiveValue(m map[string]any, key string) any {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return nil
+}
+
+// policyRemediationTarget is a policy definition, assigned directly or as a member of a policy set definition,
+// whose effect requires a remediation task to bring existing resources into compliance.
+type policyRemediationTarget struct {
+	ManagementGroupId    string
+	PolicyAssignmentName string
+	PolicyAssignmentId   string
+	Scope                string
+	policyEffect
+}
+
+// policyAssignmentEffect is the resolved effect of a policy definition assigned by a policy assignment in the hierarchy.
+type policyAssignmentEffect struct {
+	ManagementGroupId    string
+	PolicyAssignmentName string
+	PolicyAssignmentId   string
+	EnforcementMode      string
+	policyEffect
+}
+
+// hierarchyPolicyAssignmentEffects resolves the effects of every policy assignment in the hierarchy,
+// with an element for every member of policy set definition assignments.
+// The result is sorted by policy assignment id and policy definition reference id.
+func hierarchyPolicyAssignmentEffects(depl *deployment.Hierarchy, az *alzlib.AlzLib) []policyAssignmentEffect {
+	var res []policyAssignmentEffect
+	for _, mgName := range depl.ManagementGroupNames() {
+		for paName, pa := range depl.ManagementGroup(mgName).PolicyAssignmentMap() {
+			enforcementMode := string(armpolicy.EnforcementModeDefault)
+			if pa.Properties != nil && pa.Properties.EnforcementMode != nil {
+				enforcementMode = string(*pa.Properties.EnforcementMode)
+			}
+			for _, effect := range policyAssignmentEffects(depl, pa, az) {
+				res = append(res, policyAssignmentEffect{
+					ManagementGroupId:    mgName,
+					PolicyAssignmentName: paName,
+					PolicyAssignmentId:   fmt.Sprintf(deployment.PolicyAssignmentIDFmt, mgName, paName),
+					EnforcementMode:      enforcementMode,
+					policyEffect:         effect,
+				})
+			}
+		}
+	}
+	slices.SortFunc(res, func(a, b policyAssignmentEffect) int {
+		if c := strings.Compare(a.PolicyAssignmentId, b.PolicyAssignmentId); c != 0 {
+			return c
+		}
+		return strings.Compare(a.PolicyDefinitionReferenceId, b.PolicyDefinitionReferenceId)
+	})
+	return res
+}
+
+// policyAssignmentEffectsToProviderType converts the policy assignment effects to the framework type.
+// Unresolved effects are null.
+func policyAssignmentEffectsToProviderType(ctx context.Context, effects []policyAssignmentEffect) (basetypes.ListValue, diag.Diagnostics) {
+	var respDiags diag.Diagnostics
+	vals := make([]attr.Value, len(effects))
+	for i, effect := range effects {
+		val, diags := gen.NewPolicyAssignmentEffectsValue(
+			gen.NewPolicyAssignmentEffectsValueNull().AttributeTypes(ctx),
+			map[string]attr.Value{
+				"management_group_id":            types.StringValue(effect.ManagementGroupId),
+				"policy_assignment_name":         types.StringValue(effect.PolicyAssignmentName),
+				"policy_assignment_id":           types.StringValue(effect.PolicyAssignmentId),
+				"policy_definition_id":           types.StringValue(effect.PolicyDefinitionId),
+				"policy_definition_reference_id": stringValueOrNull(effect.PolicyDefinitionReferenceId),
+				"effect":                         stringValueOrNull(effect.Effect),
+				"effect_source":                  stringValueOrNull(effect.EffectSource),
+				"enforcement_mode":               types.StringValue(effect.EnforcementMode),
+			},
+		)
+		respDiags.Append(diags...)
+		vals[i] = val
+	}
+	if respDiags.HasError() {
+		return types.ListNull(gen.NewPolicyAssignmentEffectsValueNull().Type(ctx)), respDiags
+	}
+	return types.ListValue(gen.NewPolicyAssignmentEffectsValueNull().Type(ctx), vals)
+}
+
+// stringValueOrNull returns a null string value for the empty string.
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// policyRemediationTargets returns the remediation targets for the policy assignments in the hierarchy,
+// i.e. those with a resolved DeployIfNotExists or Modify effect.
+// The result is sorted by policy assignment id and policy definition reference id.
+func policyRemediationTargets(depl *deployment.Hierarchy, az *alzlib.AlzLib) []policyRemediationTarget {
+	var res []policyRemediationTarget
+	for _, effect := range hierarchyPolicyAssignmentEffects(depl, az) {
+		if !slices.Contains(remediationEffects, strings.ToLower(effect.Effect)) {
+			continue
+		}
+		res = append(res, policyRemediationTarget{
+			ManagementGroupId:    effect.ManagementGroupId,
+			PolicyAssignmentName: effect.PolicyAssignmentName,
+			PolicyAssignmentId:   effect.PolicyAssignmentId,
+			Scope:                fmt.Sprintf(deployment.ManagementGroupIDFmt, effect.ManagementGroupId),
+			policyEffect:         effect.policyEffect,
+		})
+	}
+	return res
+}
+
+// policyRemediationTargetsToProviderType converts the remediation targets to the framework type.
+func policyRemediationTargetsToProviderType(ctx context.Context, targets []policyRemediationTarget) (basetypes.ListValue, diag.Diagnostics) {
+	var respDiags diag.Diagnostics
+	vals := make([]attr.Value, len(targets))
+	for i, target := range targets {
+		val, diags := gen.NewPolicyRemediationTargetsValue(
+			gen.NewPolicyRemediationTargetsValueNull().AttributeTypes(ctx),
+			map[string]attr.Value{
+				"management_group_id":            types.StringValue(target.ManagementGroupId),
+				"policy_assignment_name":         types.StringValue(target.PolicyAssignmentName),
+				"policy_assignment_id":           types.StringValue(target.PolicyAssignmentId),
+				"policy_definition_id":           types.StringValue(target.PolicyDefinitionId),
+				"policy_definition_reference_id": stringValueOrNull(target.PolicyDefinitionReferenceId),
+				"effect":                         types.StringValue(target.Effect),
+				"scope":                          types.StringValue(target.Scope),
+			},
+		)
+		respDiags.Append(diags...)
+		vals[i] = val
+	}
+	if respDiags.HasError() {
+		return types.ListNull(gen.NewPolicyRemediationTargetsValueNull().Type(ctx)), respDiags
+	}
+	return types.ListValue(gen.NewPolicyRemediationTargetsValueNull().Type(ctx), vals)
+}
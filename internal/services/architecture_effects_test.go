@@ -0,0 +1,168 @@
+package services
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/alzlib/assets"
+	"github.com/Azure/alzlib/deployment"
+	"github.com/Azure/alzlib/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armpolicy"
+	"github.com/stretchr/testify/assert"
+)
+
+const policyEffectsTestMgPrefix = "/providers/Microsoft.Management/managementGroups/root/providers/Microsoft.Authorization"
+
+// newPolicyEffectsTestHierarchy returns the hierarchy from the policyeffects test library.
+func newPolicyEffectsTestHierarchy(t *testing.T) (*alzlib.AlzLib, *deployment.Hierarchy) {
+	t.Helper()
+	ctx := t.Context()
+	az := alzlib.NewAlzLib(nil)
+	assert.NoError(t, az.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/policyeffects", os.DirFS("testdata/policyeffects"))))
+	depl := deployment.NewHierarchy(az)
+	assert.NoError(t, depl.FromArchitecture(ctx, "test", "00000000-0000-0000-0000-000000000000", "northeurope"))
+	return az, depl
+}
+
+func TestPolicyAssignmentEffects(t *testing.T) {
+	az, depl := newPolicyEffectsTestHierarchy(t)
+	pas := depl.ManagementGroup("root").PolicyAssignmentMap()
+
+	t.Run("Assignment value", func(t *testing.T) {
+		assert.Equal(t, []policyEffect{
+			{PolicyDefinitionId: policyEffectsTestMgPrefix + "/policyDefinitions/dine-policy-definition", Effect: "AuditIfNotExists", EffectSource: effectSourcePolicyAssignment},
+		}, policyAssignmentEffects(depl, pas["dine-assignment"], az))
+	})
+
+	t.Run("Literal", func(t *testing.T) {
+		assert.Equal(t, []policyEffect{
+			{PolicyDefinitionId: policyEffectsTestMgPrefix + "/policyDefinitions/modify-policy-definition", Effect: "Modify", EffectSource: effectSourcePolicyDefinition},
+		}, policyAssignmentEffects(depl, pas["modify-assignment"], az))
+	})
+
+	t.Run("Policy set definition", func(t *testing.T) {
+		assert.Equal(t, []policyEffect{
+			{PolicyDefinitionReferenceId: "dine", PolicyDefinitionId: policyEffectsTestMgPrefix + "/policyDefinitions/dine-policy-definition", Effect: "DeployIfNotExists", EffectSource: effectSourcePolicySetDefinitionDefault},
+			{PolicyDefinitionReferenceId: "modify", PolicyDefinitionId: policyEffectsTestMgPrefix + "/policyDefinitions/modify-policy-definition", Effect: "Modify", EffectSource: effectSourcePolicyDefinition},
+			{PolicyDefinitionReferenceId: "audit", PolicyDefinitionId: policyEffectsTestMgPrefix + "/policyDefinitions/audit-policy-definition", Effect: "Deny", EffectSource: effectSourcePolicyAssignment},
+			{PolicyDefinitionReferenceId: "audit-disabled", PolicyDefinitionId: policyEffectsTestMgPrefix + "/policyDefinitions/audit-policy-definition", Effect: "Disabled", EffectSource: effectSourcePolicySetDefinition},
+		}, policyAssignmentEffects(depl, pas["set-assignment"], az))
+	})
+
+	t.Run("Unknown definition", func(t *testing.T) {
+		pa := assets.NewPolicyAssignment(armpolicy.Assignment{
+			Properties: &armpolicy.AssignmentProperties{
+				PolicyDefinitionID: to.Ptr("/providers/Microsoft.Authorization/policyDefinitions/nonexistent"),
+			},
+		})
+		assert.Nil(t, policyAssignmentEffects(depl, pa, az))
+	})
+}
+
+func TestPolicyDefinitionEffect(t *testing.T) {
+	newPd := func(effect string, params map[string]*armpolicy.ParameterDefinitionsValue) *assets.PolicyDefinition {
+		return assets.NewPolicyDefinition(armpolicy.Definition{
+			Properties: &armpolicy.DefinitionProperties{
+				Parameters: params,
+				PolicyRule: map[string]any{
+					"if":   map[string]any{"field": "type", "equals": "Microsoft.Storage/storageAccounts"},
+					"then": map[string]any{"effect": effect},
+				},
+			},
+		})
+	}
+	noValue := func(string) (any, string, bool) { return nil, "", false }
+	assignmentValue := func(v any) parameterValueFunc {
+		return func(string) (any, string, bool) { return v, effectSourcePolicyAssignment, true }
+	}
+	effectParam := map[string]*armpolicy.ParameterDefinitionsValue{
+		"effect": {DefaultValue: "Audit"},
+	}
+
+	testCases := map[string]struct {
+		pd             *assets.PolicyDefinition
+		value          parameterValueFunc
+		expectedEffect string
+		expectedSource string
+	}{
+		"Literal":                     {newPd("Deny", nil), noValue, "Deny", effectSourcePolicyDefinition},
+		"Default":                     {newPd("[parameters('effect')]", effectParam), noValue, "Audit", effectSourcePolicyDefinitionDefault},
+		"Default with whitespace":     {newPd("[ parameters( 'effect' ) ]", effectParam), noValue, "Audit", effectSourcePolicyDefinitionDefault},
+		"Supplied value":              {newPd("[parameters('effect')]", effectParam), assignmentValue("Deny"), "Deny", effectSourcePolicyAssignment},
+		"Missing parameter":           {newPd("[parameters('effect')]", nil), noValue, "", ""},
+		"Unresolved expression value": {newPd("[parameters('effect')]", effectParam), assignmentValue("[parameters('other')]"), "", ""},
+		"Non-string value":            {newPd("[parameters('effect')]", effectParam), assignmentValue(1), "", ""},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			effect, source := policyDefinitionEffect(tc.pd, tc.value)
+			assert.Equal(t, tc.expectedEffect, effect)
+			assert.Equal(t, tc.expectedSource, source)
+		})
+	}
+}
+
+func TestPolicyRemediationTargets(t *testing.T) {
+	az, depl := newPolicyEffectsTestHierarchy(t)
+	const (
+		rootId = "/providers/Microsoft.Management/managementGroups/root"
+	)
+
+	assert.Equal(t, []policyRemediationTarget{
+		{
+			ManagementGroupId:    "root",
+			PolicyAssignmentName: "modify-assignment",
+			PolicyAssignmentId:   policyEffectsTestMgPrefix + "/policyAssignments/modify-assignment",
+			Scope:                rootId,
+			policyEffect: policyEffect{
+				PolicyDefinitionId: policyEffectsTestMgPrefix + "/policyDefinitions/modify-policy-definition",
+				Effect:             "Modify",
+				EffectSource:       effectSourcePolicyDefinition,
+			},
+		},
+		{
+			ManagementGroupId:    "root",
+			PolicyAssignmentName: "set-assignment",
+			PolicyAssignmentId:   policyEffectsTestMgPrefix + "/policyAssignments/set-assignment",
+			Scope:                rootId,
+			policyEffect: policyEffect{
+				PolicyDefinitionReferenceId: "dine",
+				PolicyDefinitionId:          policyEffectsTestMgPrefix + "/policyDefinitions/dine-policy-definition",
+				Effect:                      "DeployIfNotExists",
+				EffectSource:                effectSourcePolicySetDefinitionDefault,
+			},
+		},
+		{
+			ManagementGroupId:    "root",
+			PolicyAssignmentName: "set-assignment",
+			PolicyAssignmentId:   policyEffectsTestMgPrefix + "/policyAssignments/set-assignment",
+			Scope:                rootId,
+			policyEffect: policyEffect{
+				PolicyDefinitionReferenceId: "modify",
+				PolicyDefinitionId:          policyEffectsTestMgPrefix + "/policyDefinitions/modify-policy-definition",
+				Effect:                      "Modify",
+				EffectSource:                effectSourcePolicyDefinition,
+			},
+		},
+	}, policyRemediationTargets(depl, az))
+}
+
+func TestHierarchyPolicyAssignmentEffects(t *testing.T) {
+	az, depl := newPolicyEffectsTestHierarchy(t)
+
+	effects := hierarchyPolicyAssignmentEffects(depl, az)
+	summary := make([]string, len(effects))
+	for i, e := range effects {
+		summary[i] = strings.Join([]string{e.PolicyAssignmentName, e.PolicyDefinitionReferenceId, e.Effect, e.EffectSource, e.EnforcementMode}, "/")
+	}
+	assert.Equal(t, []string{
+		"dine-assignment//AuditIfNotExists/policy_assignment/Default",
+		"modify-assignment//Modify/policy_definition/Default",
+		"set-assignment/audit/Deny/policy_assignment/Default",
+		"set-assignment/audit-disabled/Disabled/policy_set_definition/Default",
+		"set-assignment/dine/DeployIfNotExists/policy_set_definition_default/Default",
+		"set-assignment/modify/Modify/policy_definition/Default",
+	}, summary)
+}
@@ -0,0 +1,358 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/alzlib/assets"
+	"github.com/Azure/alzlib/deployment"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armpolicy"
+	"github.com/Azure/terraform-provider-alz/internal/gen"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// The severities of lint findings.
+const (
+	lintSeverityError   = "error"
+	lintSeverityWarning = "warning"
+)
+
+const (
+	// nonComplianceMessageMaxLength is the maximum length of a policy assignment non-compliance message.
+	nonComplianceMessageMaxLength = 1024
+	// policyAssignmentNameMaxLength is the maximum length of a policy assignment name at management group scope.
+	policyAssignmentNameMaxLength = 24
+)
+
+// lintFinding is a problem found by a lint rule.
+type lintFinding struct {
+	RuleId            string
+	Severity          string
+	ManagementGroupId string
+	ResourceId        string
+	Message           string
+}
+
+// lintRule is a static check over the final hierarchy.
+// The check returns the findings of the rule, the rule id and severity are set by the caller.
+type lintRule struct {
+	Id       string
+	Severity string
+	check    func(depl *deployment.Hierarchy, az *alzlib.AlzLib) []lintFinding
+}
+
+// lintRuleConfig is the caller supplied configuration of a lint rule.
+type lintRuleConfig struct {
+	Enabled  bool
+	Severity string
+}
+
+// lintRules are the available lint rules, sorted by id.
+var lintRules = []lintRule{
+	{Id: "definition_not_assignable", Severity: lintSeverityError, check: lintDefinitionNotAssignable},
+	{Id: "non_compliance_message_length", Severity: lintSeverityError, check: lintNonComplianceMessageLength},
+	{Id: "policy_assignment_duplicate_name", Severity: lintSeverityWarning, check: lintPolicyAssignmentDuplicateName},
+	{Id: "policy_assignment_missing_identity", Severity: lintSeverityError, check: lintPolicyAssignmentMissingIdentity},
+	{Id: "policy_assignment_name_length", Severity: lintSeverityError, check: lintPolicyAssignmentNameLength},
+	{Id: "policy_assignment_not_scope_outside_hierarchy", Severity: lintSeverityWarning, check: lintPolicyAssignmentNotScopeOutsideHierarchy},
+}
+
+// lintRuleIds returns the ids of the available lint rules.
+func lintRuleIds() []string {
+	res := make([]string, len(lintRules))
+	for i, rule := range lintRules {
+		res[i] = rule.Id
+	}
+	return res
+}
+
+// lintHierarchy runs the enabled lint rules over the hierarchy.
+// Rules not in the config are enabled with their default severity.
+// The findings are sorted by rule id, management group id and resource id.
+func lintHierarchy(depl *deployment.Hierarchy, az *alzlib.AlzLib, config map[string]lintRuleConfig) []lintFinding {
+	var res []lintFinding
+	for _, rule := range lintRules {
+		severity := rule.Severity
+		if cfg, ok := config[rule.Id]; ok {
+			if !cfg.Enabled {
+				continue
+			}
+			if cfg.Severity != "" {
+				severity = cfg.Severity
+			}
+		}
+		findings := rule.check(depl, az)
+		for i := range findings {
+			findings[i].RuleId = rule.Id
+			findings[i].Severity = severity
+		}
+		res = append(res, findings...)
+	}
+	slices.SortStableFunc(res, func(a, b lintFinding) int {
+		if c := strings.Compare(a.RuleId, b.RuleId); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.ManagementGroupId, b.ManagementGroupId); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ResourceId, b.ResourceId)
+	})
+	return res
+}
+
+// lintPolicyAssignments calls fn for each policy assignment in the hierarchy,
+// in management group and policy assignment name order.
+func lintPolicyAssignments(depl *deployment.Hierarchy, fn func(mg *deployment.HierarchyManagementGroup, paName string, paId string, pa *assets.PolicyAssignment)) {
+	mgNames := depl.ManagementGroupNames()
+	slices.Sort(mgNames)
+	for _, mgName := range mgNames {
+		mg := depl.ManagementGroup(mgName)
+		pas := mg.PolicyAssignmentMap()
+		paNames := make([]string, 0, len(pas))
+		for paName := range pas {
+			paNames = append(paNames, paName)
+		}
+		slices.Sort(paNames)
+		for _, paName := range paNames {
+			pa := pas[paName]
+			paId := fmt.Sprintf(deployment.PolicyAssignmentIDFmt, mgName, paName)
+			if pa.ID != nil {
+				paId = *pa.ID
+			}
+			fn(mg, paName, paId, pa)
+		}
+	}
+}
+
+// lintPolicyAssignmentMissingIdentity finds policy assignments with DeployIfNotExists or Modify effects that have no managed identity.
+func lintPolicyAssignmentMissingIdentity(depl *deployment.Hierarchy, az *alzlib.AlzLib) []lintFinding {
+	var res []lintFinding
+	var last policyRemediationTarget
+	for _, target := range policyRemediationTargets(depl, az) {
+		if target.ManagementGroupId == last.ManagementGroupId && target.PolicyAssignmentName == last.PolicyAssignmentName {
+			continue
+		}
+		last = target
+		pa := depl.ManagementGroup(target.ManagementGroupId).PolicyAssignmentMap()[target.PolicyAssignmentName]
+		if pa.Identity != nil && pa.Identity.Type != nil && *pa.Identity.Type != armpolicy.ResourceIdentityTypeNone {
+			continue
+		}
+		res = append(res, lintFinding{
+			ManagementGroupId: target.ManagementGroupId,
+			ResourceId:        target.PolicyAssignmentId,
+			Message: fmt.Sprintf(
+				"Policy assignment `%s` has DeployIfNotExists or Modify effects but no managed identity.",
+				target.PolicyAssignmentName,
+			),
+		})
+	}
+	return res
+}
+
+// lintPolicyAssignmentNotScopeOutsideHierarchy finds policy assignment not scopes that reference management groups
+// that are not in the hierarchy, or that are not below the management group of the policy assignment.
+// Subscription and resource group not scopes cannot be checked and are ignored.
+func lintPolicyAssignmentNotScopeOutsideHierarchy(depl *deployment.Hierarchy, _ *alzlib.AlzLib) []lintFinding {
+	const prefix = "/providers/microsoft.management/managementgroups/"
+	var res []lintFinding
+	lintPolicyAssignments(depl, func(mg *deployment.HierarchyManagementGroup, paName, paId string, pa *assets.PolicyAssignment) {
+		if pa.Properties == nil {
+			return
+		}
+		for _, scope := range pa.Properties.NotScopes {
+			if scope == nil || !strings.HasPrefix(strings.ToLower(*scope), prefix) {
+				continue
+			}
+			notScopeMg := depl.ManagementGroup((*scope)[len(prefix):])
+			var msg string
+			switch {
+			case notScopeMg == nil:
+				msg = "Policy assignment `%s` has not scope `%s`, which is not a management group in the hierarchy."
+			case !managementGroupIsDescendant(notScopeMg, mg):
+				msg = "Policy assignment `%s` has not scope `%s`, which is not below the management group of the policy assignment."
+			default:
+				continue
+			}
+			res = append(res, lintFinding{
+				ManagementGroupId: mg.Name(),
+				ResourceId:        paId,
+				Message:           fmt.Sprintf(msg, paName, *scope),
+			})
+		}
+	})
+	return res
+}
+
+// lintPolicyAssignmentNameLength finds policy assignment names that are too long for a management group scope.
+func lintPolicyAssignmentNameLength(depl *deployment.Hierarchy, _ *alzlib.AlzLib) []lintFinding {
+	var res []lintFinding
+	lintPolicyAssignments(depl, func(mg *deployment.HierarchyManagementGroup, paName, paId string, _ *assets.PolicyAssignment) {
+		length := utf8.RuneCountInString(paName)
+		if length <= policyAssignmentNameMaxLength {
+			return
+		}
+		res = append(res, lintFinding{
+			ManagementGroupId: mg.Name(),
+			ResourceId:        paId,
+			Message: fmt.Sprintf(
+				"Policy assignment name `%s` is %d characters long, the maximum is %d.",
+				paName, length, policyAssignmentNameMaxLength,
+			),
+		})
+	})
+	return res
+}
+
+// lintDefinitionNotAssignable finds policy assignments of custom policy (set) definitions
+// that cannot be assigned at the management group of the policy assignment.
+// A custom definition can only be assigned at the management group it is deployed to, or below it.
+// Definitions deployed to management groups that are not in the hierarchy cannot be checked and are ignored.
+func lintDefinitionNotAssignable(depl *deployment.Hierarchy, az *alzlib.AlzLib) []lintFinding {
+	const prefix = "/providers/microsoft.management/managementgroups/"
+	var res []lintFinding
+	lintPolicyAssignments(depl, func(mg *deployment.HierarchyManagementGroup, paName, paId string, pa *assets.PolicyAssignment) {
+		if pa.Properties == nil || pa.Properties.PolicyDefinitionID == nil {
+			return
+		}
+		defId := *pa.Properties.PolicyDefinitionID
+		var msg string
+		if strings.HasPrefix(strings.ToLower(defId), prefix) {
+			defMgName, _, _ := strings.Cut(defId[len(prefix):], "/")
+			defMg := depl.ManagementGroup(defMgName)
+			if defMg == nil || defMg.Name() == mg.Name() || managementGroupIsDescendant(mg, defMg) {
+				return
+			}
+			msg = fmt.Sprintf(
+				"Policy assignment `%s` references definition `%s`, which is deployed to management group `%s` that is not the management group of the policy assignment or above it.",
+				paName, defId, defMgName,
+			)
+		} else {
+			if !lintDefinitionIsCustom(az, defId) {
+				return
+			}
+			msg = fmt.Sprintf(
+				"Policy assignment `%s` references custom definition `%s`, which is not deployed to the management group of the policy assignment or above it.",
+				paName, defId,
+			)
+		}
+		res = append(res, lintFinding{
+			ManagementGroupId: mg.Name(),
+			ResourceId:        paId,
+			Message:           msg,
+		})
+	})
+	return res
+}
+
+// lintDefinitionIsCustom returns true if the tenant scoped policy (set) definition id refers to a custom definition in the library.
+func lintDefinitionIsCustom(az *alzlib.AlzLib, defId string) bool {
+	name := defId[strings.LastIndex(defId, "/")+1:]
+	switch {
+	case strings.Contains(strings.ToLower(defId), "/policysetdefinitions/"):
+		psd := az.PolicySetDefinition(name, nil)
+		return psd != nil && psd.Properties != nil && psd.Properties.PolicyType != nil &&
+			*psd.Properties.PolicyType == armpolicy.PolicyTypeCustom
+	default:
+		pd := az.PolicyDefinition(name, nil)
+		return pd != nil && pd.Properties != nil && pd.Properties.PolicyType != nil &&
+			*pd.Properties.PolicyType == armpolicy.PolicyTypeCustom
+	}
+}
+
+// lintPolicyAssignmentDuplicateName finds policy assignment names that are used at more than one management group.
+func lintPolicyAssignmentDuplicateName(depl *deployment.Hierarchy, _ *alzlib.AlzLib) []lintFinding {
+	mgNamesByPaName := make(map[string][]string)
+	lintPolicyAssignments(depl, func(mg *deployment.HierarchyManagementGroup, paName, _ string, _ *assets.PolicyAssignment) {
+		mgNamesByPaName[paName] = append(mgNamesByPaName[paName], mg.Name())
+	})
+
+	var res []lintFinding
+	lintPolicyAssignments(depl, func(mg *deployment.HierarchyManagementGroup, paName, paId string, _ *assets.PolicyAssignment) {
+		mgNames := mgNamesByPaName[paName]
+		if len(mgNames) < 2 {
+			return
+		}
+		res = append(res, lintFinding{
+			ManagementGroupId: mg.Name(),
+			ResourceId:        paId,
+			Message: fmt.Sprintf(
+				"Policy assignment name `%s` is used at more than one management group: %s.",
+				paName, strings.Join(mgNames, ", "),
+			),
+		})
+	})
+	return res
+}
+
+// lintNonComplianceMessageLength finds policy assignment non-compliance messages that are too long.
+func lintNonComplianceMessageLength(depl *deployment.Hierarchy, _ *alzlib.AlzLib) []lintFinding {
+	var res []lintFinding
+	lintPolicyAssignments(depl, func(mg *deployment.HierarchyManagementGroup, paName, paId string, pa *assets.PolicyAssignment) {
+		if pa.Properties == nil {
+			return
+		}
+		for _, msg := range pa.Properties.NonComplianceMessages {
+			if msg == nil || msg.Message == nil {
+				continue
+			}
+			length := utf8.RuneCountInString(*msg.Message)
+			if length <= nonComplianceMessageMaxLength {
+				continue
+			}
+			target := fmt.Sprintf("Policy assignment `%s`", paName)
+			if msg.PolicyDefinitionReferenceID != nil {
+				target += fmt.Sprintf(" reference id `%s`", *msg.PolicyDefinitionReferenceID)
+			}
+			res = append(res, lintFinding{
+				ManagementGroupId: mg.Name(),
+				ResourceId:        paId,
+				Message: fmt.Sprintf(
+					"%s has a non-compliance message that is %d characters long, the maximum is %d.",
+					target, length, nonComplianceMessageMaxLength,
+				),
+			})
+		}
+	})
+	return res
+}
+
+// managementGroupIsDescendant returns true if mg is below ancestor in the hierarchy.
+func managementGroupIsDescendant(mg, ancestor *deployment.HierarchyManagementGroup) bool {
+	for p := mg.Parent(); p != nil; p = p.Parent() {
+		if p.Name() == ancestor.Name() {
+			return true
+		}
+	}
+	return false
+}
+
+// lintFindingsToProviderType converts the lint findings to the framework type.
+func lintFindingsToProviderType(ctx context.Context, findings []lintFinding) (basetypes.ListValue, diag.Diagnostics) {
+	var respDiags diag.Diagnostics
+	vals := make([]attr.Value, len(findings))
+	for i, finding := range findings {
+		val, diags := gen.NewFindingsValue(
+			gen.NewFindingsValueNull().AttributeTypes(ctx),
+			map[string]attr.Value{
+				"rule_id":             types.StringValue(finding.RuleId),
+				"severity":            types.StringValue(finding.Severity),
+				"management_group_id": types.StringValue(finding.ManagementGroupId),
+				"resource_id":         types.StringValue(finding.ResourceId),
+				"message":             types.StringValue(finding.Message),
+			},
+		)
+		respDiags.Append(diags...)
+		vals[i] = val
+	}
+	if respDiags.HasError() {
+		return types.ListNull(gen.NewFindingsValueNull().Type(ctx)), respDiags
+	}
+	return types.ListValue(gen.NewFindingsValueNull().Type(ctx), vals)
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Azure/terraform-provider-alz/internal/clients"
+	"github.com/Azure/terraform-provider-alz/internal/gen"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ datasource.DataSource = (*architectureLintDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*architectureLintDataSource)(nil)
+
+func NewArchitectureLintDataSource() datasource.DataSource {
+	return &architectureLintDataSource{}
+}
+
+type architectureLintDataSource struct {
+	data *clients.Client
+}
+
+func (d *architectureLintDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_architecture_lint"
+}
+
+// Schema returns the lint schema, with the arguments of the architecture data source added.
+// The computed attributes of the architecture data source are not included.
+func (d *architectureLintDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = gen.ArchitectureLintDataSourceSchema(ctx)
+	archSchema := gen.ArchitectureDataSourceSchema(ctx)
+	for name, attr := range archSchema.Attributes {
+		if _, ok := resp.Schema.Attributes[name]; ok || (attr.IsComputed() && !attr.IsOptional()) {
+			continue
+		}
+		resp.Schema.Attributes[name] = attr
+	}
+	resp.Schema.Blocks = archSchema.Blocks
+}
+
+func (d *architectureLintDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"architectureLintDataSource.Configure() Unexpected type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.data = data
+}
+
+func (d *architectureLintDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var archData gen.ArchitectureModel
+	var rules map[string]gen.RulesValue
+
+	// Read Terraform configuration data into the models
+	resp.Diagnostics.Append(architectureArguments(ctx, req.Config, &archData)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rules"), &rules)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := archData.Timeouts.Read(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	if d.data == nil {
+		resp.Diagnostics.AddError(
+			"architectureLintDataSource.Read() Provider not configured",
+			"The provider has not been configured. Please see the provider documentation for configuration instructions.",
+		)
+		return
+	}
+
+	ruleConfig := make(map[string]lintRuleConfig, len(rules))
+	for ruleId, rule := range rules {
+		if !slices.Contains(lintRuleIds(), ruleId) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules").AtMapKey(ruleId),
+				"architectureLintDataSource.Read() Unknown lint rule",
+				fmt.Sprintf("Lint rule `%s` does not exist. Valid rules are: %s", ruleId, strings.Join(lintRuleIds(), ", ")),
+			)
+			continue
+		}
+		ruleConfig[ruleId] = lintRuleConfig{
+			Enabled:  rule.Enabled.IsNull() || rule.Enabled.ValueBool(),
+			Severity: rule.Severity.ValueString(),
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the final hierarchy from the configuration, in the same way as the architecture data source
+	h := newArchitectureHierarchy(ctx, d.data, archData, "architectureLintDataSource.Read()", resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	findingsVal, diags := lintFindingsToProviderType(ctx, lintHierarchy(h.Hierarchy, d.data.AlzLib, ruleConfig))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state, the arguments are unchanged from the configuration
+	resp.State.Raw = req.Config.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), archData.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("findings"), findingsVal)...)
+}
+
+// architectureArguments reads the arguments of the architecture data source from the configuration into the model.
+// Attributes of the model that are not in the configuration schema, i.e. the computed attributes, are left null.
+func architectureArguments(ctx context.Context, config tfsdk.Config, data *gen.ArchitectureModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	v := reflect.ValueOf(data).Elem()
+	for i := range v.NumField() {
+		name := v.Type().Field(i).Tag.Get("tfsdk")
+		_, isAttr := config.Schema.GetAttributes()[name]
+		_, isBlock := config.Schema.GetBlocks()[name]
+		if name == "id" || (!isAttr && !isBlock) {
+			continue
+		}
+		diags.Append(config.GetAttribute(ctx, path.Root(name), v.Field(i).Addr().Interface())...)
+	}
+	return diags
+}
@@ -0,0 +1,65 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/Azure/terraform-provider-alz/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAlzArchitectureLintDataSource tests the data source for alz_architecture_lint.
+func TestAccAlzArchitectureLintDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.AccTestProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchitectureLintDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.alz_architecture_lint.test", "findings.#", "3"),
+					resource.TestCheckResourceAttr("data.alz_architecture_lint.test", "findings.0.rule_id", "non_compliance_message_length"),
+					resource.TestCheckResourceAttr("data.alz_architecture_lint.test", "findings.0.severity", "warning"),
+					resource.TestCheckResourceAttr("data.alz_architecture_lint.test", "findings.0.management_group_id", "child"),
+					resource.TestCheckResourceAttr("data.alz_architecture_lint.test", "findings.1.rule_id", "policy_assignment_not_scope_outside_hierarchy"),
+					resource.TestCheckResourceAttr("data.alz_architecture_lint.test", "findings.2.rule_id", "policy_assignment_not_scope_outside_hierarchy"),
+				),
+			},
+		},
+	})
+}
+
+// testAccArchitectureLintDataSourceConfig returns a test configuration for TestAccAlzArchitectureLintDataSource.
+// The default identity fixes the missing identity finding.
+func testAccArchitectureLintDataSourceConfig() string {
+	return `
+provider "alz" {
+  library_references = [
+    {
+      custom_url = "${path.root}/testdata/lint"
+    }
+  ]
+}
+
+data "alz_architecture_lint" "test" {
+  name                     = "test"
+  root_management_group_id = "00000000-0000-0000-0000-000000000000"
+  location                 = "northeurope"
+
+  default_identity = {
+    identity_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/uami"
+  }
+
+  rules = {
+    non_compliance_message_length = {
+      severity = "warning"
+    }
+    definition_not_assignable = {
+      enabled = false
+    }
+    policy_assignment_duplicate_name = {
+      enabled = false
+    }
+  }
+}
+`
+}
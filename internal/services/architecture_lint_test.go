@@ -0,0 +1,170 @@
+package services
+
+import (
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/alzlib/assets"
+	"github.com/Azure/alzlib/deployment"
+	"github.com/Azure/alzlib/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armpolicy"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/stretchr/testify/assert"
+)
+
+// newLintTestHierarchy returns the hierarchy from the lint test library.
+func newLintTestHierarchy(t *testing.T) (*alzlib.AlzLib, *deployment.Hierarchy) {
+	t.Helper()
+	ctx := t.Context()
+	az := alzlib.NewAlzLib(nil)
+	assert.NoError(t, az.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/lint", os.DirFS("testdata/lint"))))
+	depl := deployment.NewHierarchy(az)
+	assert.NoError(t, depl.FromArchitecture(ctx, "test", "00000000-0000-0000-0000-000000000000", "northeurope"))
+	return az, depl
+}
+
+// lintFindingsSummary returns the rule id, severity, management group and resource name of each finding.
+func lintFindingsSummary(findings []lintFinding) []string {
+	res := make([]string, len(findings))
+	for i, f := range findings {
+		res[i] = strings.Join([]string{f.RuleId, f.Severity, f.ManagementGroupId, path.Base(f.ResourceId)}, "/")
+	}
+	return res
+}
+
+func TestLintHierarchy(t *testing.T) {
+	t.Run("Default rules", func(t *testing.T) {
+		az, depl := newLintTestHierarchy(t)
+		findings := lintHierarchy(depl, az, nil)
+		assert.Equal(t, []string{
+			"definition_not_assignable/error/child/custom-assignment",
+			"definition_not_assignable/error/child/sibling-def-assignment",
+			"non_compliance_message_length/error/child/long-message-assignment",
+			"policy_assignment_duplicate_name/warning/child/shared-assignment",
+			"policy_assignment_duplicate_name/warning/root/shared-assignment",
+			"policy_assignment_missing_identity/error/root/dine-assignment",
+			"policy_assignment_not_scope_outside_hierarchy/warning/child/not-scope-assignment",
+			"policy_assignment_not_scope_outside_hierarchy/warning/child/not-scope-assignment",
+		}, lintFindingsSummary(findings))
+		assert.Contains(t, findings[0].Message, "custom definition")
+		assert.Contains(t, findings[1].Message, "deployed to management group `sibling`")
+		assert.Contains(t, findings[3].Message, "child, root")
+		assert.Contains(t, findings[6].Message, "not below the management group of the policy assignment")
+		assert.Contains(t, findings[7].Message, "not a management group in the hierarchy")
+	})
+
+	t.Run("Configured rules", func(t *testing.T) {
+		az, depl := newLintTestHierarchy(t)
+		findings := lintHierarchy(depl, az, map[string]lintRuleConfig{
+			"definition_not_assignable":                     {Enabled: true, Severity: "warning"},
+			"non_compliance_message_length":                 {Enabled: false},
+			"policy_assignment_duplicate_name":              {Enabled: true, Severity: "info"},
+			"policy_assignment_missing_identity":            {Enabled: true},
+			"policy_assignment_not_scope_outside_hierarchy": {Enabled: false},
+		})
+		assert.Equal(t, []string{
+			"definition_not_assignable/warning/child/custom-assignment",
+			"definition_not_assignable/warning/child/sibling-def-assignment",
+			"policy_assignment_duplicate_name/info/child/shared-assignment",
+			"policy_assignment_duplicate_name/info/root/shared-assignment",
+			"policy_assignment_missing_identity/error/root/dine-assignment",
+		}, lintFindingsSummary(findings))
+	})
+
+	t.Run("Fixed hierarchy", func(t *testing.T) {
+		az, depl := newLintTestHierarchy(t)
+		root := depl.ManagementGroup("root")
+		child := depl.ManagementGroup("child")
+		assert.NoError(t, root.ModifyPolicyAssignment("dine-assignment", deployment.WithIdentity(&armpolicy.Identity{
+			Type: to.Ptr(armpolicy.ResourceIdentityTypeSystemAssigned),
+		})))
+		assert.NoError(t, child.ModifyPolicyAssignment("not-scope-assignment", deployment.WithNotScopes([]*string{
+			to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000"),
+		})))
+		assert.NoError(t, child.ModifyPolicyAssignment("long-message-assignment", deployment.WithNonComplianceMessages([]*armpolicy.NonComplianceMessage{
+			{Message: to.Ptr(strings.Repeat("a", nonComplianceMessageMaxLength))},
+		})))
+		// The deployment of the referenced definitions cannot be changed by modifying the policy assignments.
+		assert.Equal(t, []string{
+			"definition_not_assignable/error/child/custom-assignment",
+			"definition_not_assignable/error/child/sibling-def-assignment",
+			"policy_assignment_duplicate_name/warning/child/shared-assignment",
+			"policy_assignment_duplicate_name/warning/root/shared-assignment",
+		}, lintFindingsSummary(lintHierarchy(depl, az, nil)))
+	})
+
+	t.Run("Policy assignment name length", func(t *testing.T) {
+		// The library validates the names of the policy assignments it loads, so the long name is added directly.
+		ctx := t.Context()
+		az := alzlib.NewAlzLib(nil)
+		assert.NoError(t, az.AddPolicyAssignments(&assets.PolicyAssignment{Assignment: armpolicy.Assignment{
+			Name: to.Ptr("storage-accounts-audit-assignment"),
+			Properties: &armpolicy.AssignmentProperties{
+				PolicyDefinitionID: to.Ptr("/providers/Microsoft.Authorization/policyDefinitions/audit-policy-definition"),
+			},
+		}}))
+		lib := fstest.MapFS{
+			"audit.alz_policy_definition.json": &fstest.MapFile{Data: lintTestReadFile(t, "testdata/lint/audit.alz_policy_definition.json")},
+			"root.alz_archetype_definition.json": &fstest.MapFile{Data: []byte(`{
+				"name": "root",
+				"policy_assignments": ["storage-accounts-audit-assignment"],
+				"policy_definitions": ["audit-policy-definition"],
+				"policy_set_definitions": [],
+				"role_definitions": []
+			}`)},
+			"test.alz_architecture_definition.json": &fstest.MapFile{Data: []byte(`{
+				"name": "test",
+				"management_groups": [{"id": "root", "display_name": "Root", "archetypes": ["root"], "parent_id": null, "exists": false}]
+			}`)},
+		}
+		assert.NoError(t, az.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("test", lib)))
+		depl := deployment.NewHierarchy(az)
+		assert.NoError(t, depl.FromArchitecture(ctx, "test", "00000000-0000-0000-0000-000000000000", "northeurope"))
+		findings := lintHierarchy(depl, az, nil)
+		assert.Equal(t, []string{
+			"policy_assignment_name_length/error/root/storage-accounts-audit-assignment",
+		}, lintFindingsSummary(findings))
+		assert.Contains(t, findings[0].Message, "is 33 characters long, the maximum is 24")
+	})
+}
+
+// lintTestReadFile returns the content of a test data file.
+func lintTestReadFile(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(name)
+	assert.NoError(t, err)
+	return data
+}
+
+func TestManagementGroupIsDescendant(t *testing.T) {
+	_, depl := newLintTestHierarchy(t)
+	root := depl.ManagementGroup("root")
+	child := depl.ManagementGroup("child")
+	sibling := depl.ManagementGroup("sibling")
+
+	assert.True(t, managementGroupIsDescendant(child, root))
+	assert.False(t, managementGroupIsDescendant(root, child))
+	assert.False(t, managementGroupIsDescendant(child, child))
+	assert.False(t, managementGroupIsDescendant(sibling, child))
+}
+
+func TestArchitectureLintDataSourceSchema(t *testing.T) {
+	ctx := t.Context()
+	resp := &datasource.SchemaResponse{}
+	NewArchitectureLintDataSource().Schema(ctx, datasource.SchemaRequest{}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.False(t, resp.Schema.ValidateImplementation(ctx).HasError())
+
+	for _, name := range []string{"id", "rules", "findings", "name", "root_management_group_id", "location", "policy_assignments_to_modify"} {
+		assert.Contains(t, resp.Schema.Attributes, name)
+	}
+	for _, name := range []string{"management_groups", "policy_role_assignments", "deployment_order"} {
+		assert.NotContains(t, resp.Schema.Attributes, name)
+	}
+	assert.Contains(t, resp.Schema.Blocks, "timeouts")
+	assert.True(t, resp.Schema.Attributes["id"].IsComputed())
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/alzlib/assets"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	mapset "github.com/deckarep/golang-set/v2"
+)
+
+// architectureDefinitionRequests returns the policy (set) definitions referenced by the policy assignments in the
+// architecture, including the members of assigned policy set definitions that are in alzlib or in the cache.
+// The members of other policy set definitions are not known, so are not included.
+// The definitions from the library are included, as they cannot be told apart from built-in definitions by id.
+// The requests are sorted and unique.
+func architectureDefinitionRequests(az *alzlib.AlzLib, c alzlib.BuiltInCache, archName string) ([]alzlib.BuiltInRequest, error) {
+	arch := az.Architecture(archName)
+	if arch == nil {
+		// Let FromArchitecture() report the missing architecture
+		return nil, nil
+	}
+
+	assignments := mapset.NewThreadUnsafeSet[string]()
+	mgs := arch.RootMgs()
+	for len(mgs) > 0 {
+		mg := mgs[0]
+		mgs = append(mgs[1:], mg.Children()...)
+		for _, archetype := range mg.Archetypes() {
+			assignments = assignments.Union(archetype.PolicyAssignments)
+		}
+	}
+
+	reqs := make(map[string]alzlib.BuiltInRequest)
+	for _, paName := range mapset.Sorted(assignments) {
+		pa := az.PolicyAssignment(paName)
+		if pa == nil {
+			// Let FromArchitecture() report the missing policy assignment
+			continue
+		}
+		resID, version, err := pa.ReferencedPolicyDefinitionResourceIDAndVersion()
+		if err != nil {
+			return nil, fmt.Errorf("getting referenced policy definition of policy assignment `%s`: %w", paName, err)
+		}
+		req := alzlib.BuiltInRequest{ResourceID: resID, Version: version}
+		reqs[req.String()] = req
+
+		if !strings.EqualFold(resID.ResourceType.Type, alzlib.PolicySetDefinitionsType) {
+			continue
+		}
+		psd := builtInPolicySetDefinition(az, c, resID.Name, version)
+		if psd == nil {
+			continue
+		}
+		for _, ref := range psd.Properties.PolicyDefinitions {
+			if ref == nil || ref.PolicyDefinitionID == nil {
+				continue
+			}
+			refID, err := arm.ParseResourceID(*ref.PolicyDefinitionID)
+			if err != nil {
+				return nil, fmt.Errorf("parsing policy definition id `%s` referenced in policy set definition `%s`: %w", *ref.PolicyDefinitionID, resID.Name, err)
+			}
+			refReq := alzlib.BuiltInRequest{ResourceID: refID, Version: ref.DefinitionVersion}
+			reqs[refReq.String()] = refReq
+		}
+	}
+
+	res := make([]alzlib.BuiltInRequest, 0, len(reqs))
+	for _, key := range slices.Sorted(maps.Keys(reqs)) {
+		res = append(res, reqs[key])
+	}
+	return res, nil
+}
+
+// missingBuiltInDefinitions returns the built-in policy (set) definitions referenced by the policy assignments in the
+// architecture that are neither in alzlib nor in the cache, and so would have to be fetched from Azure.
+// This includes the members of assigned policy set definitions. The members of missing policy set definitions are not
+// known, so are not included.
+// The definitions are returned as sorted resource ids, with the version constraint if there is one.
+func missingBuiltInDefinitions(az *alzlib.AlzLib, c alzlib.BuiltInCache, archName string) ([]string, error) {
+	reqs, err := architectureDefinitionRequests(az, c, archName)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for _, req := range reqs {
+		switch strings.ToLower(req.ResourceID.ResourceType.Type) {
+		case alzlib.PolicyDefinitionsType:
+			if !builtInPolicyDefinitionAvailable(az, c, req.ResourceID.Name, req.Version) {
+				missing = append(missing, req.String())
+			}
+		case alzlib.PolicySetDefinitionsType:
+			if builtInPolicySetDefinition(az, c, req.ResourceID.Name, req.Version) == nil {
+				missing = append(missing, req.String())
+			}
+		}
+	}
+	return missing, nil
+}
+
+// builtInPolicyDefinitionAvailable returns true if the policy definition is in alzlib or in the cache.
+func builtInPolicyDefinitionAvailable(az *alzlib.AlzLib, c alzlib.BuiltInCache, name string, version *string) bool {
+	if az.PolicyDefinitionExists(name, version) {
+		return true
+	}
+	if c == nil {
+		return false
+	}
+	pdvs := c.PolicyDefinitionVersionsByName(name)
+	if pdvs == nil {
+		return false
+	}
+	pd, err := pdvs.GetVersion(version)
+	return err == nil && pd != nil
+}
+
+// builtInPolicySetDefinition returns the policy set definition from alzlib or from the cache, or nil if it is in neither.
+func builtInPolicySetDefinition(az *alzlib.AlzLib, c alzlib.BuiltInCache, name string, version *string) *assets.PolicySetDefinition {
+	if psd := az.PolicySetDefinition(name, version); psd != nil {
+		return psd
+	}
+	if c == nil {
+		return nil
+	}
+	psdvs := c.PolicySetDefinitionVersionsByName(name)
+	if psdvs == nil {
+		return nil
+	}
+	psd, err := psdvs.GetVersion(version)
+	if err != nil {
+		return nil
+	}
+	return psd
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Azure/alzlib"
+	"github.com/Azure/alzlib/deployment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMissingBuiltInDefinitions(t *testing.T) {
+	ctx := t.Context()
+	builtIn := alzlib.NewAlzLib(nil)
+	require.NoError(t, builtIn.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/offline/builtin", os.DirFS("testdata/offline/builtin"))))
+	c := builtIn.ExportBuiltInCache()
+
+	az := alzlib.NewAlzLib(nil)
+	require.NoError(t, az.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/offline/lib", os.DirFS("testdata/offline/lib"))))
+
+	missing, err := missingBuiltInDefinitions(az, c, "test")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"/providers/Microsoft.Authorization/policyDefinitions/missing-member-policy-definition",
+		"/providers/Microsoft.Authorization/policyDefinitions/missing-policy-definition",
+		"/providers/Microsoft.Authorization/policySetDefinitions/missing-policy-set-definition",
+	}, missing)
+
+	// Without a cache, every built-in definition is missing.
+	missing, err = missingBuiltInDefinitions(az, nil, "test")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"/providers/Microsoft.Authorization/policyDefinitions/cached-policy-definition",
+		"/providers/Microsoft.Authorization/policyDefinitions/missing-policy-definition",
+		"/providers/Microsoft.Authorization/policySetDefinitions/cached-policy-set-definition",
+		"/providers/Microsoft.Authorization/policySetDefinitions/missing-policy-set-definition",
+	}, missing)
+
+	// Let FromArchitecture() report the missing architecture.
+	missing, err = missingBuiltInDefinitions(az, c, "missing")
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+
+	// Without a policy client, the hierarchy fails on the first definition that is missing from the cache.
+	az.AddCache(c)
+	depl := deployment.NewHierarchy(az)
+	assert.ErrorContains(t, depl.FromArchitecture(ctx, "test", "00000000-0000-0000-0000-000000000000", "northeurope"), "policy client not set")
+}
+
+func TestArchitectureDefinitionRequests(t *testing.T) {
+	ctx := t.Context()
+	builtIn := alzlib.NewAlzLib(nil)
+	require.NoError(t, builtIn.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/offline/builtin", os.DirFS("testdata/offline/builtin"))))
+	c := builtIn.ExportBuiltInCache()
+
+	az := alzlib.NewAlzLib(nil)
+	require.NoError(t, az.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/offline/lib", os.DirFS("testdata/offline/lib"))))
+
+	// The members of the cached policy set definition are included, those of the missing one are not known.
+	reqs, err := architectureDefinitionRequests(az, c, "test")
+	require.NoError(t, err)
+	res := make([]string, len(reqs))
+	for i, req := range reqs {
+		res[i] = req.String()
+	}
+	assert.Equal(t, []string{
+		"/providers/Microsoft.Authorization/policyDefinitions/cached-policy-definition",
+		"/providers/Microsoft.Authorization/policyDefinitions/missing-member-policy-definition",
+		"/providers/Microsoft.Authorization/policyDefinitions/missing-policy-definition",
+		"/providers/Microsoft.Authorization/policySetDefinitions/cached-policy-set-definition",
+		"/providers/Microsoft.Authorization/policySetDefinitions/missing-policy-set-definition",
+	}, res)
+}
+
+// TestCompleteBuiltInCacheNeedsNoAzure verifies that, with every referenced built-in definition in the cache,
+// the hierarchy and its policy role assignments are built without a policy client.
+// Built-in role definitions are only referenced by resource id, so they are never looked up.
+func TestCompleteBuiltInCacheNeedsNoAzure(t *testing.T) {
+	ctx := t.Context()
+	builtIn := alzlib.NewAlzLib(nil)
+	require.NoError(t, builtIn.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/offline/builtin", os.DirFS("testdata/offline/builtin"))))
+
+	az := alzlib.NewAlzLib(nil)
+	require.NoError(t, az.Init(ctx, alzlib.NewCustomLibraryReferenceFromFS("testdata/offline/lib", os.DirFS("testdata/offline/lib"))))
+	c := builtIn.ExportBuiltInCache()
+	az.AddCache(c)
+
+	missing, err := missingBuiltInDefinitions(az, c, "cached")
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+
+	depl := deployment.NewHierarchy(az)
+	require.NoError(t, depl.FromArchitecture(ctx, "cached", "00000000-0000-0000-0000-000000000000", "northeurope"))
+	pras, err := depl.PolicyRoleAssignments(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []deployment.PolicyRoleAssignment{
+		{
+			RoleDefinitionID:  "/providers/Microsoft.Authorization/roleDefinitions/749f88d5-cbae-40b8-bcfc-e573ddc772fa",
+			Scope:             "/providers/Microsoft.Management/managementGroups/cached",
+			AssignmentName:    "cached",
+			ManagementGroupID: "cached",
+		},
+	}, pras.ToSlice())
+}
@@ -9,8 +9,11 @@ import (
 	"github.com/Azure/terraform-provider-alz/internal/gen"
 	"github.com/Azure/terraform-provider-alz/internal/typehelper/gotype"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 var _ datasource.DataSource = (*metadataDataSource)(nil)
@@ -87,5 +90,66 @@ func (d *metadataDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 	alzRefsAttrVal := gotype.SliceOfPrimitiveToFramework(ctx, to.SliceOfPtrs(alzRefs...))
 	data.AlzLibraryReferences = types.ListValueMust(types.StringType, alzRefsAttrVal)
+
+	overwrites, diags := libraryOverwritesToProviderType(ctx, d.alz.LibraryOverwrites())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.LibraryOverwrites = overwrites
+
+	dependencyGraph, diags := libraryDependencyGraphToProviderType(ctx, d.alz.LibraryDependencyGraph())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.LibraryDependencyGraph = dependencyGraph
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// libraryOverwritesToProviderType converts the library overwrites to the framework type.
+func libraryOverwritesToProviderType(ctx context.Context, overwrites []clients.LibraryOverwrite) (basetypes.ListValue, diag.Diagnostics) {
+	var respDiags diag.Diagnostics
+	vals := make([]attr.Value, len(overwrites))
+	for i, ovr := range overwrites {
+		val, diags := gen.NewLibraryOverwritesValue(
+			gen.NewLibraryOverwritesValueNull().AttributeTypes(ctx),
+			map[string]attr.Value{
+				"asset_type":                    types.StringValue(ovr.AssetType),
+				"name":                          types.StringValue(ovr.Name),
+				"version":                       types.StringValue(ovr.Version),
+				"original_library_reference":    types.StringValue(ovr.OriginalLibraryReference),
+				"overwriting_library_reference": types.StringValue(ovr.OverwritingLibraryReference),
+			},
+		)
+		respDiags.Append(diags...)
+		vals[i] = val
+	}
+	if respDiags.HasError() {
+		return types.ListNull(gen.NewLibraryOverwritesValueNull().Type(ctx)), respDiags
+	}
+	return types.ListValue(gen.NewLibraryOverwritesValueNull().Type(ctx), vals)
+}
+
+// libraryDependencyGraphToProviderType converts the library dependency graph to the framework type.
+func libraryDependencyGraphToProviderType(ctx context.Context, graph []clients.LibraryDependency) (basetypes.ListValue, diag.Diagnostics) {
+	var respDiags diag.Diagnostics
+	vals := make([]attr.Value, len(graph))
+	for i, dep := range graph {
+		val, diags := gen.NewLibraryDependencyGraphValue(
+			gen.NewLibraryDependencyGraphValueNull().AttributeTypes(ctx),
+			map[string]attr.Value{
+				"library_reference":           types.StringValue(dep.LibraryReference),
+				"explicit":                    types.BoolValue(dep.Explicit),
+				"dependencies":                types.ListValueMust(types.StringType, gotype.SliceOfPrimitiveToFramework(ctx, to.SliceOfPtrs(dep.Dependencies...))),
+				"replaced_library_references": types.ListValueMust(types.StringType, gotype.SliceOfPrimitiveToFramework(ctx, to.SliceOfPtrs(dep.ReplacedLibraryReferences...))),
+			},
+		)
+		respDiags.Append(diags...)
+		vals[i] = val
+	}
+	if respDiags.HasError() {
+		return types.ListNull(gen.NewLibraryDependencyGraphValueNull().Type(ctx)), respDiags
+	}
+	return types.ListValue(gen.NewLibraryDependencyGraphValueNull().Type(ctx), vals)
+}
@@ -17,6 +17,9 @@ func TestAccAlzMetadataDataSource(t *testing.T) {
 				Config: testAccMetadataDataSourceConfig(),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.alz_metadata.test", "alz_library_references.0", "platform/alz@2024.07.5"),
+					resource.TestCheckResourceAttr("data.alz_metadata.test", "library_dependency_graph.#", "1"),
+					resource.TestCheckResourceAttr("data.alz_metadata.test", "library_dependency_graph.0.library_reference", "platform/alz@2024.07.5"),
+					resource.TestCheckResourceAttr("data.alz_metadata.test", "library_dependency_graph.0.explicit", "true"),
 				),
 			},
 		},
@@ -40,6 +43,45 @@ func TestAccAlzMetadataDataSourceCustomLib(t *testing.T) {
 	})
 }
 
+// TestAccAlzMetadataDataSourceLibraryOverwrites tests that the data source for alz_metadata reports
+// the library assets that are overwritten by later libraries.
+func TestAccAlzMetadataDataSourceLibraryOverwrites(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.AccTestProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetadataDataSourceConfigLibraryOverwrites(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.alz_metadata.test", "library_overwrites.#", "1"),
+					resource.TestCheckResourceAttr("data.alz_metadata.test", "library_overwrites.0.asset_type", "policy_assignment"),
+					resource.TestCheckResourceAttr("data.alz_metadata.test", "library_overwrites.0.name", "test-policy-assignment"),
+					resource.TestCheckResourceAttr("data.alz_metadata.test", "library_overwrites.0.original_library_reference", "testdata/testacc_lib"),
+					resource.TestCheckResourceAttr("data.alz_metadata.test", "library_overwrites.0.overwriting_library_reference", "testdata/libraryoverwrites"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAlzMetadataDataSourceLocalPath tests that a library can be referenced by a path relative to the
+// configuration directory.
+func TestAccAlzMetadataDataSourceLocalPath(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.AccTestPreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.AccTestProtoV6ProviderFactoriesUnique(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetadataDataSourceConfigLocalPath(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.alz_metadata.test", "alz_library_references.#", "0"),
+					resource.TestCheckResourceAttr("data.alz_metadata.test", "library_dependency_graph.0.library_reference", "testdata/testacc_lib"),
+				),
+			},
+		},
+	})
+}
+
 // testAccMetadataDataSourceConfig returns a test configuration for .
 func testAccMetadataDataSourceConfig() string {
 	return `
@@ -70,3 +112,38 @@ provider "alz" {
 data "alz_metadata" "test" {}
 `
 }
+
+// testAccMetadataDataSourceConfigLibraryOverwrites returns a test configuration with a library
+// that overwrites a policy assignment from the previous library.
+func testAccMetadataDataSourceConfigLibraryOverwrites() string {
+	return `
+provider "alz" {
+  library_overwrite_enabled = true
+  library_references = [
+    {
+      custom_url = "testdata/testacc_lib"
+    },
+    {
+      custom_url = "testdata/libraryoverwrites"
+    }
+  ]
+}
+
+data "alz_metadata" "test" {}
+`
+}
+
+// testAccMetadataDataSourceConfigLocalPath returns a test configuration with a local path library.
+func testAccMetadataDataSourceConfigLocalPath() string {
+	return `
+provider "alz" {
+  library_references = [
+    {
+      local_path = "testdata/testacc_lib"
+    }
+  ]
+}
+
+data "alz_metadata" "test" {}
+`
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/Azure/alzlib/assets"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armpolicy"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,7 +16,8 @@ type AlzMapTypes interface {
 	*assets.PolicyAssignment |
 		*assets.PolicyDefinition |
 		*assets.PolicySetDefinition |
-		*assets.RoleDefinition
+		*assets.RoleDefinition |
+		*armpolicy.Exemption
 }
 
 // ConvertAlzMapToFrameworkType converts a map[string]armTypes to a map[string]attr.Value, using types.StringType as the value type.
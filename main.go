@@ -7,6 +7,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/Azure/terraform-provider-alz/internal/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -32,6 +33,11 @@ var (
 )
 
 func main() {
+	// Subcommands, e.g. `cache build`, run the command line tools instead of the plugin server.
+	if provider.IsCommand(os.Args[1:]) {
+		os.Exit(provider.RunCommand(context.Background(), version, os.Args[1:], os.Stdout, os.Stderr))
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")